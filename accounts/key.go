@@ -0,0 +1,239 @@
+package accounts
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keyVersion = 3
+
+	keyCipher = "aes-128-ctr"
+	keyKDF    = "scrypt"
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	// StandardScryptN and StandardScryptP are the scrypt parameters
+	// used for the keys stored in the keystore
+	StandardScryptN = 1 << 18
+	StandardScryptP = 1
+
+	// LightScryptN and LightScryptP are the scrypt parameters
+	// that trade security for speed, used mostly for testing
+	LightScryptN = 1 << 12
+	LightScryptP = 6
+)
+
+var (
+	ErrDecrypt            = errors.New("could not decrypt key with given passphrase")
+	ErrUnsupportedVersion = errors.New("unsupported key version")
+	ErrUnsupportedCipher  = errors.New("unsupported key cipher")
+	ErrUnsupportedKDF     = errors.New("unsupported key derivation function")
+	ErrInvalidKeyParams   = errors.New("invalid key parameters")
+)
+
+// encryptedKeyJSON is the Web3 Secret Storage (v3) representation of a key
+type encryptedKeyJSON struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    scryptParamsJSON `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+type scryptParamsJSON struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// encryptKey encrypts the private key with the passphrase,
+// and encodes it using the Web3 Secret Storage format
+func encryptKey(key *ecdsa.PrivateKey, passphrase string, scryptN, scryptP int) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+
+	keyBytes, err := crypto.MarshalPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := aesCTRXOR(derivedKey[:16], keyBytes, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&encryptedKeyJSON{
+		Address: hex.EncodeToString(crypto.PubKeyToAddress(&key.PublicKey).Bytes()),
+		Crypto: cryptoJSON{
+			Cipher:     keyCipher,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: keyKDF,
+			KDFParams: scryptParamsJSON{
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				DKLen: scryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(crypto.Keccak256(derivedKey[16:32], cipherText)),
+		},
+		ID:      id.String(),
+		Version: keyVersion,
+	})
+}
+
+// decryptKey decrypts the Web3 Secret Storage encoded key using the passphrase
+func decryptKey(keyJSON []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	var encrypted encryptedKeyJSON
+	if err := json.Unmarshal(keyJSON, &encrypted); err != nil {
+		return nil, err
+	}
+
+	if encrypted.Version != keyVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, encrypted.Version)
+	}
+
+	if encrypted.Crypto.Cipher != keyCipher {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCipher, encrypted.Crypto.Cipher)
+	}
+
+	if encrypted.Crypto.KDF != keyKDF {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKDF, encrypted.Crypto.KDF)
+	}
+
+	params := encrypted.Crypto.KDFParams
+
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := hex.DecodeString(encrypted.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := hex.DecodeString(encrypted.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := hex.DecodeString(encrypted.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first half of the derived key is the AES key, the second half is used for the MAC
+	if params.DKLen < scryptDKLen {
+		return nil, fmt.Errorf("%w: derived key length %d", ErrInvalidKeyParams, params.DKLen)
+	}
+
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("%w: iv length %d", ErrInvalidKeyParams, len(iv))
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(crypto.Keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, ErrDecrypt
+	}
+
+	keyBytes, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := crypto.ParsePrivateKey(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if address := crypto.PubKeyToAddress(&key.PublicKey); address != types.StringToAddress(encrypted.Address) {
+		return nil, fmt.Errorf("key content mismatch: have account %s, want %s", address, encrypted.Address)
+	}
+
+	return key, nil
+}
+
+// keyAddress reads the address of the Web3 Secret Storage encoded key, without decrypting it
+func keyAddress(keyJSON []byte) (types.Address, error) {
+	var encrypted encryptedKeyJSON
+	if err := json.Unmarshal(keyJSON, &encrypted); err != nil {
+		return types.ZeroAddress, err
+	}
+
+	buf, err := hex.DecodeString(encrypted.Address)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	if len(buf) != types.AddressLength {
+		return types.ZeroAddress, fmt.Errorf("invalid key address %s", encrypted.Address)
+	}
+
+	return types.BytesToAddress(buf), nil
+}
+
+func aesCTRXOR(key, input, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
@@ -0,0 +1,323 @@
+package accounts
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrUnknownAccount = errors.New("unknown account")
+	ErrLocked         = errors.New("account is locked")
+)
+
+// Keystore manages the user accounts of the node.
+// The keys are stored encrypted on disk in the Web3 Secret Storage format,
+// and can be unlocked for signing for a limited time
+type Keystore struct {
+	// Path to the keystore directory
+	dir string
+
+	// The scrypt parameters used for encrypting new keys
+	scryptN int
+	scryptP int
+
+	// Map of known accounts and their key file paths
+	accounts map[types.Address]string
+
+	// Map of unlocked accounts
+	unlocked map[types.Address]*unlockedKey
+
+	// Mux for the account maps
+	lock sync.RWMutex
+}
+
+// unlockedKey is a decrypted key that is available for signing
+type unlockedKey struct {
+	key *ecdsa.PrivateKey
+
+	// The timer that locks the key again, nil if the key is unlocked indefinitely
+	timer *time.Timer
+}
+
+// NewKeystore creates a keystore in the given directory,
+// loading the keys that are already stored in it
+func NewKeystore(dir string, scryptN, scryptP int) (*Keystore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		dir:      dir,
+		scryptN:  scryptN,
+		scryptP:  scryptP,
+		accounts: make(map[types.Address]string),
+		unlocked: make(map[types.Address]*unlockedKey),
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+
+		keyJSON, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		address, err := keyAddress(keyJSON)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key file %s, %w", path, err)
+		}
+
+		ks.accounts[address] = path
+	}
+
+	return ks, nil
+}
+
+// NewAccount generates a new key, stores it encrypted with the passphrase
+// and returns the address of the new account
+func (ks *Keystore) NewAccount(passphrase string) (types.Address, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return ks.storeKey(key, passphrase)
+}
+
+// ImportKey stores the given key encrypted with the passphrase
+// and returns the address of the imported account
+func (ks *Keystore) ImportKey(key *ecdsa.PrivateKey, passphrase string) (types.Address, error) {
+	address := crypto.PubKeyToAddress(&key.PublicKey)
+	if ks.HasAccount(address) {
+		return types.ZeroAddress, fmt.Errorf("account %s already exists", address)
+	}
+
+	return ks.storeKey(key, passphrase)
+}
+
+func (ks *Keystore) storeKey(key *ecdsa.PrivateKey, passphrase string) (types.Address, error) {
+	keyJSON, err := encryptKey(key, passphrase, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	address := crypto.PubKeyToAddress(&key.PublicKey)
+	path := filepath.Join(ks.dir, keyFileName(address, time.Now().UTC()))
+
+	if err := ioutil.WriteFile(path, keyJSON, 0600); err != nil {
+		return types.ZeroAddress, err
+	}
+
+	ks.lock.Lock()
+	ks.accounts[address] = path
+	ks.lock.Unlock()
+
+	return address, nil
+}
+
+// Accounts returns the addresses of all the accounts in the keystore, sorted
+func (ks *Keystore) Accounts() []types.Address {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	accounts := make([]types.Address, 0, len(ks.accounts))
+	for address := range ks.accounts {
+		accounts = append(accounts, address)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Bytes(), accounts[j].Bytes()) < 0
+	})
+
+	return accounts
+}
+
+// HasAccount returns a flag indicating if the account is in the keystore
+func (ks *Keystore) HasAccount(address types.Address) bool {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	_, ok := ks.accounts[address]
+
+	return ok
+}
+
+// Unlock decrypts the key of the account and keeps it available for signing.
+// The account is locked again after the timeout. A zero timeout unlocks the account
+// until the Lock method is called
+func (ks *Keystore) Unlock(address types.Address, passphrase string, timeout time.Duration) error {
+	key, err := ks.decrypt(address, passphrase)
+	if err != nil {
+		return err
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	if previous, ok := ks.unlocked[address]; ok && previous.timer != nil {
+		previous.timer.Stop()
+	}
+
+	unlocked := &unlockedKey{key: key}
+
+	if timeout > 0 {
+		unlocked.timer = time.AfterFunc(timeout, func() {
+			ks.lock.Lock()
+			defer ks.lock.Unlock()
+
+			// Make sure the account wasn't unlocked again in the meantime
+			if ks.unlocked[address] == unlocked {
+				delete(ks.unlocked, address)
+			}
+		})
+	}
+
+	ks.unlocked[address] = unlocked
+
+	return nil
+}
+
+// Lock removes the decrypted key of the account from memory
+func (ks *Keystore) Lock(address types.Address) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	if _, ok := ks.accounts[address]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownAccount, address)
+	}
+
+	if unlocked, ok := ks.unlocked[address]; ok {
+		if unlocked.timer != nil {
+			unlocked.timer.Stop()
+		}
+
+		delete(ks.unlocked, address)
+	}
+
+	return nil
+}
+
+// IsUnlocked returns a flag indicating if the account is available for signing
+func (ks *Keystore) IsUnlocked(address types.Address) bool {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	_, ok := ks.unlocked[address]
+
+	return ok
+}
+
+// SignHash signs the hash using the key of the unlocked account
+func (ks *Keystore) SignHash(address types.Address, hash []byte) ([]byte, error) {
+	key, err := ks.unlockedKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(key, hash)
+}
+
+// SignHashWithPassphrase signs the hash using the key of the account,
+// decrypting it only for the duration of the call
+func (ks *Keystore) SignHashWithPassphrase(address types.Address, passphrase string, hash []byte) ([]byte, error) {
+	key, err := ks.decrypt(address, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(key, hash)
+}
+
+// SignTx signs the transaction with the signer, using the key of the unlocked account
+func (ks *Keystore) SignTx(
+	address types.Address,
+	tx *types.Transaction,
+	signer crypto.TxSigner,
+) (*types.Transaction, error) {
+	key, err := ks.unlockedKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.SignTx(tx, key)
+}
+
+// SignTxWithPassphrase signs the transaction with the signer, using the key of the account
+// decrypted only for the duration of the call
+func (ks *Keystore) SignTxWithPassphrase(
+	address types.Address,
+	passphrase string,
+	tx *types.Transaction,
+	signer crypto.TxSigner,
+) (*types.Transaction, error) {
+	key, err := ks.decrypt(address, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.SignTx(tx, key)
+}
+
+func (ks *Keystore) unlockedKey(address types.Address) (*ecdsa.PrivateKey, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	if _, ok := ks.accounts[address]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, address)
+	}
+
+	unlocked, ok := ks.unlocked[address]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrLocked, address)
+	}
+
+	return unlocked.key, nil
+}
+
+func (ks *Keystore) decrypt(address types.Address, passphrase string) (*ecdsa.PrivateKey, error) {
+	ks.lock.RLock()
+	path, ok := ks.accounts[address]
+	ks.lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, address)
+	}
+
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptKey(keyJSON, passphrase)
+}
+
+// keyFileName returns the file name of the key, in the form of UTC--<created_at UTC ISO8601>--<address hex>
+func keyFileName(address types.Address, t time.Time) string {
+	timestamp := fmt.Sprintf(
+		"%04d-%02d-%02dT%02d-%02d-%02d.%09dZ",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+	)
+
+	return fmt.Sprintf("UTC--%s--%s", timestamp, strings.TrimPrefix(strings.ToLower(address.String()), "0x"))
+}
@@ -0,0 +1,171 @@
+package accounts
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeystore(t *testing.T) *Keystore {
+	t.Helper()
+
+	ks, err := NewKeystore(t.TempDir(), LightScryptN, LightScryptP)
+	assert.NoError(t, err)
+
+	return ks
+}
+
+func TestKeystore_EncryptDecrypt(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	keyJSON, err := encryptKey(key, "passphrase", LightScryptN, LightScryptP)
+	assert.NoError(t, err)
+
+	address, err := keyAddress(keyJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubKeyToAddress(&key.PublicKey), address)
+
+	decrypted, err := decryptKey(keyJSON, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, key.D, decrypted.D)
+
+	_, err = decryptKey(keyJSON, "wrong")
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestKeystore_DecryptInvalidParams(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	keyJSON, err := encryptKey(key, "passphrase", LightScryptN, LightScryptP)
+	assert.NoError(t, err)
+
+	cases := map[string]func(encrypted *encryptedKeyJSON){
+		"short derived key": func(encrypted *encryptedKeyJSON) {
+			encrypted.Crypto.KDFParams.DKLen = 16
+		},
+		"short iv": func(encrypted *encryptedKeyJSON) {
+			encrypted.Crypto.CipherParams.IV = "00"
+		},
+	}
+
+	for name, malform := range cases {
+		var encrypted encryptedKeyJSON
+		assert.NoError(t, json.Unmarshal(keyJSON, &encrypted))
+
+		malform(&encrypted)
+
+		malformed, err := json.Marshal(&encrypted)
+		assert.NoError(t, err)
+
+		_, err = decryptKey(malformed, "passphrase")
+		assert.ErrorIs(t, err, ErrInvalidKeyParams, name)
+	}
+}
+
+func TestKeystore_NewAccountReload(t *testing.T) {
+	dir := t.TempDir()
+
+	ks, err := NewKeystore(dir, LightScryptN, LightScryptP)
+	assert.NoError(t, err)
+
+	addr1, err := ks.NewAccount("first")
+	assert.NoError(t, err)
+
+	addr2, err := ks.NewAccount("second")
+	assert.NoError(t, err)
+
+	// the keys are loaded from disk
+	reloaded, err := NewKeystore(dir, LightScryptN, LightScryptP)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []types.Address{addr1, addr2}, reloaded.Accounts())
+
+	assert.NoError(t, reloaded.Unlock(addr1, "first", 0))
+	assert.ErrorIs(t, reloaded.Unlock(addr2, "first", 0), ErrDecrypt)
+}
+
+func TestKeystore_UnlockLock(t *testing.T) {
+	ks := newTestKeystore(t)
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	addr, err := ks.ImportKey(key, "passphrase")
+	assert.NoError(t, err)
+
+	hash := crypto.Keccak256([]byte("hello"))
+
+	// locked accounts can't sign
+	_, err = ks.SignHash(addr, hash)
+	assert.ErrorIs(t, err, ErrLocked)
+
+	// unknown accounts can't be unlocked
+	assert.ErrorIs(t, ks.Unlock(types.StringToAddress("1"), "passphrase", 0), ErrUnknownAccount)
+
+	assert.NoError(t, ks.Unlock(addr, "passphrase", 0))
+
+	sig, err := ks.SignHash(addr, hash)
+	assert.NoError(t, err)
+
+	pub, err := crypto.SigToPub(hash, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, addr, crypto.PubKeyToAddress(pub))
+
+	assert.NoError(t, ks.Lock(addr))
+	assert.False(t, ks.IsUnlocked(addr))
+
+	// the account is still usable with the passphrase
+	_, err = ks.SignHashWithPassphrase(addr, "passphrase", hash)
+	assert.NoError(t, err)
+}
+
+func TestKeystore_UnlockTimeout(t *testing.T) {
+	ks := newTestKeystore(t)
+
+	addr, err := ks.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	assert.NoError(t, ks.Unlock(addr, "passphrase", 50*time.Millisecond))
+	assert.True(t, ks.IsUnlocked(addr))
+
+	assert.Eventually(t, func() bool {
+		return !ks.IsUnlocked(addr)
+	}, time.Second, 10*time.Millisecond)
+
+	// unlocking again indefinitely overrides the previous timeout
+	assert.NoError(t, ks.Unlock(addr, "passphrase", 50*time.Millisecond))
+	assert.NoError(t, ks.Unlock(addr, "passphrase", 0))
+
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, ks.IsUnlocked(addr))
+}
+
+func TestKeystore_SignTx(t *testing.T) {
+	ks := newTestKeystore(t)
+
+	addr, err := ks.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	signer := crypto.NewEIP155Signer(100)
+	to := types.StringToAddress("2")
+
+	tx := &types.Transaction{
+		To:       &to,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+		Value:    big.NewInt(1),
+	}
+
+	signedTx, err := ks.SignTxWithPassphrase(addr, "passphrase", tx, signer)
+	assert.NoError(t, err)
+
+	sender, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, addr, sender)
+}
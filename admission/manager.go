@@ -0,0 +1,141 @@
+package admission
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+var ErrReloadNotSupported = errors.New("the admission policy is read from the on-chain registry")
+
+type executor interface {
+	BeginTxn(parentRoot types.Hash, header *types.Header, coinbaseReceiver types.Address) (*state.Transition, error)
+}
+
+// Manager provides the admission policy in effect at a given state,
+// either from a local file or from an on-chain registry contract.
+type Manager struct {
+	logger hclog.Logger
+	lock   sync.RWMutex
+
+	// local file source
+	path   string
+	policy *Policy
+
+	// on-chain registry source
+	registry *types.Address
+	executor executor
+
+	// the registry policy of the last queried state
+	cachedRoot   types.Hash
+	cachedPolicy *Policy
+}
+
+// NewFileManager creates a manager of the policy stored in the given
+// JSON file, which can be reloaded at runtime.
+// The file policy is node-local: it is enforced only by the txpool of this node,
+// which rejects the transactions not admitted when adding them and drops the pending
+// ones no longer admitted after a reload. It is never enforced when processing blocks.
+func NewFileManager(logger hclog.Logger, path string) (*Manager, error) {
+	m := &Manager{
+		logger: logger.Named("admission"),
+		path:   path,
+	}
+
+	if _, err := m.Reload(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// NewRegistryManager creates a manager of the policy
+// held by the given registry contract.
+func NewRegistryManager(logger hclog.Logger, registry types.Address, executor executor) *Manager {
+	return &Manager{
+		logger:   logger.Named("admission"),
+		registry: &registry,
+		executor: executor,
+	}
+}
+
+// Reload reads the policy file again and returns the new policy.
+func (m *Manager) Reload() (*Policy, error) {
+	if m.registry != nil {
+		return nil, ErrReloadNotSupported
+	}
+
+	policy, err := LoadPolicy(m.path)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	m.policy = policy
+	m.lock.Unlock()
+
+	m.logger.Info("admission policy loaded",
+		"path", m.path,
+		"allowlist", len(policy.Allowlist),
+		"denylist", len(policy.Denylist),
+		"deployAllowlist", len(policy.DeployAllowlist),
+	)
+
+	return policy, nil
+}
+
+// PolicyAt returns the policy in effect for a block
+// with the given header, built on the given parent state.
+func (m *Manager) PolicyAt(parentRoot types.Hash, header *types.Header) (*Policy, error) {
+	m.lock.RLock()
+
+	if m.registry == nil {
+		defer m.lock.RUnlock()
+
+		return m.policy, nil
+	}
+
+	if m.cachedPolicy != nil && m.cachedRoot == parentRoot {
+		defer m.lock.RUnlock()
+
+		return m.cachedPolicy, nil
+	}
+
+	m.lock.RUnlock()
+
+	// the queries must not be limited by the block gas limit
+	header = header.Copy()
+	header.GasLimit = queryGasLimit * 3
+
+	transition, err := m.executor.BeginTxn(parentRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := QueryPolicy(transition, *m.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	m.cachedRoot = parentRoot
+	m.cachedPolicy = policy
+	m.lock.Unlock()
+
+	return policy, nil
+}
+
+// Admit returns an error if the transaction is not admitted by the policy
+// in effect for a block with the given header, built on the given parent state.
+func (m *Manager) Admit(parentRoot types.Hash, header *types.Header, tx *types.Transaction) error {
+	policy, err := m.PolicyAt(parentRoot, header)
+	if err != nil {
+		return fmt.Errorf("%w: %v", state.ErrRegistryQuery, err)
+	}
+
+	return policy.Check(tx)
+}
@@ -0,0 +1,124 @@
+package admission
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrSenderNotAllowed     = errors.New("sender is not in the allowlist")
+	ErrSenderDenied         = errors.New("sender is in the denylist")
+	ErrRecipientDenied      = errors.New("recipient is in the denylist")
+	ErrDeploymentNotAllowed = errors.New("sender is not allowed to deploy contracts")
+	ErrFeePayerNotAllowed   = errors.New("fee payer is not in the allowlist")
+	ErrFeePayerDenied       = errors.New("fee payer is in the denylist")
+)
+
+// Policy defines which transactions are admitted to the chain.
+// An empty allowlist admits all the senders which are not denylisted.
+type Policy struct {
+	// Allowlist holds the only senders and fee payers allowed to send transactions
+	Allowlist []types.Address `json:"allowlist"`
+
+	// Denylist holds the senders, fee payers and recipients whose transactions are rejected
+	Denylist []types.Address `json:"denylist"`
+
+	// DeployAllowlist holds the only senders allowed to deploy contracts
+	DeployAllowlist []types.Address `json:"deployAllowlist"`
+
+	// lookup sets built from the lists
+	allowed, denied, deployers map[types.Address]struct{}
+}
+
+// NewPolicy creates a policy from the given lists.
+func NewPolicy(allowlist, denylist, deployAllowlist []types.Address) *Policy {
+	p := &Policy{
+		Allowlist:       allowlist,
+		Denylist:        denylist,
+		DeployAllowlist: deployAllowlist,
+	}
+
+	p.index()
+
+	return p
+}
+
+// LoadPolicy reads the policy from the given JSON file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse admission policy %s: %w", path, err)
+	}
+
+	p.index()
+
+	return p, nil
+}
+
+// index builds the lookup sets of the policy lists.
+func (p *Policy) index() {
+	toSet := func(addrs []types.Address) map[types.Address]struct{} {
+		set := make(map[types.Address]struct{}, len(addrs))
+		for _, addr := range addrs {
+			set[addr] = struct{}{}
+		}
+
+		return set
+	}
+
+	p.allowed = toSet(p.Allowlist)
+	p.denied = toSet(p.Denylist)
+	p.deployers = toSet(p.DeployAllowlist)
+}
+
+// Check returns an error if the transaction is not admitted by the policy.
+// The sender and the fee payer of the transaction are expected to be recovered beforehand.
+func (p *Policy) Check(tx *types.Transaction) error {
+	if _, ok := p.denied[tx.From]; ok {
+		return ErrSenderDenied
+	}
+
+	if len(p.allowed) != 0 {
+		if _, ok := p.allowed[tx.From]; !ok {
+			return ErrSenderNotAllowed
+		}
+	}
+
+	// the sponsors of fee delegated transactions are subject to the same lists as the senders
+	if tx.FeePayer != nil {
+		if _, ok := p.denied[*tx.FeePayer]; ok {
+			return ErrFeePayerDenied
+		}
+
+		if len(p.allowed) != 0 {
+			if _, ok := p.allowed[*tx.FeePayer]; !ok {
+				return ErrFeePayerNotAllowed
+			}
+		}
+	}
+
+	if tx.IsContractCreation() {
+		if len(p.deployers) != 0 {
+			if _, ok := p.deployers[tx.From]; !ok {
+				return ErrDeploymentNotAllowed
+			}
+		}
+
+		return nil
+	}
+
+	if _, ok := p.denied[*tx.To]; ok {
+		return ErrRecipientDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package admission
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addr1 = types.StringToAddress("1")
+	addr2 = types.StringToAddress("2")
+	addr3 = types.StringToAddress("3")
+)
+
+func TestPolicy_Check(t *testing.T) {
+	policy := NewPolicy(
+		[]types.Address{addr1, addr2},
+		[]types.Address{addr3},
+		[]types.Address{addr1},
+	)
+
+	testTable := []struct {
+		name string
+		from types.Address
+		to   *types.Address
+		err  error
+	}{
+		{"allowlisted sender", addr1, &addr2, nil},
+		{"sender not allowlisted", types.StringToAddress("4"), &addr1, ErrSenderNotAllowed},
+		{"denylisted sender", addr3, &addr1, ErrSenderDenied},
+		{"denylisted recipient", addr1, &addr3, ErrRecipientDenied},
+		{"allowed deployment", addr1, nil, nil},
+		{"deployment not allowed", addr2, nil, ErrDeploymentNotAllowed},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := policy.Check(&types.Transaction{
+				From: testCase.from,
+				To:   testCase.to,
+			})
+
+			assert.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+func TestPolicy_CheckFeePayer(t *testing.T) {
+	policy := NewPolicy(
+		[]types.Address{addr1, addr2},
+		[]types.Address{addr3},
+		nil,
+	)
+
+	sponsored := func(feePayer types.Address) *types.Transaction {
+		return &types.Transaction{
+			From:     addr1,
+			To:       &addr2,
+			FeePayer: &feePayer,
+		}
+	}
+
+	assert.NoError(t, policy.Check(sponsored(addr2)))
+	assert.ErrorIs(t, policy.Check(sponsored(addr3)), ErrFeePayerDenied)
+	assert.ErrorIs(t, policy.Check(sponsored(types.StringToAddress("4"))), ErrFeePayerNotAllowed)
+
+	// the sponsors are only denylisted without an allowlist
+	policy = NewPolicy(nil, []types.Address{addr3}, nil)
+
+	assert.NoError(t, policy.Check(sponsored(types.StringToAddress("4"))))
+	assert.ErrorIs(t, policy.Check(sponsored(addr3)), ErrFeePayerDenied)
+}
+
+func TestPolicy_EmptyListsAdmitAll(t *testing.T) {
+	policy := NewPolicy(nil, nil, nil)
+
+	assert.NoError(t, policy.Check(&types.Transaction{From: addr1, To: &addr2}))
+	assert.NoError(t, policy.Check(&types.Transaction{From: addr1}))
+}
+
+func TestFileManager_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	writePolicy := func(content string) {
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	writePolicy(`{"denylist": ["` + addr1.String() + `"]}`)
+
+	manager, err := NewFileManager(nullLogger(), path)
+	assert.NoError(t, err)
+
+	tx := &types.Transaction{From: addr1, To: &addr2}
+	assert.ErrorIs(t, manager.Admit(types.Hash{}, &types.Header{}, tx), ErrSenderDenied)
+
+	// the new policy is applied once reloaded
+	writePolicy(`{"allowlist": ["` + addr1.String() + `"]}`)
+	assert.ErrorIs(t, manager.Admit(types.Hash{}, &types.Header{}, tx), ErrSenderDenied)
+
+	policy, err := manager.Reload()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{addr1}, policy.Allowlist)
+	assert.NoError(t, manager.Admit(types.Hash{}, &types.Header{}, tx))
+
+	// a malformed file keeps the current policy
+	writePolicy(`{"allowlist": [`)
+
+	_, err = manager.Reload()
+	assert.Error(t, err)
+	assert.NoError(t, manager.Admit(types.Hash{}, &types.Header{}, tx))
+}
@@ -0,0 +1,130 @@
+package admission
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func nullLogger() hclog.Logger {
+	return hclog.NewNullLogger()
+}
+
+// registryCode returns the code of a registry contract
+// returning the given address for any list
+func registryCode(addr types.Address) []byte {
+	code := []byte{
+		0x60, 0x60, // PUSH1 size
+		0x60, 0x0c, // PUSH1 offset of the data
+		0x60, 0x00, // PUSH1 memory offset
+		0x39,       // CODECOPY
+		0x60, 0x60, // PUSH1 size
+		0x60, 0x00, // PUSH1 memory offset
+		0xf3, // RETURN
+	}
+
+	// abi encoded address[] with one element
+	data := make([]byte, 0x60)
+	data[0x1f] = 0x20
+	data[0x3f] = 0x01
+	copy(data[0x4c:], addr.Bytes())
+
+	return append(code, data...)
+}
+
+func newTestExecutor(t *testing.T, alloc map[types.Address]*chain.GenesisAccount) (*state.Executor, types.Hash) {
+	t.Helper()
+
+	executor := state.NewExecutor(
+		&chain.Params{Forks: chain.AllForksEnabled, ChainID: 100},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		nullLogger(),
+	)
+	executor.SetRuntime(evm.NewEVM())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.Hash{}
+		}
+	}
+
+	return executor, executor.WriteGenesis(alloc)
+}
+
+func TestRegistryManager(t *testing.T) {
+	registry := types.StringToAddress("1100")
+
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		registry: {
+			Code: registryCode(addr1),
+		},
+	})
+
+	manager := NewRegistryManager(nullLogger(), registry, executor)
+
+	policy, err := manager.PolicyAt(root, &types.Header{Number: 1})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []types.Address{addr1}, policy.Allowlist)
+	assert.Equal(t, []types.Address{addr1}, policy.Denylist)
+	assert.Equal(t, []types.Address{addr1}, policy.DeployAllowlist)
+
+	// the registry policy can't be reloaded
+	_, err = manager.Reload()
+	assert.ErrorIs(t, err, ErrReloadNotSupported)
+}
+
+func TestRegistryManager_MissingRegistry(t *testing.T) {
+	executor, root := newTestExecutor(t, nil)
+
+	manager := NewRegistryManager(nullLogger(), types.StringToAddress("1100"), executor)
+
+	// transactions are rejected if the policy can't be read
+	assert.Error(t, manager.Admit(root, &types.Header{Number: 1}, &types.Transaction{From: addr1, To: &addr2}))
+}
+
+func TestExecutor_EnforcesPolicy(t *testing.T) {
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		addr1: {Balance: big.NewInt(1000000000)},
+		addr3: {Balance: big.NewInt(1000000000)},
+	})
+	executor.AdmissionPolicy = &Manager{
+		logger: nullLogger(),
+		policy: NewPolicy(nil, []types.Address{addr3}, nil),
+	}
+
+	header := &types.Header{Number: 1, GasLimit: 1000000}
+
+	newTx := func(from types.Address) *types.Transaction {
+		return &types.Transaction{
+			From:     from,
+			To:       &addr2,
+			Value:    big.NewInt(1),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+		}
+	}
+
+	transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	assert.NoError(t, transition.Write(newTx(addr1)))
+	err = transition.Write(newTx(addr3))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), ErrSenderDenied.Error())
+	}
+
+	// blocks with denied transactions are rejected
+	block := &types.Block{
+		Header:       header,
+		Transactions: []*types.Transaction{newTx(addr1), newTx(addr3)},
+	}
+
+	assert.ErrorIs(t, executor.CheckAdmission(&types.Header{StateRoot: root}, block), ErrSenderDenied)
+}
@@ -51,6 +51,20 @@ type Blockchain struct {
 	agpMux sync.Mutex // Mutex for the averageGasPrice calculation
 }
 
+// InvalidBlockError is returned when writing a block which fails the validation,
+// as opposed to the blocks which can't be written because of the local state
+type InvalidBlockError struct {
+	Err error
+}
+
+func (e *InvalidBlockError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InvalidBlockError) Unwrap() error {
+	return e.Err
+}
+
 type Verifier interface {
 	VerifyHeader(parent, header *types.Header) error
 	GetBlockCreator(header *types.Header) (types.Address, error)
@@ -567,24 +581,24 @@ func (b *Blockchain) WriteBlock(block *types.Block) error {
 
 	// Verify the header
 	if err := b.consensus.VerifyHeader(parent, block.Header); err != nil {
-		return fmt.Errorf("failed to verify the header: %w", err)
+		return &InvalidBlockError{fmt.Errorf("failed to verify the header: %w", err)}
 	}
 
 	// Verify body data
 	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
-		return fmt.Errorf(
+		return &InvalidBlockError{fmt.Errorf(
 			"uncle root hash mismatch: have %s, want %s",
 			hash,
 			block.Header.Sha3Uncles,
-		)
+		)}
 	}
 
 	if hash := buildroot.CalculateTransactionsRoot(block.Transactions); hash != block.Header.TxRoot {
-		return fmt.Errorf(
+		return &InvalidBlockError{fmt.Errorf(
 			"transaction root hash mismatch: have %s, want %s",
 			hash,
 			block.Header.TxRoot,
-		)
+		)}
 	}
 
 	// Checks are passed, write the chain
@@ -678,30 +692,36 @@ func (b *Blockchain) processBlock(block *types.Block) (*state.BlockResult, error
 
 	result, err := b.executor.ProcessBlock(parent.StateRoot, block, blockCreator)
 	if err != nil {
+		// only the rejected transactions invalidate the block, as opposed
+		// to the failures of the node itself (e.g. missing state)
+		if state.IsInvalidTxError(err) {
+			return nil, &InvalidBlockError{err}
+		}
+
 		return nil, err
 	}
 
 	receipts := result.Receipts
 	if len(receipts) != len(block.Transactions) {
-		return nil, fmt.Errorf("bad size of receipts and transactions")
+		return nil, &InvalidBlockError{fmt.Errorf("bad size of receipts and transactions")}
 	}
 
 	// Validate the fields
 	if result.Root != header.StateRoot {
-		return nil, fmt.Errorf("invalid merkle root")
+		return nil, &InvalidBlockError{fmt.Errorf("invalid merkle root")}
 	}
 
 	if result.TotalGas != header.GasUsed {
-		return nil, fmt.Errorf("gas used is different")
+		return nil, &InvalidBlockError{fmt.Errorf("gas used is different")}
 	}
 
 	receiptSha := buildroot.CalculateReceiptsRoot(result.Receipts)
 	if receiptSha != header.ReceiptsRoot {
-		return nil, fmt.Errorf("invalid receipts root")
+		return nil, &InvalidBlockError{fmt.Errorf("invalid receipts root")}
 	}
 
 	if gasLimitErr := b.verifyGasLimit(header); gasLimitErr != nil {
-		return nil, fmt.Errorf("invalid gas limit, %w", gasLimitErr)
+		return nil, &InvalidBlockError{fmt.Errorf("invalid gas limit, %w", gasLimitErr)}
 	}
 
 	return result, nil
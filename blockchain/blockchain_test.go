@@ -12,6 +12,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -620,3 +621,71 @@ func TestCalculateGasLimit(t *testing.T) {
 		})
 	}
 }
+
+type errExecutor struct {
+	err error
+}
+
+func (e *errExecutor) ProcessBlock(
+	parentRoot types.Hash,
+	block *types.Block,
+	blockCreator types.Address,
+) (*state.BlockResult, error) {
+	return nil, e.err
+}
+
+func TestProcessBlock_InvalidBlockError(t *testing.T) {
+	executor := &errExecutor{}
+
+	b, err := newBlockChain(&chain.Chain{
+		Genesis: &chain.Genesis{},
+		Params: &chain.Params{
+			BlockGasTarget: defaultBlockGasTarget,
+		},
+	}, executor)
+	assert.NoError(t, err)
+
+	block := &types.Block{
+		Header: &types.Header{
+			ParentHash: b.Header().Hash,
+			Number:     1,
+		},
+	}
+
+	testTable := []struct {
+		name    string
+		err     error
+		invalid bool
+	}{
+		{
+			"rejected transaction",
+			state.NewTransitionApplicationError(state.ErrNonceIncorrect, true),
+			true,
+		},
+		{
+			"block gas limit reached",
+			state.NewGasLimitReachedTransitionApplicationError(state.ErrBlockLimitReached),
+			true,
+		},
+		{
+			"missing state",
+			errors.New("state not found"),
+			false,
+		},
+		{
+			"registry query failure",
+			fmt.Errorf("%w: execution reverted", state.ErrRegistryQuery),
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		executor.err = testCase.err
+
+		_, err := b.processBlock(block)
+		assert.Error(t, err, testCase.name)
+
+		var invalidErr *InvalidBlockError
+		assert.Equal(t, testCase.invalid, errors.As(err, &invalidErr), testCase.name)
+	}
+}
@@ -2,6 +2,8 @@ package chain
 
 import (
 	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 // Params are all the set of params for the chain
@@ -10,6 +12,37 @@ type Params struct {
 	ChainID        int                    `json:"chainID"`
 	Engine         map[string]interface{} `json:"engine"`
 	BlockGasTarget uint64                 `json:"blockGasTarget"`
+
+	// Admission enables the transaction admission policy of permissioned chains
+	Admission *AdmissionParams `json:"admission,omitempty"`
+
+	// FeeDelegation enables the transactions with the gas paid by a sponsor
+	FeeDelegation *FeeDelegationParams `json:"feeDelegation,omitempty"`
+
+	// NodeRegistry restricts the network connections to the registered nodes
+	NodeRegistry *NodeRegistryParams `json:"nodeRegistry,omitempty"`
+}
+
+// AdmissionParams defines the source of the transaction admission policy
+type AdmissionParams struct {
+	// Registry is the address of the registry contract holding the policy,
+	// which needs to be deployed in the genesis
+	Registry types.Address `json:"registry"`
+}
+
+// FeeDelegationParams defines the sponsors allowed to pay for the gas of other accounts
+type FeeDelegationParams struct {
+	// Registry is the address of the registry contract holding the whitelisted
+	// sponsors, which needs to be deployed in the genesis
+	Registry types.Address `json:"registry"`
+}
+
+// NodeRegistryParams defines the nodes allowed to connect to each other
+type NodeRegistryParams struct {
+	// Registry is the address of the registry contract holding the libp2p
+	// IDs of the allowed nodes, which needs to be deployed in the genesis.
+	// The bootnodes are always allowed, so that new nodes can sync the chain
+	Registry types.Address `json:"registry"`
 }
 
 func (p *Params) GetEngine() string {
@@ -31,6 +64,10 @@ type Forks struct {
 	EIP150         *Fork `json:"EIP150,omitempty"`
 	EIP158         *Fork `json:"EIP158,omitempty"`
 	EIP155         *Fork `json:"EIP155,omitempty"`
+
+	// FeeDelegation enables the transactions with the gas paid by a sponsor,
+	// which changes their signature and encoding
+	FeeDelegation *Fork `json:"feeDelegation,omitempty"`
 }
 
 func (f *Forks) active(ff *Fork, block uint64) bool {
@@ -69,6 +106,10 @@ func (f *Forks) IsEIP155(block uint64) bool {
 	return f.active(f.EIP155, block)
 }
 
+func (f *Forks) IsFeeDelegation(block uint64) bool {
+	return f.active(f.FeeDelegation, block)
+}
+
 func (f *Forks) At(block uint64) ForksInTime {
 	return ForksInTime{
 		Homestead:      f.active(f.Homestead, block),
@@ -79,6 +120,7 @@ func (f *Forks) At(block uint64) ForksInTime {
 		EIP150:         f.active(f.EIP150, block),
 		EIP158:         f.active(f.EIP158, block),
 		EIP155:         f.active(f.EIP155, block),
+		FeeDelegation:  f.active(f.FeeDelegation, block),
 	}
 }
 
@@ -106,7 +148,8 @@ type ForksInTime struct {
 	Istanbul,
 	EIP150,
 	EIP158,
-	EIP155 bool
+	EIP155,
+	FeeDelegation bool
 }
 
 var AllForksEnabled = &Forks{
@@ -56,6 +56,52 @@ func (d *DevCommand) DefineFlags() {
 		FlagOptional: true,
 	}
 
+	d.FlagMap["price-bump"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the minimum gas price increase (in percent) required to replace "+
+				"a transaction with the same nonce in the pool. Default: %d",
+			helper.DefaultConfig().TxPool.PriceBump,
+		),
+		Arguments: []string{
+			"PRICE_BUMP",
+		},
+		FlagOptional: true,
+	}
+
+	d.FlagMap["max-account-enqueued"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum number of enqueued transactions per account (0 for no limit). Default: %d",
+			helper.DefaultConfig().TxPool.MaxAccountEnqueued,
+		),
+		Arguments: []string{
+			"MAX_ACCOUNT_ENQUEUED",
+		},
+		FlagOptional: true,
+	}
+
+	d.FlagMap["max-account-promoted"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum number of promoted transactions per account (0 for no limit). Default: %d",
+			helper.DefaultConfig().TxPool.MaxAccountPromoted,
+		),
+		Arguments: []string{
+			"MAX_ACCOUNT_PROMOTED",
+		},
+		FlagOptional: true,
+	}
+
+	d.FlagMap["enqueued-lifetime"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the time (in seconds) enqueued transactions with nonce gaps are kept in the pool "+
+				"without any account activity (0 to keep them forever). Default: %d",
+			helper.DefaultConfig().TxPool.EnqueuedLifetime,
+		),
+		Arguments: []string{
+			"ENQUEUED_LIFETIME",
+		},
+		FlagOptional: true,
+	}
+
 	d.FlagMap["block-gas-limit"] = helper.FlagDescriptor{
 		Description: "Sets the gas limit of each block. Default: 5000",
 		Arguments: []string{
@@ -0,0 +1,45 @@
+package devnet
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/mitchellh/cli"
+)
+
+// defaultDir is the directory of the devnet if not set
+const defaultDir = "devnet"
+
+// DevnetCommand is the top level command for the local devnets
+type DevnetCommand struct {
+}
+
+// Help implements the cli.Command interface
+func (c *DevnetCommand) Help() string {
+	return c.Synopsis()
+}
+
+func (c *DevnetCommand) GetBaseCommand() string {
+	return "devnet"
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DevnetCommand) Synopsis() string {
+	return "Top level command for the local devnets described by a topology file. Only accepts subcommands"
+}
+
+// Run implements the cli.Command interface
+func (c *DevnetCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+// defineDirFlag defines the flag of the devnet directory
+func defineDirFlag(flagMap map[string]helper.FlagDescriptor) {
+	flagMap["dir"] = helper.FlagDescriptor{
+		Description: "Sets the directory of the devnet, holding the genesis, the state of the devnet " +
+			"and the data directories of the nodes. Default: " + defaultDir,
+		Arguments: []string{
+			"DIRECTORY",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
@@ -0,0 +1,149 @@
+package devnet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+// DevnetReset is the command to reset the chain of the devnet
+type DevnetReset struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+}
+
+func (c *DevnetReset) DefineFlags() {
+	c.Base.DefineFlags(c.Formatter)
+
+	defineDirFlag(c.FlagMap)
+
+	c.FlagMap["all"] = helper.FlagDescriptor{
+		Description: "Removes the whole devnet directory, including the keys of the nodes and the genesis, " +
+			"so that the next start initializes a new devnet",
+		Arguments: []string{
+			"ALL",
+		},
+		ArgumentsOptional: true,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (c *DevnetReset) GetHelperText() string {
+	return "Resets the chain of the stopped devnet, removing the chain data and the logs of the nodes " +
+		"while keeping their keys and the genesis"
+}
+
+func (c *DevnetReset) GetBaseCommand() string {
+	return "devnet reset"
+}
+
+// Help implements the cli.Command interface
+func (c *DevnetReset) Help() string {
+	c.DefineFlags()
+
+	return helper.GenerateHelp(c.Synopsis(), helper.GenerateUsage(c.GetBaseCommand(), c.FlagMap), c.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DevnetReset) Synopsis() string {
+	return c.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (c *DevnetReset) Run(args []string) int {
+	flags := c.Base.NewFlagSet(c.GetBaseCommand(), c.Formatter)
+
+	var (
+		dir string
+		all bool
+	)
+
+	flags.StringVar(&dir, "dir", defaultDir, "")
+	flags.BoolVar(&all, "all", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	state, err := readState(dir)
+
+	switch {
+	case errors.Is(err, ErrNotInitialized) && all:
+		// the directory of a devnet which failed to initialize has no state
+		state = &State{}
+	case err != nil:
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if state.running() {
+		c.Formatter.OutputError(ErrDevnetRunning)
+
+		return 1
+	}
+
+	for _, node := range state.Nodes {
+		if node.PID != 0 && processAlive(node.PID) {
+			c.Formatter.OutputError(fmt.Errorf("%w, %s is running", ErrDevnetRunning, node.Name))
+
+			return 1
+		}
+	}
+
+	if all {
+		if err := os.RemoveAll(dir); err != nil {
+			c.Formatter.OutputError(fmt.Errorf("unable to remove the devnet directory, %w", err))
+
+			return 1
+		}
+	} else {
+		if err := resetState(state); err != nil {
+			c.Formatter.OutputError(err)
+
+			return 1
+		}
+
+		if err := writeState(dir, state); err != nil {
+			c.Formatter.OutputError(err)
+
+			return 1
+		}
+	}
+
+	c.Formatter.OutputResult(&DevnetResetResult{
+		Dir: dir,
+		All: all,
+	})
+
+	return 0
+}
+
+type DevnetResetResult struct {
+	Dir string `json:"dir"`
+	All bool   `json:"all"`
+}
+
+func (r *DevnetResetResult) Output() string {
+	var buffer bytes.Buffer
+
+	removed := "chain data and logs of the nodes"
+	if r.All {
+		removed = "whole devnet directory"
+	}
+
+	buffer.WriteString("\n[DEVNET RESET]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Directory|%s", r.Dir),
+		fmt.Sprintf("Removed|%s", removed),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
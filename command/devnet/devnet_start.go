@@ -0,0 +1,179 @@
+package devnet
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/hashicorp/go-hclog"
+)
+
+// DevnetStart is the command to launch the nodes of the devnet and supervise them
+type DevnetStart struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+}
+
+func (c *DevnetStart) DefineFlags() {
+	c.Base.DefineFlags(c.Formatter)
+
+	defineDirFlag(c.FlagMap)
+
+	c.FlagMap["config"] = helper.FlagDescriptor{
+		Description: "Sets the path to the JSON or YAML topology of the devnet, with the number of validators " +
+			"and non-validators, the mechanism, the premined accounts, the block gas limit and the ports. " +
+			"Required to initialize the devnet, which generates the keys of the nodes and the genesis",
+		Arguments: []string{
+			"TOPOLOGY_FILE",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	c.FlagMap["binary"] = helper.FlagDescriptor{
+		Description: "Sets the path to the Polygon Edge binary running the nodes. Default: the current binary",
+		Arguments: []string{
+			"BINARY",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (c *DevnetStart) GetHelperText() string {
+	return "Initializes the devnet if needed, then launches its nodes and restarts the ones which exit " +
+		"until it is stopped"
+}
+
+func (c *DevnetStart) GetBaseCommand() string {
+	return "devnet start"
+}
+
+// Help implements the cli.Command interface
+func (c *DevnetStart) Help() string {
+	c.DefineFlags()
+
+	return helper.GenerateHelp(c.Synopsis(), helper.GenerateUsage(c.GetBaseCommand(), c.FlagMap), c.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DevnetStart) Synopsis() string {
+	return c.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (c *DevnetStart) Run(args []string) int {
+	flags := c.Base.NewFlagSet(c.GetBaseCommand(), c.Formatter)
+
+	var (
+		dir          string
+		topologyPath string
+		binary       string
+	)
+
+	flags.StringVar(&dir, "dir", defaultDir, "")
+	flags.StringVar(&topologyPath, "config", "", "")
+	flags.StringVar(&binary, "binary", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	var topology *Topology
+
+	if topologyPath != "" {
+		var err error
+
+		if topology, err = ReadTopology(topologyPath); err != nil {
+			c.Formatter.OutputError(err)
+
+			return 1
+		}
+	}
+
+	if binary == "" {
+		var err error
+
+		if binary, err = os.Executable(); err != nil {
+			c.Formatter.OutputError(fmt.Errorf("unable to find the current binary, %w", err))
+
+			return 1
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		c.Formatter.OutputError(fmt.Errorf("unable to create the devnet directory, %w", err))
+
+		return 1
+	}
+
+	state, err := loadOrInitState(dir, topology)
+	if err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if state.running() {
+		c.Formatter.OutputError(ErrAlreadyRunning)
+
+		return 1
+	}
+
+	// the nodes left running by a supervisor which didn't stop them hold the ports
+	for _, node := range state.Nodes {
+		if node.PID != 0 && processAlive(node.PID) {
+			c.Formatter.OutputError(fmt.Errorf("%w, %s is running", ErrDevnetRunning, node.Name))
+
+			return 1
+		}
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "polygon",
+		Level: hclog.LevelFromString(state.Topology.LogLevel),
+	})
+
+	nodeSupervisor := newSupervisor(logger, dir, binary, state)
+	if err := nodeSupervisor.start(); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	c.Formatter.OutputResult(nodeSupervisor.status())
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	sig := <-signalCh
+
+	output := fmt.Sprintf("\n[SIGNAL] Caught signal: %v\n", sig)
+	output += "Stopping the nodes of the devnet...\n"
+
+	c.UI.Output(output)
+
+	stopCh := make(chan error, 1)
+
+	go func() {
+		stopCh <- nodeSupervisor.stop()
+	}()
+
+	select {
+	case <-signalCh:
+		return 1
+	case err := <-stopCh:
+		if err != nil {
+			c.Formatter.OutputError(err)
+
+			return 1
+		}
+
+		return 0
+	}
+}
@@ -0,0 +1,246 @@
+package devnet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// nodeQueryTimeout is the time given to a node to report its status
+const nodeQueryTimeout = 3 * time.Second
+
+// DevnetStatus is the command to query the status of the devnet and its nodes
+type DevnetStatus struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+}
+
+func (c *DevnetStatus) DefineFlags() {
+	c.Base.DefineFlags(c.Formatter)
+
+	defineDirFlag(c.FlagMap)
+}
+
+// GetHelperText returns a simple description of the command
+func (c *DevnetStatus) GetHelperText() string {
+	return "Returns the status of the devnet, with the block height and the peers of its running nodes"
+}
+
+func (c *DevnetStatus) GetBaseCommand() string {
+	return "devnet status"
+}
+
+// Help implements the cli.Command interface
+func (c *DevnetStatus) Help() string {
+	c.DefineFlags()
+
+	return helper.GenerateHelp(c.Synopsis(), helper.GenerateUsage(c.GetBaseCommand(), c.FlagMap), c.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DevnetStatus) Synopsis() string {
+	return c.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (c *DevnetStatus) Run(args []string) int {
+	flags := c.Base.NewFlagSet(c.GetBaseCommand(), c.Formatter)
+
+	var dir string
+
+	flags.StringVar(&dir, "dir", defaultDir, "")
+
+	if err := flags.Parse(args); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	state, err := readState(dir)
+	if err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	res := newDevnetStatusResult(dir, state)
+
+	for i, node := range state.Nodes {
+		if res.Nodes[i].Running {
+			res.Nodes[i].queryNode(node)
+		}
+	}
+
+	c.Formatter.OutputResult(res)
+
+	return 0
+}
+
+type NodeStatus struct {
+	Name        string `json:"name"`
+	Validator   bool   `json:"validator"`
+	Address     string `json:"address"`
+	NodeID      string `json:"node_id"`
+	Running     bool   `json:"running"`
+	PID         int    `json:"pid,omitempty"`
+	Restarts    uint64 `json:"restarts"`
+	GRPCAddr    string `json:"grpc_addr"`
+	JSONRPCAddr string `json:"jsonrpc_addr"`
+	LibP2PAddr  string `json:"libp2p_addr"`
+	LogFile     string `json:"log_file"`
+
+	// BlockNumber and Peers are reported by the running nodes
+	BlockNumber *int64 `json:"block_number,omitempty"`
+	Peers       *int   `json:"peers,omitempty"`
+
+	// Error is the error of the query of a running node
+	Error string `json:"error,omitempty"`
+}
+
+// queryNode queries the block height and the peers of the node
+func (s *NodeStatus) queryNode(node *Node) {
+	conn, err := (&helper.GRPCFlag{Addr: node.GRPCAddr}).Conn()
+	if err != nil {
+		s.Error = err.Error()
+
+		return
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), nodeQueryTimeout)
+	defer cancel()
+
+	client := proto.NewSystemClient(conn)
+
+	status, err := client.GetStatus(ctx, &empty.Empty{})
+	if err != nil {
+		s.Error = err.Error()
+
+		return
+	}
+
+	peers, err := client.PeersList(ctx, &empty.Empty{})
+	if err != nil {
+		s.Error = err.Error()
+
+		return
+	}
+
+	blockNumber := status.GetCurrent().GetNumber()
+	peerCount := len(peers.GetPeers())
+
+	s.BlockNumber = &blockNumber
+	s.Peers = &peerCount
+}
+
+type DevnetStatusResult struct {
+	Dir       string        `json:"dir"`
+	Name      string        `json:"name"`
+	ChainID   uint64        `json:"chain_id"`
+	Mechanism string        `json:"mechanism"`
+	Running   bool          `json:"running"`
+	PID       int           `json:"pid,omitempty"`
+	Nodes     []*NodeStatus `json:"nodes"`
+}
+
+func newDevnetStatusResult(dir string, state *State) *DevnetStatusResult {
+	res := &DevnetStatusResult{
+		Dir:       dir,
+		Name:      state.Topology.Name,
+		ChainID:   state.Topology.ChainID,
+		Mechanism: state.Topology.Mechanism,
+		Running:   state.running(),
+		Nodes:     make([]*NodeStatus, len(state.Nodes)),
+	}
+
+	if res.Running {
+		res.PID = state.PID
+	}
+
+	for i, node := range state.Nodes {
+		res.Nodes[i] = &NodeStatus{
+			Name:        node.Name,
+			Validator:   node.Validator,
+			Address:     node.Address.String(),
+			NodeID:      node.NodeID,
+			Running:     node.PID != 0 && processAlive(node.PID),
+			Restarts:    node.Restarts,
+			GRPCAddr:    node.GRPCAddr,
+			JSONRPCAddr: node.JSONRPCAddr,
+			LibP2PAddr:  node.LibP2PAddr,
+			LogFile:     node.logPath(),
+		}
+
+		if res.Nodes[i].Running {
+			res.Nodes[i].PID = node.PID
+		}
+	}
+
+	return res
+}
+
+func (r *DevnetStatusResult) Output() string {
+	var buffer bytes.Buffer
+
+	supervisor := "stopped"
+	if r.Running {
+		supervisor = fmt.Sprintf("running (pid %d)", r.PID)
+	}
+
+	buffer.WriteString("\n[DEVNET STATUS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Directory|%s", r.Dir),
+		fmt.Sprintf("Chain|%s (%d)", r.Name, r.ChainID),
+		fmt.Sprintf("Mechanism|%s", r.Mechanism),
+		fmt.Sprintf("Supervisor|%s", supervisor),
+	}))
+
+	for _, node := range r.Nodes {
+		role := "non-validator"
+		if node.Validator {
+			role = "validator"
+		}
+
+		status := "stopped"
+		if node.Running {
+			status = fmt.Sprintf("running (pid %d)", node.PID)
+		}
+
+		rows := []string{
+			fmt.Sprintf("Role|%s", role),
+			fmt.Sprintf("Status|%s", status),
+			fmt.Sprintf("Restarts|%d", node.Restarts),
+			fmt.Sprintf("Address|%s", node.Address),
+			fmt.Sprintf("Node ID|%s", node.NodeID),
+			fmt.Sprintf("gRPC|%s", node.GRPCAddr),
+			fmt.Sprintf("JSON-RPC|%s", node.JSONRPCAddr),
+			fmt.Sprintf("libp2p|%s", node.LibP2PAddr),
+			fmt.Sprintf("Log|%s", node.LogFile),
+		}
+
+		if node.BlockNumber != nil {
+			rows = append(rows, fmt.Sprintf("Block number|%d", *node.BlockNumber))
+		}
+
+		if node.Peers != nil {
+			rows = append(rows, fmt.Sprintf("Peers|%d", *node.Peers))
+		}
+
+		if node.Error != "" {
+			rows = append(rows, fmt.Sprintf("Error|%s", node.Error))
+		}
+
+		buffer.WriteString(fmt.Sprintf("\n\n[%s]\n", node.Name))
+		buffer.WriteString(helper.FormatKV(rows))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,191 @@
+package devnet
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+const (
+	defaultStopTimeout = 30 * time.Second
+	stopPollInterval   = 100 * time.Millisecond
+)
+
+// DevnetStop is the command to stop the devnet
+type DevnetStop struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+}
+
+func (c *DevnetStop) DefineFlags() {
+	c.Base.DefineFlags(c.Formatter)
+
+	defineDirFlag(c.FlagMap)
+
+	c.FlagMap["timeout"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf("Sets the time to wait for the devnet to stop. Default: %s", defaultStopTimeout),
+		Arguments: []string{
+			"TIMEOUT",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (c *DevnetStop) GetHelperText() string {
+	return "Stops the supervisor of the devnet and its nodes"
+}
+
+func (c *DevnetStop) GetBaseCommand() string {
+	return "devnet stop"
+}
+
+// Help implements the cli.Command interface
+func (c *DevnetStop) Help() string {
+	c.DefineFlags()
+
+	return helper.GenerateHelp(c.Synopsis(), helper.GenerateUsage(c.GetBaseCommand(), c.FlagMap), c.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DevnetStop) Synopsis() string {
+	return c.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (c *DevnetStop) Run(args []string) int {
+	flags := c.Base.NewFlagSet(c.GetBaseCommand(), c.Formatter)
+
+	var (
+		dir     string
+		timeout time.Duration
+	)
+
+	flags.StringVar(&dir, "dir", defaultDir, "")
+	flags.DurationVar(&timeout, "timeout", defaultStopTimeout, "")
+
+	if err := flags.Parse(args); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	state, err := readState(dir)
+	if err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	res := &DevnetStopResult{
+		Dir: dir,
+	}
+
+	// the supervisor stops the nodes before exiting
+	if state.running() {
+		if err := stopProcess(state.PID, timeout); err != nil {
+			c.Formatter.OutputError(fmt.Errorf("unable to stop the supervisor, %w", err))
+
+			return 1
+		}
+
+		if state, err = readState(dir); err != nil {
+			c.Formatter.OutputError(err)
+
+			return 1
+		}
+
+		res.Supervisor = true
+	}
+
+	// the nodes are left running if the supervisor was killed
+	for _, node := range state.Nodes {
+		if node.PID != 0 && processAlive(node.PID) {
+			if err := stopProcess(node.PID, timeout); err != nil {
+				c.Formatter.OutputError(fmt.Errorf("unable to stop %s, %w", node.Name, err))
+
+				return 1
+			}
+
+			res.Nodes = append(res.Nodes, node.Name)
+		}
+
+		node.PID = 0
+	}
+
+	if !res.Supervisor && len(res.Nodes) == 0 {
+		c.Formatter.OutputError(ErrDevnetNotRunning)
+
+		return 1
+	}
+
+	state.PID = 0
+
+	if err := writeState(dir, state); err != nil {
+		c.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	c.Formatter.OutputResult(res)
+
+	return 0
+}
+
+// stopProcess terminates the process, waiting for it to exit
+func stopProcess(pid int, timeout time.Duration) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	if err := terminateProcess(process); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for processAlive(pid) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("process %d did not exit in %s", pid, timeout)
+		}
+
+		time.Sleep(stopPollInterval)
+	}
+
+	return nil
+}
+
+type DevnetStopResult struct {
+	Dir        string `json:"dir"`
+	Supervisor bool   `json:"supervisor"`
+
+	// Nodes are the nodes left running without a supervisor, stopped directly
+	Nodes []string `json:"nodes,omitempty"`
+}
+
+func (r *DevnetStopResult) Output() string {
+	var buffer bytes.Buffer
+
+	rows := []string{
+		fmt.Sprintf("Directory|%s", r.Dir),
+	}
+
+	if r.Supervisor {
+		rows = append(rows, "Supervisor|stopped with its nodes")
+	}
+
+	for _, node := range r.Nodes {
+		rows = append(rows, fmt.Sprintf("Node without supervisor|%s stopped", node))
+	}
+
+	buffer.WriteString("\n[DEVNET STOP]\n")
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
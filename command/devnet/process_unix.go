@@ -0,0 +1,24 @@
+//go:build !windows
+// +build !windows
+
+package devnet
+
+import (
+	"os"
+	"syscall"
+)
+
+// processAlive returns true if the process is running
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// terminateProcess asks the process to shut down gracefully
+func terminateProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
@@ -0,0 +1,25 @@
+//go:build windows
+// +build windows
+
+package devnet
+
+import (
+	"os"
+)
+
+// processAlive returns true if the process is running
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	_ = process.Release()
+
+	return true
+}
+
+// terminateProcess kills the process, as the signals can't be sent on Windows
+func terminateProcess(process *os.Process) error {
+	return process.Kill()
+}
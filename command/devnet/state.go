@@ -0,0 +1,281 @@
+package devnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/genesis"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	stateFile = "devnet.json"
+	logFile   = "node.log"
+)
+
+var (
+	ErrNotInitialized    = errors.New("the devnet is not initialized")
+	ErrTopologyChanged   = errors.New("the devnet was initialized with another topology, reset it with --all first")
+	ErrDevnetRunning     = errors.New("the devnet is running, stop it first")
+	ErrDevnetNotRunning  = errors.New("the devnet is not running")
+	ErrAlreadyRunning    = errors.New("the devnet is already running")
+	ErrPartialDevnetData = errors.New("the devnet directory has node data without a devnet state, reset it with --all")
+)
+
+// chainDataPaths are the paths of the chain data in the data directory of a node,
+// removed by the reset which keeps the keys of the node
+var chainDataPaths = []string{
+	"blockchain",
+	"trie",
+	"txpool",
+	filepath.Join(secrets.ConsensusFolderLocal, "metadata"),
+	filepath.Join(secrets.ConsensusFolderLocal, "snapshots"),
+}
+
+// Node is a node of the devnet
+type Node struct {
+	Name           string        `json:"name"`
+	Validator      bool          `json:"validator"`
+	Address        types.Address `json:"address"`
+	NodeID         string        `json:"node_id"`
+	DataDir        string        `json:"data_dir"`
+	GRPCAddr       string        `json:"grpc_addr"`
+	JSONRPCAddr    string        `json:"jsonrpc_addr"`
+	LibP2PAddr     string        `json:"libp2p_addr"`
+	PrometheusAddr string        `json:"prometheus_addr,omitempty"`
+
+	// PID is the process of the running node, zero if it is stopped
+	PID int `json:"pid"`
+
+	// Restarts is the number of times the node was restarted by the supervisor
+	Restarts uint64 `json:"restarts"`
+}
+
+// multiaddr returns the libp2p address of the node, including its ID
+func (n *Node) multiaddr() string {
+	host, port, _ := net.SplitHostPort(n.LibP2PAddr)
+
+	protocol := "ip4"
+	if net.ParseIP(host).To4() == nil {
+		protocol = "ip6"
+	}
+
+	return fmt.Sprintf("/%s/%s/tcp/%s/p2p/%s", protocol, host, port, n.NodeID)
+}
+
+func (n *Node) logPath() string {
+	return filepath.Join(n.DataDir, logFile)
+}
+
+// State is the state of the devnet, persisted in its directory
+type State struct {
+	Topology *Topology `json:"topology"`
+
+	// PID is the process of the supervisor of the nodes, zero if the devnet is stopped
+	PID int `json:"pid"`
+
+	Nodes []*Node `json:"nodes"`
+}
+
+// running returns true if the supervisor of the devnet is running
+func (s *State) running() bool {
+	return s.PID != 0 && processAlive(s.PID)
+}
+
+func statePath(dir string) string {
+	return filepath.Join(dir, stateFile)
+}
+
+func genesisPath(dir string) string {
+	return filepath.Join(dir, helper.GenesisFileName)
+}
+
+// readState reads the state of the devnet in the directory
+func readState(dir string) (*State, error) {
+	raw, err := ioutil.ReadFile(statePath(dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotInitialized
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the devnet state, %w", err)
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(raw, state); err != nil {
+		return nil, fmt.Errorf("unable to decode the devnet state, %w", err)
+	}
+
+	return state, nil
+}
+
+// writeState writes the state of the devnet, replacing the previous one at once
+func writeState(dir string, state *State) error {
+	raw, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := statePath(dir)
+	if err := ioutil.WriteFile(path+".new", raw, 0600); err != nil {
+		return fmt.Errorf("unable to write the devnet state, %w", err)
+	}
+
+	return os.Rename(path+".new", path)
+}
+
+// loadOrInitState returns the state of the devnet in the directory, generating
+// the keys of the nodes and the genesis of the topology if it is not initialized
+func loadOrInitState(dir string, topology *Topology) (*State, error) {
+	state, err := readState(dir)
+	if err == nil {
+		if topology != nil && !reflect.DeepEqual(topology, state.Topology) {
+			return nil, ErrTopologyChanged
+		}
+
+		return state, nil
+	}
+
+	if !errors.Is(err, ErrNotInitialized) {
+		return nil, err
+	}
+
+	if topology == nil {
+		return nil, fmt.Errorf("%w, the topology is required", ErrNotInitialized)
+	}
+
+	return initState(dir, topology)
+}
+
+// initState generates the keys of the nodes and the genesis of the topology
+func initState(dir string, topology *Topology) (*State, error) {
+	if _, err := os.Stat(genesisPath(dir)); err == nil {
+		return nil, ErrPartialDevnetData
+	}
+
+	state := &State{
+		Topology: topology,
+		Nodes:    topology.nodes(dir),
+	}
+
+	for _, node := range state.Nodes {
+		if err := initNodeSecrets(node); err != nil {
+			return nil, fmt.Errorf("unable to initialize the secrets of %s, %w", node.Name, err)
+		}
+	}
+
+	if err := generateGenesis(topology.genesisArgs(dir, state.Nodes)); err != nil {
+		return nil, err
+	}
+
+	if err := writeState(dir, state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// initNodeSecrets generates the validator and networking keys of the node in its data directory
+func initNodeSecrets(node *Node) error {
+	for _, folder := range []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal} {
+		if _, err := os.Stat(filepath.Join(node.DataDir, folder)); err == nil {
+			return ErrPartialDevnetData
+		}
+	}
+
+	secretsManager, err := local.SecretsManagerFactory(
+		nil, // Local secrets manager doesn't require a config
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path: node.DataDir,
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodePrivateKey()
+	if err != nil {
+		return err
+	}
+
+	if err := secretsManager.SetSecret(secrets.ValidatorKey, validatorKeyEncoded); err != nil {
+		return err
+	}
+
+	libp2pKey, libp2pKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	if err != nil {
+		return err
+	}
+
+	if err := secretsManager.SetSecret(secrets.NetworkKey, libp2pKeyEncoded); err != nil {
+		return err
+	}
+
+	nodeID, err := peer.IDFromPrivateKey(libp2pKey)
+	if err != nil {
+		return err
+	}
+
+	node.Address = crypto.PubKeyToAddress(&validatorKey.PublicKey)
+	node.NodeID = nodeID.String()
+
+	return nil
+}
+
+// generateGenesis runs the genesis command with the arguments
+func generateGenesis(args []string) error {
+	var output bytes.Buffer
+
+	genesisCmd := genesis.GenesisCommand{
+		Base: helper.Base{
+			UI: &cli.BasicUi{
+				Writer:      &output,
+				ErrorWriter: &output,
+			},
+		},
+	}
+
+	if genesisCmd.Run(args) != 0 {
+		return fmt.Errorf("unable to generate the genesis, %s", strings.TrimSpace(output.String()))
+	}
+
+	return nil
+}
+
+// resetState removes the chain data and the logs of the nodes, keeping their keys and the genesis
+func resetState(state *State) error {
+	for _, node := range state.Nodes {
+		paths := append([]string{logFile}, chainDataPaths...)
+
+		for _, path := range paths {
+			if err := os.RemoveAll(filepath.Join(node.DataDir, path)); err != nil {
+				return fmt.Errorf("unable to reset %s, %w", node.Name, err)
+			}
+		}
+
+		node.PID = 0
+		node.Restarts = 0
+	}
+
+	state.PID = 0
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package devnet
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTopology() *Topology {
+	topology := &Topology{
+		Validators:    2,
+		NonValidators: 1,
+	}
+	topology.setDefaults()
+
+	return topology
+}
+
+func TestLoadOrInitState(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := loadOrInitState(dir, nil)
+	assert.True(t, errors.Is(err, ErrNotInitialized))
+
+	state, err := loadOrInitState(dir, newTestTopology())
+	assert.NoError(t, err)
+	assert.Len(t, state.Nodes, 3)
+
+	for _, node := range state.Nodes {
+		assert.NotEmpty(t, node.NodeID)
+		assert.FileExists(t, filepath.Join(node.DataDir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+		assert.FileExists(t, filepath.Join(node.DataDir, secrets.NetworkFolderLocal, secrets.NetworkKeyLocal))
+	}
+
+	genesis, err := chain.Import(genesisPath(dir))
+	assert.NoError(t, err)
+	assert.Len(t, genesis.Bootnodes, 3)
+
+	t.Run("reload", func(t *testing.T) {
+		loaded, err := loadOrInitState(dir, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, state, loaded)
+
+		loaded, err = loadOrInitState(dir, newTestTopology())
+		assert.NoError(t, err)
+		assert.Equal(t, state, loaded)
+	})
+
+	t.Run("topology changed", func(t *testing.T) {
+		topology := newTestTopology()
+		topology.Validators = 4
+
+		_, err := loadOrInitState(dir, topology)
+		assert.True(t, errors.Is(err, ErrTopologyChanged))
+	})
+
+	t.Run("partial data", func(t *testing.T) {
+		assert.NoError(t, os.Remove(statePath(dir)))
+
+		_, err := loadOrInitState(dir, newTestTopology())
+		assert.True(t, errors.Is(err, ErrPartialDevnetData))
+	})
+}
+
+func TestResetState(t *testing.T) {
+	dir := t.TempDir()
+
+	state, err := initState(dir, newTestTopology())
+	assert.NoError(t, err)
+
+	node := state.Nodes[0]
+	node.PID = 1
+	node.Restarts = 2
+
+	for _, path := range append([]string{logFile}, chainDataPaths...) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(node.DataDir, path), 0755))
+	}
+
+	assert.NoError(t, resetState(state))
+
+	for _, path := range append([]string{logFile}, chainDataPaths...) {
+		_, err := os.Stat(filepath.Join(node.DataDir, path))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	}
+
+	assert.FileExists(t, filepath.Join(node.DataDir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	assert.FileExists(t, filepath.Join(node.DataDir, secrets.NetworkFolderLocal, secrets.NetworkKeyLocal))
+	assert.FileExists(t, genesisPath(dir))
+	assert.Equal(t, 0, node.PID)
+	assert.Equal(t, uint64(0), node.Restarts)
+}
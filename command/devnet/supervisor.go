@@ -0,0 +1,242 @@
+package devnet
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	minRestartBackoff = time.Second
+	maxRestartBackoff = 30 * time.Second
+
+	// stableRunTime is the time after which a running node has its restart backoff reset
+	stableRunTime = time.Minute
+
+	// nodeStopTimeout is the time given to a node to shut down before it is killed
+	nodeStopTimeout = 10 * time.Second
+)
+
+var errNodeExited = errors.New("node exited")
+
+// supervisor launches the nodes of the devnet as child processes,
+// and restarts the ones which exit until it is stopped
+type supervisor struct {
+	logger hclog.Logger
+	dir    string
+	binary string
+
+	lock  sync.Mutex // lock guards the state
+	state *State
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newSupervisor(logger hclog.Logger, dir, binary string, state *State) *supervisor {
+	return &supervisor{
+		logger: logger.Named("devnet"),
+		dir:    dir,
+		binary: binary,
+		state:  state,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// nodeProcess is a running node
+type nodeProcess struct {
+	cmd     *exec.Cmd
+	logFile *os.File
+	exitCh  chan error
+}
+
+// start launches the nodes
+func (s *supervisor) start() error {
+	s.lock.Lock()
+	s.state.PID = os.Getpid()
+
+	for _, node := range s.state.Nodes {
+		node.PID = 0
+	}
+	s.lock.Unlock()
+
+	if err := s.saveState(); err != nil {
+		return err
+	}
+
+	for _, node := range s.state.Nodes {
+		process, err := s.launchNode(node)
+		if err != nil {
+			s.logger.Error("unable to launch node", "node", node.Name, "err", err)
+		}
+
+		s.wg.Add(1)
+
+		go s.supervise(node, process)
+	}
+
+	return nil
+}
+
+// stop stops the nodes, waiting for them to exit
+func (s *supervisor) stop() error {
+	close(s.stopCh)
+	s.wg.Wait()
+
+	s.lock.Lock()
+	s.state.PID = 0
+	s.lock.Unlock()
+
+	return s.saveState()
+}
+
+// supervise waits for the node to exit, relaunching it with an increasing backoff
+func (s *supervisor) supervise(node *Node, process *nodeProcess) {
+	defer s.wg.Done()
+
+	backoff := minRestartBackoff
+
+	for {
+		startTime := time.Now()
+
+		if process != nil {
+			if err := s.waitNode(node, process); err != nil {
+				s.logger.Error("node failed", "node", node.Name, "err", err)
+			}
+		}
+
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		if s.state.Topology.NoRestart {
+			s.logger.Warn("node is not restarted", "node", node.Name)
+
+			return
+		}
+
+		if time.Since(startTime) > stableRunTime {
+			backoff = minRestartBackoff
+		}
+
+		s.logger.Info("restarting node", "node", node.Name, "backoff", backoff)
+
+		select {
+		case <-s.stopCh:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+
+		s.lock.Lock()
+		node.Restarts++
+		s.lock.Unlock()
+
+		var err error
+		if process, err = s.launchNode(node); err != nil {
+			s.logger.Error("unable to launch node", "node", node.Name, "err", err)
+		}
+	}
+}
+
+// launchNode launches the node, logging its output to its log file
+func (s *supervisor) launchNode(node *Node) (*nodeProcess, error) {
+	logFile, err := os.OpenFile(node.logPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(s.binary, s.state.Topology.serverArgs(genesisPath(s.dir), node)...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+
+	if err := cmd.Start(); err != nil {
+		logFile.Close()
+
+		return nil, err
+	}
+
+	process := &nodeProcess{
+		cmd:     cmd,
+		logFile: logFile,
+		exitCh:  make(chan error, 1),
+	}
+
+	go func() {
+		process.exitCh <- cmd.Wait()
+	}()
+
+	s.setPID(node, cmd.Process.Pid)
+	s.logger.Info("node started", "node", node.Name, "pid", cmd.Process.Pid, "log", node.logPath())
+
+	return process, nil
+}
+
+// waitNode waits for the node to exit, or stops it when the supervisor is stopped
+func (s *supervisor) waitNode(node *Node, process *nodeProcess) error {
+	defer process.logFile.Close()
+	defer s.setPID(node, 0)
+
+	select {
+	case err := <-process.exitCh:
+		if err != nil {
+			return err
+		}
+
+		return errNodeExited
+	case <-s.stopCh:
+	}
+
+	if err := terminateProcess(process.cmd.Process); err != nil {
+		s.logger.Error("unable to terminate node", "node", node.Name, "err", err)
+	}
+
+	select {
+	case <-process.exitCh:
+	case <-time.After(nodeStopTimeout):
+		s.logger.Warn("node did not shut down in time, killing it", "node", node.Name)
+
+		_ = process.cmd.Process.Kill()
+		<-process.exitCh
+	}
+
+	s.logger.Info("node stopped", "node", node.Name)
+
+	return nil
+}
+
+// status returns the status of the devnet, without querying the nodes
+func (s *supervisor) status() *DevnetStatusResult {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return newDevnetStatusResult(s.dir, s.state)
+}
+
+// setPID sets the process of the node, saving the state
+func (s *supervisor) setPID(node *Node, pid int) {
+	s.lock.Lock()
+	node.PID = pid
+	s.lock.Unlock()
+
+	if err := s.saveState(); err != nil {
+		s.logger.Error("unable to save the devnet state", "err", err)
+	}
+}
+
+func (s *supervisor) saveState() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return writeState(s.dir, s.state)
+}
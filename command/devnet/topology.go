@@ -0,0 +1,300 @@
+package devnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/server"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/0xPolygon/polygon-edge/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultValidators  = 4
+	defaultHost        = "127.0.0.1"
+	defaultGRPCPort    = 10000
+	defaultJSONRPCPort = 20000
+	defaultLibP2PPort  = 30000
+	defaultLogLevel    = "INFO"
+
+	maxPort = 65535
+)
+
+var (
+	ErrInvalidTopology     = errors.New("invalid devnet topology")
+	ErrUnknownTopologyType = errors.New("unknown topology file type, expected .json, .yaml or .yml")
+)
+
+// Topology is the declarative description of a local devnet
+type Topology struct {
+	// Name and ChainID of the chain
+	Name    string `json:"name" yaml:"name"`
+	ChainID uint64 `json:"chain_id" yaml:"chain_id"`
+
+	// Mechanism is the IBFT consensus mechanism, PoA or PoS
+	Mechanism string `json:"mechanism" yaml:"mechanism"`
+
+	// Validators is the number of validators, and NonValidators
+	// the number of nodes which only sync the chain
+	Validators    uint64 `json:"validators" yaml:"validators"`
+	NonValidators uint64 `json:"non_validators" yaml:"non_validators"`
+
+	EpochSize     uint64 `json:"epoch_size" yaml:"epoch_size"`
+	BlockGasLimit uint64 `json:"block_gas_limit" yaml:"block_gas_limit"`
+
+	// Premine are the premined accounts, as ADDRESS:VALUE
+	Premine []string `json:"premine" yaml:"premine"`
+
+	// Host is the IP address the nodes listen on, and Ports the first port
+	// of each endpoint, incremented for each node
+	Host  string `json:"host" yaml:"host"`
+	Ports Ports  `json:"ports" yaml:"ports"`
+
+	LogLevel   string `json:"log_level" yaml:"log_level"`
+	PriceLimit uint64 `json:"price_limit" yaml:"price_limit"`
+
+	// NoRestart disables the restart of the nodes which exit
+	NoRestart bool `json:"no_restart" yaml:"no_restart"`
+}
+
+// Ports are the first ports of the endpoints of the nodes
+type Ports struct {
+	GRPC    uint64 `json:"grpc" yaml:"grpc"`
+	JSONRPC uint64 `json:"jsonrpc" yaml:"jsonrpc"`
+	LibP2P  uint64 `json:"libp2p" yaml:"libp2p"`
+
+	// Prometheus is disabled if not set
+	Prometheus uint64 `json:"prometheus" yaml:"prometheus"`
+}
+
+// ReadTopology reads the topology from the JSON or YAML file, filling in the defaults
+func ReadTopology(path string) (*Topology, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the topology, %w", err)
+	}
+
+	topology := &Topology{}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+
+		err = decoder.Decode(topology)
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(raw))
+		decoder.KnownFields(true)
+
+		err = decoder.Decode(topology)
+	default:
+		return nil, ErrUnknownTopologyType
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the topology, %w", err)
+	}
+
+	topology.setDefaults()
+
+	if err := topology.validate(); err != nil {
+		return nil, err
+	}
+
+	return topology, nil
+}
+
+func (t *Topology) setDefaults() {
+	if t.Name == "" {
+		t.Name = helper.DefaultChainName
+	}
+
+	if t.ChainID == 0 {
+		t.ChainID = helper.DefaultChainID
+	}
+
+	if t.Mechanism == "" {
+		t.Mechanism = string(ibft.PoA)
+	}
+
+	if t.Validators == 0 {
+		t.Validators = defaultValidators
+	}
+
+	if t.EpochSize == 0 {
+		t.EpochSize = ibft.DefaultEpochSize
+	}
+
+	if t.BlockGasLimit == 0 {
+		t.BlockGasLimit = helper.GenesisGasLimit
+	}
+
+	if t.Host == "" {
+		t.Host = defaultHost
+	}
+
+	if t.Ports.GRPC == 0 {
+		t.Ports.GRPC = defaultGRPCPort
+	}
+
+	if t.Ports.JSONRPC == 0 {
+		t.Ports.JSONRPC = defaultJSONRPCPort
+	}
+
+	if t.Ports.LibP2P == 0 {
+		t.Ports.LibP2P = defaultLibP2PPort
+	}
+
+	if t.LogLevel == "" {
+		t.LogLevel = defaultLogLevel
+	}
+}
+
+func (t *Topology) validate() error {
+	if !strings.EqualFold(t.Mechanism, string(ibft.PoA)) && !strings.EqualFold(t.Mechanism, string(ibft.PoS)) {
+		return fmt.Errorf("%w: unknown mechanism %s, expected PoA or PoS", ErrInvalidTopology, t.Mechanism)
+	}
+
+	if net.ParseIP(t.Host) == nil {
+		return fmt.Errorf("%w: the host %s is not an IP address", ErrInvalidTopology, t.Host)
+	}
+
+	if err := helper.FillPremineMap(map[types.Address]*chain.GenesisAccount{}, t.Premine); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTopology, err.Error())
+	}
+
+	// the port ranges of the endpoints can't overlap
+	nodes := t.nodeCount()
+	ranges := map[string]uint64{
+		"grpc":    t.Ports.GRPC,
+		"jsonrpc": t.Ports.JSONRPC,
+		"libp2p":  t.Ports.LibP2P,
+	}
+
+	if t.Ports.Prometheus != 0 {
+		ranges["prometheus"] = t.Ports.Prometheus
+	}
+
+	for name, first := range ranges {
+		if first+nodes-1 > maxPort {
+			return fmt.Errorf("%w: the %s ports exceed %d", ErrInvalidTopology, name, maxPort)
+		}
+
+		for otherName, otherFirst := range ranges {
+			if name != otherName && first <= otherFirst && otherFirst < first+nodes {
+				return fmt.Errorf("%w: the %s and %s ports overlap", ErrInvalidTopology, name, otherName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *Topology) isPoS() bool {
+	return strings.EqualFold(t.Mechanism, string(ibft.PoS))
+}
+
+func (t *Topology) nodeCount() uint64 {
+	return t.Validators + t.NonValidators
+}
+
+// nodes returns the nodes of the topology, the validators first
+func (t *Topology) nodes(dir string) []*Node {
+	nodes := make([]*Node, 0, t.nodeCount())
+
+	for i := uint64(0); i < t.nodeCount(); i++ {
+		name := fmt.Sprintf("validator-%d", i+1)
+		if i >= t.Validators {
+			name = fmt.Sprintf("node-%d", i-t.Validators+1)
+		}
+
+		node := &Node{
+			Name:        name,
+			Validator:   i < t.Validators,
+			DataDir:     filepath.Join(dir, name),
+			GRPCAddr:    t.addr(t.Ports.GRPC + i),
+			JSONRPCAddr: t.addr(t.Ports.JSONRPC + i),
+			LibP2PAddr:  t.addr(t.Ports.LibP2P + i),
+		}
+
+		if t.Ports.Prometheus != 0 {
+			node.PrometheusAddr = t.addr(t.Ports.Prometheus + i)
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func (t *Topology) addr(port uint64) string {
+	return net.JoinHostPort(t.Host, fmt.Sprintf("%d", port))
+}
+
+// genesisArgs returns the arguments of the genesis command of the nodes
+func (t *Topology) genesisArgs(dir string, nodes []*Node) []string {
+	args := []string{
+		"--dir", dir,
+		"--name", t.Name,
+		"--chainid", fmt.Sprintf("%d", t.ChainID),
+		"--consensus", "ibft",
+		"--epoch-size", fmt.Sprintf("%d", t.EpochSize),
+		"--block-gas-limit", fmt.Sprintf("%d", t.BlockGasLimit),
+	}
+
+	if t.isPoS() {
+		args = append(args, "--pos")
+	}
+
+	for _, node := range nodes {
+		if node.Validator {
+			args = append(args, "--ibft-validator", node.Address.String())
+		}
+	}
+
+	// a single node has no peers to discover
+	if len(nodes) > 1 {
+		for _, node := range nodes {
+			args = append(args, "--bootnode", node.multiaddr())
+		}
+	}
+
+	for _, premine := range t.Premine {
+		args = append(args, "--premine", premine)
+	}
+
+	return args
+}
+
+// serverArgs returns the arguments of the server command of the node
+func (t *Topology) serverArgs(genesisPath string, node *Node) []string {
+	args := []string{
+		(&server.ServerCommand{}).GetBaseCommand(),
+		"--data-dir", node.DataDir,
+		"--chain", genesisPath,
+		"--grpc", node.GRPCAddr,
+		"--jsonrpc", node.JSONRPCAddr,
+		"--libp2p", node.LibP2PAddr,
+		"--log-level", t.LogLevel,
+		"--price-limit", fmt.Sprintf("%d", t.PriceLimit),
+	}
+
+	if node.PrometheusAddr != "" {
+		args = append(args, "--prometheus", node.PrometheusAddr)
+	}
+
+	if node.Validator {
+		args = append(args, "--seal")
+	}
+
+	return args
+}
@@ -0,0 +1,213 @@
+package devnet
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTopology(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("unable to write the topology, %v", err)
+	}
+
+	return path
+}
+
+func TestReadTopology(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		topology, err := ReadTopology(writeTopology(t, "topology.yaml", `
+name: devnet
+chain_id: 200
+mechanism: PoS
+validators: 2
+non_validators: 1
+premine:
+  - 0x1010101010101010101010101010101010101010:1000
+ports:
+  grpc: 41000
+  prometheus: 44000
+`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "devnet", topology.Name)
+		assert.Equal(t, uint64(200), topology.ChainID)
+		assert.True(t, topology.isPoS())
+		assert.Equal(t, uint64(3), topology.nodeCount())
+		assert.Equal(t, []string{"0x1010101010101010101010101010101010101010:1000"}, topology.Premine)
+		assert.Equal(t, Ports{
+			GRPC:       41000,
+			JSONRPC:    defaultJSONRPCPort,
+			LibP2P:     defaultLibP2PPort,
+			Prometheus: 44000,
+		}, topology.Ports)
+	})
+
+	t.Run("json defaults", func(t *testing.T) {
+		topology, err := ReadTopology(writeTopology(t, "topology.json", `{}`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, helper.DefaultChainName, topology.Name)
+		assert.Equal(t, uint64(helper.DefaultChainID), topology.ChainID)
+		assert.Equal(t, string(ibft.PoA), topology.Mechanism)
+		assert.Equal(t, uint64(defaultValidators), topology.Validators)
+		assert.Equal(t, uint64(ibft.DefaultEpochSize), topology.EpochSize)
+		assert.Equal(t, uint64(helper.GenesisGasLimit), topology.BlockGasLimit)
+		assert.Equal(t, defaultHost, topology.Host)
+		assert.Equal(t, defaultLogLevel, topology.LogLevel)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := ReadTopology(writeTopology(t, "topology.json", `{"validator": 4}`))
+		assert.Error(t, err)
+
+		_, err = ReadTopology(writeTopology(t, "topology.yml", "validator: 4\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := ReadTopology(writeTopology(t, "topology.toml", ""))
+		assert.True(t, errors.Is(err, ErrUnknownTopologyType))
+	})
+}
+
+func TestTopology_Validate(t *testing.T) {
+	testTable := []struct {
+		name   string
+		modify func(*Topology)
+	}{
+		{
+			"unknown mechanism",
+			func(t *Topology) {
+				t.Mechanism = "PoW"
+			},
+		},
+		{
+			"host is not an IP address",
+			func(t *Topology) {
+				t.Host = "localhost"
+			},
+		},
+		{
+			"invalid premine",
+			func(t *Topology) {
+				t.Premine = []string{"0x1:abc"}
+			},
+		},
+		{
+			"overlapping ports",
+			func(t *Topology) {
+				t.Ports.JSONRPC = t.Ports.GRPC + t.nodeCount() - 1
+			},
+		},
+		{
+			"overlapping prometheus ports",
+			func(t *Topology) {
+				t.Ports.Prometheus = t.Ports.LibP2P
+			},
+		},
+		{
+			"ports exceeding the range",
+			func(t *Topology) {
+				t.Ports.LibP2P = maxPort - 1
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			topology := &Topology{}
+			topology.setDefaults()
+
+			assert.NoError(t, topology.validate())
+
+			testCase.modify(topology)
+
+			assert.True(t, errors.Is(topology.validate(), ErrInvalidTopology))
+		})
+	}
+}
+
+func TestTopology_Nodes(t *testing.T) {
+	topology := &Topology{
+		Validators:    2,
+		NonValidators: 1,
+		Ports: Ports{
+			Prometheus: 40000,
+		},
+	}
+	topology.setDefaults()
+
+	nodes := topology.nodes("net")
+
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+
+	assert.Equal(t, []string{"validator-1", "validator-2", "node-1"}, names)
+	assert.True(t, nodes[1].Validator)
+	assert.False(t, nodes[2].Validator)
+
+	node := nodes[2]
+
+	assert.Equal(t, filepath.Join("net", "node-1"), node.DataDir)
+	assert.Equal(t, "127.0.0.1:10002", node.GRPCAddr)
+	assert.Equal(t, "127.0.0.1:20002", node.JSONRPCAddr)
+	assert.Equal(t, "127.0.0.1:30002", node.LibP2PAddr)
+	assert.Equal(t, "127.0.0.1:40002", node.PrometheusAddr)
+
+	node.NodeID = "16Uiu2HAm"
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/30002/p2p/16Uiu2HAm", node.multiaddr())
+
+	args := topology.serverArgs("genesis.json", node)
+	assert.Contains(t, args, "--prometheus")
+	assert.NotContains(t, args, "--seal")
+	assert.Contains(t, topology.serverArgs("genesis.json", nodes[0]), "--seal")
+}
+
+func TestTopology_GenesisArgs(t *testing.T) {
+	topology := &Topology{
+		Mechanism: string(ibft.PoS),
+		Premine: []string{
+			"0x1010101010101010101010101010101010101010:1000",
+		},
+	}
+	topology.setDefaults()
+
+	countFlag := func(args []string, flag string) int {
+		count := 0
+
+		for _, arg := range args {
+			if arg == flag {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	args := topology.genesisArgs("net", topology.nodes("net"))
+
+	assert.Contains(t, args, "--pos")
+	assert.Equal(t, 4, countFlag(args, "--ibft-validator"))
+	assert.Equal(t, 4, countFlag(args, "--bootnode"))
+	assert.Equal(t, 1, countFlag(args, "--premine"))
+
+	t.Run("single node", func(t *testing.T) {
+		topology.Validators = 1
+
+		args := topology.genesisArgs("net", topology.nodes("net"))
+
+		assert.Equal(t, 1, countFlag(args, "--ibft-validator"))
+		assert.Equal(t, 0, countFlag(args, "--bootnode"))
+	})
+}
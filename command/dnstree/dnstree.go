@@ -0,0 +1,26 @@
+package dnstree
+
+import "github.com/mitchellh/cli"
+
+// DNSTreeCommand is the top level command for the DNS trees of bootnodes
+type DNSTreeCommand struct {
+}
+
+// Help implements the cli.Command interface
+func (c *DNSTreeCommand) Help() string {
+	return c.Synopsis()
+}
+
+func (c *DNSTreeCommand) GetBaseCommand() string {
+	return "dnstree"
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DNSTreeCommand) Synopsis() string {
+	return "Top level command for the signed DNS trees listing the bootnodes. Only accepts subcommands"
+}
+
+// Run implements the cli.Command interface
+func (c *DNSTreeCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,211 @@
+package dnstree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	helperFlags "github.com/0xPolygon/polygon-edge/helper/flags"
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
+)
+
+// DNSTreeBuild is the command to build and sign the DNS tree of the bootnodes
+type DNSTreeBuild struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+}
+
+func (p *DNSTreeBuild) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter)
+
+	p.FlagMap["domain"] = helper.FlagDescriptor{
+		Description: "Sets the domain the tree is published at",
+		Arguments: []string{
+			"DOMAIN",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+
+	p.FlagMap["key"] = helper.FlagDescriptor{
+		Description: "Sets the path to the hex encoded secp256k1 key signing the tree. The key is generated if missing",
+		Arguments: []string{
+			"KEY_FILE",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+
+	p.FlagMap["node"] = helper.FlagDescriptor{
+		Description: "Adds a node to the tree, as a multiaddr including the peer ID or an enode URL. " +
+			"This flag can be used multiple times",
+		Arguments: []string{
+			"NODE_ADDRESS",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+
+	p.FlagMap["link"] = helper.FlagDescriptor{
+		Description: "Adds a link to another tree, as an enrtree:// URL. This flag can be used multiple times",
+		Arguments: []string{
+			"TREE_URL",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	p.FlagMap["seq"] = helper.FlagDescriptor{
+		Description: "Sets the sequence number of the tree, which must increase on every update. " +
+			"Default: the current UNIX time",
+		Arguments: []string{
+			"SEQUENCE_NUMBER",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *DNSTreeBuild) GetHelperText() string {
+	return "Builds and signs the DNS tree of the bootnodes, printing the TXT records to publish"
+}
+
+// Help implements the cli.DNSTreeBuild interface
+func (p *DNSTreeBuild) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.DNSTreeBuild interface
+func (p *DNSTreeBuild) Synopsis() string {
+	return p.GetHelperText()
+}
+
+func (p *DNSTreeBuild) GetBaseCommand() string {
+	return "dnstree build"
+}
+
+// Run implements the cli.DNSTreeBuild interface
+func (p *DNSTreeBuild) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter)
+
+	var (
+		domain  string
+		keyPath string
+		seq     uint64
+		nodes   = make(helperFlags.ArrayFlags, 0)
+		links   = make(helperFlags.ArrayFlags, 0)
+	)
+
+	flags.StringVar(&domain, "domain", "", "")
+	flags.StringVar(&keyPath, "key", "", "")
+	flags.Uint64Var(&seq, "seq", uint64(time.Now().Unix()), "")
+	flags.Var(&nodes, "node", "")
+	flags.Var(&links, "link", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if domain == "" {
+		p.Formatter.OutputError(errors.New("required argument (domain) not passed in"))
+
+		return 1
+	}
+
+	if keyPath == "" {
+		p.Formatter.OutputError(errors.New("required argument (key) not passed in"))
+
+		return 1
+	}
+
+	if len(nodes) == 0 {
+		p.Formatter.OutputError(errors.New("at least one node is required"))
+
+		return 1
+	}
+
+	key, err := crypto.GenerateOrReadPrivateKey(keyPath)
+	if err != nil {
+		p.Formatter.OutputError(fmt.Errorf("unable to read the signing key, %w", err))
+
+		return 1
+	}
+
+	tree, err := dnsdisc.MakeTree(seq, nodes, links)
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	url, err := tree.Sign(key, domain)
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	res := &DNSTreeBuildResult{
+		URL:     url,
+		Seq:     tree.Seq(),
+		Records: []DNSRecord{},
+	}
+
+	for name, txt := range tree.Records(domain) {
+		res.Records = append(res.Records, DNSRecord{Name: name, TXT: txt})
+	}
+
+	// the root record comes first
+	sort.Slice(res.Records, func(i, j int) bool {
+		if res.Records[i].Name == domain || res.Records[j].Name == domain {
+			return res.Records[i].Name == domain
+		}
+
+		return res.Records[i].Name < res.Records[j].Name
+	})
+
+	p.Formatter.OutputResult(res)
+
+	return 0
+}
+
+type DNSRecord struct {
+	Name string `json:"name"`
+	TXT  string `json:"txt"`
+}
+
+type DNSTreeBuildResult struct {
+	URL     string      `json:"url"`
+	Seq     uint64      `json:"seq"`
+	Records []DNSRecord `json:"records"`
+}
+
+func (r *DNSTreeBuildResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DNS TREE]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("URL|%s", r.URL),
+		fmt.Sprintf("Sequence number|%d", r.Seq),
+	}))
+	buffer.WriteString("\n\n[TXT RECORDS]\n")
+
+	rows := make([]string, len(r.Records))
+	for i, record := range r.Records {
+		rows[i] = fmt.Sprintf("%s|%s", record.Name, record.TXT)
+	}
+
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -97,7 +97,8 @@ func (c *GenesisCommand) DefineFlags() {
 	}
 
 	c.FlagMap["bootnode"] = helper.FlagDescriptor{
-		Description: "Multiaddr URL for p2p discovery bootstrap. This flag can be used multiple times.",
+		Description: "Multiaddr URL for p2p discovery bootstrap, or the enrtree:// URL of a signed DNS tree " +
+			"listing the bootnodes. This flag can be used multiple times.",
 		Arguments: []string{
 			"BOOTNODE_URL",
 		},
@@ -145,6 +146,34 @@ func (c *GenesisCommand) DefineFlags() {
 		FlagOptional:      true,
 	}
 
+	c.FlagMap["admission-registry"] = helper.FlagDescriptor{
+		Description: "Sets the address of the registry contract holding the transaction admission policy. " +
+			"The contract needs to be added to the genesis alloc",
+		Arguments: []string{
+			"ADMISSION_REGISTRY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["sponsor-registry"] = helper.FlagDescriptor{
+		Description: "Enables the fee delegated transactions from the genesis block (feeDelegation fork) and sets " +
+			"the address of the registry contract holding the whitelisted sponsors. " +
+			"The contract needs to be added to the genesis alloc",
+		Arguments: []string{
+			"SPONSOR_REGISTRY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["node-registry"] = helper.FlagDescriptor{
+		Description: "Restricts the network connections to the registered nodes and sets the address " +
+			"of the registry contract holding their libp2p IDs. The contract needs to be added to the genesis alloc",
+		Arguments: []string{
+			"NODE_REGISTRY",
+		},
+		FlagOptional: true,
+	}
+
 	c.FlagMap["pos"] = helper.FlagDescriptor{
 		Description: "Sets the flag indicating that the client should use Proof of Stake IBFT. Defaults to " +
 			"Proof of Authority if flag is not provided or false",
@@ -193,6 +222,9 @@ func (c *GenesisCommand) Run(args []string) int {
 		ibftValidators           helperFlags.ArrayFlags
 		ibftValidatorsPrefixPath string
 		blockGasLimit            uint64
+		admissionRegistry        string
+		sponsorRegistry          string
+		nodeRegistry             string
 	)
 
 	flags.StringVar(&baseDir, "dir", "", "")
@@ -206,6 +238,9 @@ func (c *GenesisCommand) Run(args []string) int {
 	flags.Uint64Var(&epochSize, "epoch-size", ibft.DefaultEpochSize, "")
 	flags.Uint64Var(&blockGasLimit, "block-gas-limit", helper.GenesisGasLimit, "")
 	flags.BoolVar(&isPos, "pos", false, "")
+	flags.StringVar(&admissionRegistry, "admission-registry", "", "")
+	flags.StringVar(&sponsorRegistry, "sponsor-registry", "", "")
+	flags.StringVar(&nodeRegistry, "node-registry", "", "")
 
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(fmt.Sprintf("failed to parse args: %v", err))
@@ -264,7 +299,7 @@ func (c *GenesisCommand) Run(args []string) int {
 		}
 	}
 
-	if bootnodes.AreSet && len(bootnodes.Addrs) < 2 {
+	if bootnodes.AreSet && len(bootnodes.Addrs) < 2 && !bootnodes.HasDNSTree() {
 		c.UI.Error("Minimum two bootnodes are required")
 
 		return 1
@@ -305,6 +340,50 @@ func (c *GenesisCommand) Run(args []string) int {
 		Bootnodes: bootnodes.Addrs,
 	}
 
+	if admissionRegistry != "" {
+		registry := types.Address{}
+		if err := registry.UnmarshalText([]byte(admissionRegistry)); err != nil {
+			c.UI.Error(fmt.Sprintf("invalid admission registry address: %v", err))
+
+			return 1
+		}
+
+		cc.Params.Admission = &chain.AdmissionParams{
+			Registry: registry,
+		}
+	}
+
+	if sponsorRegistry != "" {
+		registry := types.Address{}
+		if err := registry.UnmarshalText([]byte(sponsorRegistry)); err != nil {
+			c.UI.Error(fmt.Sprintf("invalid sponsor registry address: %v", err))
+
+			return 1
+		}
+
+		cc.Params.FeeDelegation = &chain.FeeDelegationParams{
+			Registry: registry,
+		}
+
+		// the fee delegated transactions are accepted from the genesis
+		forks := *cc.Params.Forks
+		forks.FeeDelegation = chain.NewFork(0)
+		cc.Params.Forks = &forks
+	}
+
+	if nodeRegistry != "" {
+		registry := types.Address{}
+		if err := registry.UnmarshalText([]byte(nodeRegistry)); err != nil {
+			c.UI.Error(fmt.Sprintf("invalid node registry address: %v", err))
+
+			return 1
+		}
+
+		cc.Params.NodeRegistry = &chain.NodeRegistryParams{
+			Registry: registry,
+		}
+	}
+
 	// If the consensus selected is IBFT and the mechanism is Proof of Stake,
 	// deploy the Staking SC
 	if isPos && (consensus == ibftConsensus || consensus == devConsensus) {
@@ -7,14 +7,18 @@ import (
 	"io/ioutil"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	helperFlags "github.com/0xPolygon/polygon-edge/helper/flags"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/hcl"
 	"github.com/imdario/mergo"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // Config defines the server configuration params
@@ -24,7 +28,13 @@ type Config struct {
 	DataDir        string                 `json:"data_dir"`
 	BlockGasTarget string                 `json:"block_gas_target"`
 	GRPCAddr       string                 `json:"grpc_addr"`
+	GRPCAuthFile   string                 `json:"grpc_auth_file"`
 	JSONRPCAddr    string                 `json:"jsonrpc_addr"`
+	EnablePersonal bool                   `json:"enable_personal"`
+	EnableAdmin    bool                   `json:"enable_admin"`
+	AllowedOrigins []string               `json:"jsonrpc_allowed_origins"`
+	GraphQL        *GraphQL               `json:"graphql"`
+	TLS            *TLS                   `json:"tls"`
 	Telemetry      *Telemetry             `json:"telemetry"`
 	Network        *Network               `json:"network"`
 	Seal           bool                   `json:"seal"`
@@ -41,6 +51,22 @@ type Telemetry struct {
 	PrometheusAddr string `json:"prometheus_addr"`
 }
 
+// TLS defines the certificates of the gRPC and JSON-RPC servers, which are plaintext if not set
+type TLS struct {
+	GRPCCert     string `json:"grpc_cert"`
+	GRPCKey      string `json:"grpc_key"`
+	GRPCClientCA string `json:"grpc_client_ca"`
+	JSONRPCCert  string `json:"jsonrpc_cert"`
+	JSONRPCKey   string `json:"jsonrpc_key"`
+}
+
+// GraphQL defines the GraphQL server configuration params
+type GraphQL struct {
+	Addr          string `json:"addr"`
+	MaxDepth      uint64 `json:"max_depth"`
+	MaxComplexity uint64 `json:"max_complexity"`
+}
+
 // Network defines the network configuration params
 type Network struct {
 	NoDiscover bool   `json:"no_discover"`
@@ -48,12 +74,39 @@ type Network struct {
 	NatAddr    string `json:"nat_addr"`
 	DNS        string `json:"dns_addr"`
 	MaxPeers   uint64 `json:"max_peers"`
+
+	// Limits of the connections in each direction, up to max_peers if zero
+	MaxInboundPeers  uint64 `json:"max_inbound_peers"`
+	MaxOutboundPeers uint64 `json:"max_outbound_peers"`
+
+	// Allowlist restricts the connections to the allowed peers
+	Allowlist    bool     `json:"allowlist"`
+	AllowedPeers []string `json:"allowed_peers"`
+
+	// NAT traversal
+	NATPortMap bool `json:"nat_port_map"`
+	Relay      bool `json:"relay"`
+	AutoRelay  bool `json:"auto_relay"`
+
+	// Sentry topology
+	Role            string   `json:"role"`
+	PersistentPeers []string `json:"persistent_peers"`
+	PrivatePeers    []string `json:"private_peers"`
 }
 
 // TxPool defines the TxPool configuration params
 type TxPool struct {
-	PriceLimit uint64 `json:"price_limit"`
-	MaxSlots   uint64 `json:"max_slots"`
+	PriceLimit         uint64 `json:"price_limit"`
+	PriceBump          uint64 `json:"price_bump"`
+	MaxSlots           uint64 `json:"max_slots"`
+	MaxAccountEnqueued uint64 `json:"max_account_enqueued"`
+	MaxAccountPromoted uint64 `json:"max_account_promoted"`
+	EnqueuedLifetime   uint64 `json:"enqueued_lifetime"` // in seconds
+	NoJournal          bool   `json:"no_journal"`
+	JournalRotate      uint64 `json:"journal_rotate"` // in seconds
+	AdmissionPolicy    string `json:"admission_policy"`
+	LegacyTxGossip     bool   `json:"legacy_tx_gossip"`
+	PrivateTxLifetime  uint64 `json:"private_tx_lifetime"` // in blocks
 }
 
 // DefaultConfig returns the default server configuration
@@ -67,10 +120,19 @@ func DefaultConfig() *Config {
 			MaxPeers:   50,
 		},
 		Telemetry: &Telemetry{},
+		GraphQL:   &GraphQL{},
+		TLS:       &TLS{},
 		Seal:      false,
 		TxPool: &TxPool{
-			PriceLimit: 0,
-			MaxSlots:   4096,
+			PriceLimit:         0,
+			PriceBump:          txpool.DefaultPriceBump,
+			MaxSlots:           4096,
+			MaxAccountEnqueued: txpool.DefaultMaxAccountEnqueued,
+			MaxAccountPromoted: txpool.DefaultMaxAccountPromoted,
+			EnqueuedLifetime:   uint64(txpool.DefaultEnqueuedLifetime / time.Second),
+			NoJournal:          false,
+			JournalRotate:      uint64(txpool.DefaultJournalRotate / time.Second),
+			PrivateTxLifetime:  txpool.DefaultPrivateTxLifetime,
 		},
 		Consensus: map[string]interface{}{},
 		LogLevel:  "INFO",
@@ -90,6 +152,9 @@ func (c *Config) BuildConfig() (*server.Config, error) {
 
 	conf.Chain = cc
 	conf.Seal = c.Seal
+	conf.EnablePersonal = c.EnablePersonal
+	conf.EnableAdmin = c.EnableAdmin
+	conf.JSONRPCAllowedOrigins = c.AllowedOrigins
 	conf.DataDir = c.DataDir
 	// Set the secrets manager config if it was passed in
 	if c.Secrets != "" {
@@ -108,6 +173,8 @@ func (c *Config) BuildConfig() (*server.Config, error) {
 		}
 	}
 
+	conf.GRPCAuthFile = c.GRPCAuthFile
+
 	if c.JSONRPCAddr != "" {
 		// If an address was passed in, parse it
 		if conf.JSONRPCAddr, err = resolveAddr(c.JSONRPCAddr); err != nil {
@@ -115,6 +182,26 @@ func (c *Config) BuildConfig() (*server.Config, error) {
 		}
 	}
 
+	if c.GraphQL != nil {
+		// The GraphQL server is started only if an address was passed in
+		if c.GraphQL.Addr != "" {
+			if conf.GraphQLAddr, err = resolveAddr(c.GraphQL.Addr); err != nil {
+				return nil, err
+			}
+		}
+
+		conf.GraphQLMaxDepth = c.GraphQL.MaxDepth
+		conf.GraphQLMaxComplexity = c.GraphQL.MaxComplexity
+	}
+
+	if c.TLS != nil {
+		conf.GRPCTLSCert = c.TLS.GRPCCert
+		conf.GRPCTLSKey = c.TLS.GRPCKey
+		conf.GRPCTLSClientCA = c.TLS.GRPCClientCA
+		conf.JSONRPCTLSCert = c.TLS.JSONRPCCert
+		conf.JSONRPCTLSKey = c.TLS.JSONRPCKey
+	}
+
 	if c.Telemetry.PrometheusAddr != "" {
 		// If an address was passed in, parse it
 		if conf.Telemetry.PrometheusAddr, err = resolveAddr(c.Telemetry.PrometheusAddr); err != nil {
@@ -142,6 +229,40 @@ func (c *Config) BuildConfig() (*server.Config, error) {
 
 		conf.Network.NoDiscover = c.Network.NoDiscover
 		conf.Network.MaxPeers = c.Network.MaxPeers
+		conf.Network.MaxInboundPeers = c.Network.MaxInboundPeers
+		conf.Network.MaxOutboundPeers = c.Network.MaxOutboundPeers
+		conf.Network.Allowlist = c.Network.Allowlist
+		conf.Network.NATPortMap = c.Network.NATPortMap
+		conf.Network.Relay = c.Network.Relay
+		conf.Network.AutoRelay = c.Network.AutoRelay
+		conf.Network.Role = c.Network.Role
+
+		for _, raw := range c.Network.PersistentPeers {
+			info, err := network.StringToAddrInfo(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid persistent peer %s: %w", raw, err)
+			}
+
+			conf.Network.PersistentPeers = append(conf.Network.PersistentPeers, info)
+		}
+
+		for _, raw := range c.Network.PrivatePeers {
+			id, err := peer.Decode(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid private peer %s: %w", raw, err)
+			}
+
+			conf.Network.PrivatePeers = append(conf.Network.PrivatePeers, id)
+		}
+
+		for _, raw := range c.Network.AllowedPeers {
+			id, err := peer.Decode(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed peer %s: %w", raw, err)
+			}
+
+			conf.Network.AllowedPeers = append(conf.Network.AllowedPeers, id)
+		}
 
 		conf.Chain = cc
 	}
@@ -149,7 +270,16 @@ func (c *Config) BuildConfig() (*server.Config, error) {
 	// TxPool
 	{
 		conf.PriceLimit = c.TxPool.PriceLimit
+		conf.PriceBump = c.TxPool.PriceBump
 		conf.MaxSlots = c.TxPool.MaxSlots
+		conf.MaxAccountEnqueued = c.TxPool.MaxAccountEnqueued
+		conf.MaxAccountPromoted = c.TxPool.MaxAccountPromoted
+		conf.EnqueuedLifetime = time.Duration(c.TxPool.EnqueuedLifetime) * time.Second
+		conf.NoJournal = c.TxPool.NoJournal
+		conf.JournalRotate = time.Duration(c.TxPool.JournalRotate) * time.Second
+		conf.AdmissionPolicyFile = c.TxPool.AdmissionPolicy
+		conf.LegacyTxGossip = c.TxPool.LegacyTxGossip
+		conf.PrivateTxLifetime = c.TxPool.PrivateTxLifetime
 	}
 
 	// Target gas limit
@@ -236,6 +366,10 @@ func (c *Config) mergeConfigWith(otherConfig *Config) error {
 		c.GRPCAddr = otherConfig.GRPCAddr
 	}
 
+	if otherConfig.GRPCAuthFile != "" {
+		c.GRPCAuthFile = otherConfig.GRPCAuthFile
+	}
+
 	if otherConfig.Telemetry != nil {
 		if otherConfig.Telemetry.PrometheusAddr != "" {
 			c.Telemetry.PrometheusAddr = otherConfig.Telemetry.PrometheusAddr
@@ -246,6 +380,54 @@ func (c *Config) mergeConfigWith(otherConfig *Config) error {
 		c.JSONRPCAddr = otherConfig.JSONRPCAddr
 	}
 
+	if otherConfig.EnablePersonal {
+		c.EnablePersonal = true
+	}
+
+	if otherConfig.EnableAdmin {
+		c.EnableAdmin = true
+	}
+
+	if len(otherConfig.AllowedOrigins) != 0 {
+		c.AllowedOrigins = otherConfig.AllowedOrigins
+	}
+
+	if otherConfig.GraphQL != nil {
+		if otherConfig.GraphQL.Addr != "" {
+			c.GraphQL.Addr = otherConfig.GraphQL.Addr
+		}
+
+		if otherConfig.GraphQL.MaxDepth != 0 {
+			c.GraphQL.MaxDepth = otherConfig.GraphQL.MaxDepth
+		}
+
+		if otherConfig.GraphQL.MaxComplexity != 0 {
+			c.GraphQL.MaxComplexity = otherConfig.GraphQL.MaxComplexity
+		}
+	}
+
+	if otherConfig.TLS != nil {
+		if otherConfig.TLS.GRPCCert != "" {
+			c.TLS.GRPCCert = otherConfig.TLS.GRPCCert
+		}
+
+		if otherConfig.TLS.GRPCKey != "" {
+			c.TLS.GRPCKey = otherConfig.TLS.GRPCKey
+		}
+
+		if otherConfig.TLS.GRPCClientCA != "" {
+			c.TLS.GRPCClientCA = otherConfig.TLS.GRPCClientCA
+		}
+
+		if otherConfig.TLS.JSONRPCCert != "" {
+			c.TLS.JSONRPCCert = otherConfig.TLS.JSONRPCCert
+		}
+
+		if otherConfig.TLS.JSONRPCKey != "" {
+			c.TLS.JSONRPCKey = otherConfig.TLS.JSONRPCKey
+		}
+	}
+
 	if otherConfig.Join != "" {
 		c.Join = otherConfig.Join
 	}
@@ -268,9 +450,49 @@ func (c *Config) mergeConfigWith(otherConfig *Config) error {
 			c.Network.MaxPeers = otherConfig.Network.MaxPeers
 		}
 
+		if otherConfig.Network.MaxInboundPeers != 0 {
+			c.Network.MaxInboundPeers = otherConfig.Network.MaxInboundPeers
+		}
+
+		if otherConfig.Network.MaxOutboundPeers != 0 {
+			c.Network.MaxOutboundPeers = otherConfig.Network.MaxOutboundPeers
+		}
+
 		if otherConfig.Network.NoDiscover {
 			c.Network.NoDiscover = true
 		}
+
+		if otherConfig.Network.Allowlist {
+			c.Network.Allowlist = true
+		}
+
+		if len(otherConfig.Network.AllowedPeers) != 0 {
+			c.Network.AllowedPeers = otherConfig.Network.AllowedPeers
+		}
+
+		if otherConfig.Network.NATPortMap {
+			c.Network.NATPortMap = true
+		}
+
+		if otherConfig.Network.Relay {
+			c.Network.Relay = true
+		}
+
+		if otherConfig.Network.AutoRelay {
+			c.Network.AutoRelay = true
+		}
+
+		if otherConfig.Network.Role != "" {
+			c.Network.Role = otherConfig.Network.Role
+		}
+
+		if len(otherConfig.Network.PersistentPeers) != 0 {
+			c.Network.PersistentPeers = otherConfig.Network.PersistentPeers
+		}
+
+		if len(otherConfig.Network.PrivatePeers) != 0 {
+			c.Network.PrivatePeers = otherConfig.Network.PrivatePeers
+		}
 	}
 
 	if otherConfig.TxPool != nil {
@@ -279,9 +501,45 @@ func (c *Config) mergeConfigWith(otherConfig *Config) error {
 			c.TxPool.PriceLimit = otherConfig.TxPool.PriceLimit
 		}
 
+		if otherConfig.TxPool.PriceBump != 0 {
+			c.TxPool.PriceBump = otherConfig.TxPool.PriceBump
+		}
+
 		if otherConfig.TxPool.MaxSlots != 0 {
 			c.TxPool.MaxSlots = otherConfig.TxPool.MaxSlots
 		}
+
+		if otherConfig.TxPool.MaxAccountEnqueued != 0 {
+			c.TxPool.MaxAccountEnqueued = otherConfig.TxPool.MaxAccountEnqueued
+		}
+
+		if otherConfig.TxPool.MaxAccountPromoted != 0 {
+			c.TxPool.MaxAccountPromoted = otherConfig.TxPool.MaxAccountPromoted
+		}
+
+		if otherConfig.TxPool.EnqueuedLifetime != 0 {
+			c.TxPool.EnqueuedLifetime = otherConfig.TxPool.EnqueuedLifetime
+		}
+
+		if otherConfig.TxPool.NoJournal {
+			c.TxPool.NoJournal = true
+		}
+
+		if otherConfig.TxPool.JournalRotate != 0 {
+			c.TxPool.JournalRotate = otherConfig.TxPool.JournalRotate
+		}
+
+		if otherConfig.TxPool.LegacyTxGossip {
+			c.TxPool.LegacyTxGossip = true
+		}
+
+		if otherConfig.TxPool.PrivateTxLifetime != 0 {
+			c.TxPool.PrivateTxLifetime = otherConfig.TxPool.PrivateTxLifetime
+		}
+
+		if otherConfig.TxPool.AdmissionPolicy != "" {
+			c.TxPool.AdmissionPolicy = otherConfig.TxPool.AdmissionPolicy
+		}
 	}
 	// Read the secrets config file location
 	if otherConfig.Secrets != "" {
@@ -1,13 +1,19 @@
 package helper
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"os"
 
+	"github.com/0xPolygon/polygon-edge/helper/tlsutil"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/mitchellh/cli"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // Flags are sets of flags for specific purpose and provide functions
@@ -15,8 +21,19 @@ import (
 // GRPCFlag is a helper utility for GRPC flag and provides gRPC connection
 type GRPCFlag struct {
 	Addr string
+
+	// TLS of the connection, plaintext if none is set
+	TLSCA   string
+	TLSCert string
+	TLSKey  string
+
+	// Token is the bearer token authorizing the calls, if set
+	Token string
 }
 
+// GRPCTokenEnv is the environment variable of the bearer token, used if the flag is not set
+const GRPCTokenEnv = "POLYGON_EDGE_GRPC_TOKEN"
+
 // DefineFlags sets some flags for grpc settings
 func (g *GRPCFlag) DefineFlags(flagMap map[string]FlagDescriptor) {
 	flagMap["grpc-address"] = FlagDescriptor{
@@ -27,16 +44,100 @@ func (g *GRPCFlag) DefineFlags(flagMap map[string]FlagDescriptor) {
 		ArgumentsOptional: false,
 		FlagOptional:      true,
 	}
+
+	DefineCredentialFlags(flagMap)
+}
+
+// DefineCredentialFlags sets the flags for the TLS and the token of the gRPC connections
+func DefineCredentialFlags(flagMap map[string]FlagDescriptor) {
+	flagMap["tls-ca"] = FlagDescriptor{
+		Description: "Path to the CA certificate verifying the TLS certificate of the gRPC API. " +
+			"Default: the system roots if any TLS flag is set, plaintext otherwise",
+		Arguments: []string{
+			"TLS_CA_FILE",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	flagMap["tls-cert"] = FlagDescriptor{
+		Description: "Path to the client certificate presented to the gRPC API requiring mutual TLS",
+		Arguments: []string{
+			"TLS_CERT_FILE",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	flagMap["tls-key"] = FlagDescriptor{
+		Description: "Path to the key of the client certificate",
+		Arguments: []string{
+			"TLS_KEY_FILE",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	flagMap["grpc-token"] = FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Bearer token authorizing the calls to the gRPC API, which requires TLS unless the node is on "+
+				"the loopback address. Default: the %s environment variable",
+			GRPCTokenEnv,
+		),
+		Arguments: []string{
+			"TOKEN",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
 }
 
 // FlagSet adds some default commands to handle grpc connections with the server
 func (g *GRPCFlag) FlagSet(f *flag.FlagSet) {
 	f.StringVar(&g.Addr, "grpc-address", fmt.Sprintf("%s:%d", "127.0.0.1", server.DefaultGRPCPort), "")
+	f.StringVar(&g.TLSCA, "tls-ca", "", "")
+	f.StringVar(&g.TLSCert, "tls-cert", "", "")
+	f.StringVar(&g.TLSKey, "tls-key", "", "")
+	f.StringVar(&g.Token, "grpc-token", os.Getenv(GRPCTokenEnv), "")
+}
+
+// DialOptions returns the transport credentials of the connection,
+// using TLS if any TLS flag is set, and the token credentials if set
+func (g *GRPCFlag) DialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+
+	if g.TLSCA != "" || g.TLSCert != "" || g.TLSKey != "" {
+		tlsConfig, err := tlsutil.ClientConfig(g.TLSCA, g.TLSCert, g.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+
+		opts[0] = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
+	if g.Token != "" {
+		insecure := g.TLSCA == "" && g.TLSCert == "" && g.TLSKey == ""
+		if insecure && !isLoopbackTarget(g.Addr) {
+			return nil, errTokenWithoutTLS
+		}
+
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{
+			token:         g.Token,
+			allowInsecure: insecure,
+		}))
+	}
+
+	return opts, nil
 }
 
 // Conn returns a grpc connection
 func (g *GRPCFlag) Conn() (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(g.Addr, grpc.WithInsecure())
+	opts, err := g.DialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(g.Addr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
@@ -44,6 +145,41 @@ func (g *GRPCFlag) Conn() (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+var errTokenWithoutTLS = errors.New("the grpc token requires TLS unless the node is on the loopback address")
+
+// tokenCredentials sends the bearer token in the metadata of every call
+type tokenCredentials struct {
+	token string
+
+	// allowInsecure allows the token on plaintext connections, only to a local node
+	allowInsecure bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity requires TLS, unless the token is sent to a local node
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return !t.allowInsecure
+}
+
+// isLoopbackTarget returns a flag indicating if the address is on the local host
+func isLoopbackTarget(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
 // FormatterFlag is a helper utility for formatter
 type FormatterFlag struct {
 	UI     cli.Ui
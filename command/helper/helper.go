@@ -18,6 +18,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	helperFlags "github.com/0xPolygon/polygon-edge/helper/flags"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/mitchellh/cli"
 	"github.com/ryanuber/columnize"
@@ -384,6 +385,7 @@ func BootstrapDevCommand(baseCommand string, args []string) (*Config, error) {
 		},
 		TxPool:    &TxPool{},
 		Telemetry: &Telemetry{},
+		GraphQL:   &GraphQL{},
 	}
 	cliConfig.Seal = true
 	cliConfig.Dev = true
@@ -401,7 +403,21 @@ func BootstrapDevCommand(baseCommand string, args []string) (*Config, error) {
 	flags.StringVar(&cliConfig.LogLevel, "log-level", DefaultConfig().LogLevel, "")
 	flags.Var(&premine, "premine", "")
 	flags.Uint64Var(&cliConfig.TxPool.PriceLimit, "price-limit", 0, "")
+	flags.Uint64Var(&cliConfig.TxPool.PriceBump, "price-bump", txpool.DefaultPriceBump, "")
 	flags.Uint64Var(&cliConfig.TxPool.MaxSlots, "max-slots", DefaultMaxSlots, "")
+	flags.Uint64Var(
+		&cliConfig.TxPool.MaxAccountEnqueued,
+		"max-account-enqueued",
+		DefaultConfig().TxPool.MaxAccountEnqueued,
+		"",
+	)
+	flags.Uint64Var(
+		&cliConfig.TxPool.MaxAccountPromoted,
+		"max-account-promoted",
+		DefaultConfig().TxPool.MaxAccountPromoted,
+		"",
+	)
+	flags.Uint64Var(&cliConfig.TxPool.EnqueuedLifetime, "enqueued-lifetime", DefaultConfig().TxPool.EnqueuedLifetime, "")
 	flags.Uint64Var(&gaslimit, "block-gas-limit", GenesisGasLimit, "")
 	flags.Uint64Var(&cliConfig.DevInterval, "dev-interval", 0, "")
 	flags.Uint64Var(&chainID, "chainid", DefaultChainID, "")
@@ -434,6 +450,8 @@ func ReadConfig(baseCommand string, args []string) (*Config, error) {
 		Network:   &Network{},
 		TxPool:    &TxPool{},
 		Telemetry: &Telemetry{},
+		GraphQL:   &GraphQL{},
+		TLS:       &TLS{},
 	}
 
 	flags := flag.NewFlagSet(baseCommand, flag.ContinueOnError)
@@ -447,7 +465,19 @@ func ReadConfig(baseCommand string, args []string) (*Config, error) {
 	flags.StringVar(&cliConfig.Chain, "chain", "", "")
 	flags.StringVar(&cliConfig.DataDir, "data-dir", "", "")
 	flags.StringVar(&cliConfig.GRPCAddr, "grpc", "", "")
+	flags.StringVar(&cliConfig.GRPCAuthFile, "grpc-auth-file", "", "")
 	flags.StringVar(&cliConfig.JSONRPCAddr, "jsonrpc", "", "")
+	flags.BoolVar(&cliConfig.EnablePersonal, "enable-personal", false, "")
+	flags.BoolVar(&cliConfig.EnableAdmin, "enable-admin", false, "")
+	flags.Var((*helperFlags.ArrayFlags)(&cliConfig.AllowedOrigins), "jsonrpc-allowed-origin", "")
+	flags.StringVar(&cliConfig.GraphQL.Addr, "graphql", "", "")
+	flags.Uint64Var(&cliConfig.GraphQL.MaxDepth, "graphql-max-depth", 0, "")
+	flags.Uint64Var(&cliConfig.GraphQL.MaxComplexity, "graphql-max-complexity", 0, "")
+	flags.StringVar(&cliConfig.TLS.GRPCCert, "grpc-tls-cert", "", "")
+	flags.StringVar(&cliConfig.TLS.GRPCKey, "grpc-tls-key", "", "")
+	flags.StringVar(&cliConfig.TLS.GRPCClientCA, "grpc-tls-client-ca", "", "")
+	flags.StringVar(&cliConfig.TLS.JSONRPCCert, "jsonrpc-tls-cert", "", "")
+	flags.StringVar(&cliConfig.TLS.JSONRPCKey, "jsonrpc-tls-key", "", "")
 	flags.StringVar(&cliConfig.Join, "join", "", "")
 	flags.StringVar(&cliConfig.Network.Addr, "libp2p", "", "")
 	flags.StringVar(&cliConfig.Telemetry.PrometheusAddr, "prometheus", "", "")
@@ -465,8 +495,39 @@ func ReadConfig(baseCommand string, args []string) (*Config, error) {
 	)
 	flags.BoolVar(&cliConfig.Network.NoDiscover, "no-discover", false, "")
 	flags.Uint64Var(&cliConfig.Network.MaxPeers, "max-peers", 0, "")
+	flags.Uint64Var(&cliConfig.Network.MaxInboundPeers, "max-inbound-peers", 0, "")
+	flags.Uint64Var(&cliConfig.Network.MaxOutboundPeers, "max-outbound-peers", 0, "")
+	flags.BoolVar(&cliConfig.Network.Allowlist, "allowlist", false, "")
+	flags.BoolVar(&cliConfig.Network.NATPortMap, "nat-port-map", false, "")
+	flags.BoolVar(&cliConfig.Network.Relay, "relay", false, "")
+	flags.BoolVar(&cliConfig.Network.AutoRelay, "auto-relay", false, "")
+	flags.StringVar(&cliConfig.Network.Role, "network-role", "", "")
 	flags.Uint64Var(&cliConfig.TxPool.PriceLimit, "price-limit", 0, "")
+	flags.Uint64Var(&cliConfig.TxPool.PriceBump, "price-bump", txpool.DefaultPriceBump, "")
 	flags.Uint64Var(&cliConfig.TxPool.MaxSlots, "max-slots", DefaultMaxSlots, "")
+	flags.Uint64Var(
+		&cliConfig.TxPool.MaxAccountEnqueued,
+		"max-account-enqueued",
+		DefaultConfig().TxPool.MaxAccountEnqueued,
+		"",
+	)
+	flags.Uint64Var(
+		&cliConfig.TxPool.MaxAccountPromoted,
+		"max-account-promoted",
+		DefaultConfig().TxPool.MaxAccountPromoted,
+		"",
+	)
+	flags.Uint64Var(&cliConfig.TxPool.EnqueuedLifetime, "enqueued-lifetime", DefaultConfig().TxPool.EnqueuedLifetime, "")
+	flags.BoolVar(&cliConfig.TxPool.NoJournal, "no-journal", false, "")
+	flags.Uint64Var(&cliConfig.TxPool.JournalRotate, "journal-rotate", DefaultConfig().TxPool.JournalRotate, "")
+	flags.StringVar(&cliConfig.TxPool.AdmissionPolicy, "admission-policy", "", "")
+	flags.BoolVar(&cliConfig.TxPool.LegacyTxGossip, "legacy-tx-gossip", false, "")
+	flags.Uint64Var(
+		&cliConfig.TxPool.PrivateTxLifetime,
+		"private-tx-lifetime",
+		DefaultConfig().TxPool.PrivateTxLifetime,
+		"",
+	)
 	flags.BoolVar(&cliConfig.Dev, "dev", false, "")
 	flags.Uint64Var(&cliConfig.DevInterval, "dev-interval", 1, "")
 	flags.StringVar(&cliConfig.BlockGasTarget, "block-gas-target", strconv.FormatUint(0, 10), "")
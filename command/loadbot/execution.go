@@ -2,12 +2,15 @@ package loadbot
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/golang/protobuf/ptypes/any"
 	"math/big"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,16 +36,75 @@ type Mode string
 const (
 	transfer Mode = "transfer"
 	deploy   Mode = "deploy"
+	erc20    Mode = "erc20"
+	erc721   Mode = "erc721"
+	call     Mode = "call"
+	mixed    Mode = "mixed"
 )
 
-type Account struct {
-	Address    types.Address
-	PrivateKey *ecdsa.PrivateKey
+func isValidMode(mode Mode) bool {
+	switch mode {
+	case transfer, deploy, erc20, erc721, call, mixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModeWeight is a mode of a mixed workload, with its share of the transactions
+type ModeWeight struct {
+	Mode   Mode
+	Weight uint64
+}
+
+// parseMix parses the MODE=WEIGHT pairs of the mixed mode
+func parseMix(raw string) ([]ModeWeight, error) {
+	if raw == "" {
+		return nil, errors.New("the mixed mode requires the share of each mode")
+	}
+
+	pairs := strings.Split(raw, ",")
+	mix := make([]ModeWeight, 0, len(pairs))
+
+	for _, pair := range pairs {
+		parts := strings.Split(strings.TrimSpace(pair), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid pair %s, expected MODE=WEIGHT", pair)
+		}
+
+		mode := Mode(strings.ToLower(parts[0]))
+		if !isValidMode(mode) || mode == mixed {
+			return nil, fmt.Errorf("invalid mode %s", parts[0])
+		}
+
+		if hasMode(mix, mode) {
+			return nil, fmt.Errorf("duplicate mode %s", mode)
+		}
+
+		weight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil || weight == 0 {
+			return nil, fmt.Errorf("invalid weight %s of the mode %s", parts[1], mode)
+		}
+
+		mix = append(mix, ModeWeight{Mode: mode, Weight: weight})
+	}
+
+	return mix, nil
+}
+
+func hasMode(mix []ModeWeight, mode Mode) bool {
+	for _, modeWeight := range mix {
+		if modeWeight.Mode == mode {
+			return true
+		}
+	}
+
+	return false
 }
 
 type Configuration struct {
 	TPS              uint64
-	Sender           types.Address
+	Senders          []types.Address
 	Receiver         types.Address
 	Value            *big.Int
 	Count            uint64
@@ -54,6 +116,30 @@ type Configuration struct {
 	GasPrice         *big.Int
 	GasLimit         *big.Int
 	ContractArtifact *generator.ContractArtifact
+
+	// Mix is the share of each mode of the mixed mode
+	Mix []ModeWeight
+
+	// Rate profile of the run, which starts from StartTPS and goes up
+	// to TPS, in Steps for the step profile
+	Profile  Profile
+	StartTPS uint64
+	Steps    uint64
+
+	// ContractAddress is the contract called by the call mode, which deploys
+	// the contract artifact if not set. CallMethod is the method of the ABI
+	// of the artifact, and CallArgs the JSON template of its arguments
+	ContractAddress types.Address
+	CallMethod      string
+	CallArgs        string
+
+	// TLS of the gRPC connection, plaintext if none is set
+	TLSCA   string
+	TLSCert string
+	TLSKey  string
+
+	// GRPCToken is the bearer token authorizing the gRPC calls, if set
+	GRPCToken string
 }
 
 type metadata struct {
@@ -62,6 +148,12 @@ type metadata struct {
 
 	// block where it was sealed
 	blockNumber uint64
+
+	// time at which the transaction was submitted
+	submitTime time.Time
+
+	// inclusionLatency is the time between the submission and the timestamp of the block
+	inclusionLatency time.Duration
 }
 
 type ExecDuration struct {
@@ -87,6 +179,13 @@ type ExecDuration struct {
 
 	// TotalExecTime is the total execution time for a single loadbot run
 	TotalExecTime time.Duration
+
+	// TurnAroundDistribution is the distribution of the turn around times
+	TurnAroundDistribution Distribution
+
+	// InclusionDistribution is the distribution of the times between the
+	// submission of the transactions and the timestamp of their block
+	InclusionDistribution Distribution
 }
 
 // calcTurnAroundMetrics updates the turn around metrics based on the turnAroundMap
@@ -99,6 +198,9 @@ func (ed *ExecDuration) calcTurnAroundMetrics() {
 	var (
 		zeroTime  time.Time // Zero time
 		totalTime time.Time // Zero time used for tracking
+
+		turnAroundTimes    = make([]time.Duration, 0, totalPassing)
+		inclusionLatencies = make([]time.Duration, 0, totalPassing)
 	)
 
 	if totalPassing == 0 {
@@ -107,6 +209,8 @@ func (ed *ExecDuration) calcTurnAroundMetrics() {
 		ed.SlowestTurnAround = zeroDuration
 		ed.FastestTurnAround = zeroDuration
 		ed.AverageTurnAround = zeroDuration
+		ed.TurnAroundDistribution = newDistribution(nil)
+		ed.InclusionDistribution = newDistribution(nil)
 
 		return
 	}
@@ -130,6 +234,9 @@ func (ed *ExecDuration) calcTurnAroundMetrics() {
 
 		totalTime = totalTime.Add(turnAroundTime)
 
+		turnAroundTimes = append(turnAroundTimes, turnAroundTime)
+		inclusionLatencies = append(inclusionLatencies, data.inclusionLatency)
+
 		ed.blockTransactions[data.blockNumber]++
 
 		return true
@@ -140,6 +247,8 @@ func (ed *ExecDuration) calcTurnAroundMetrics() {
 	ed.SlowestTurnAround = slowestTurnAround
 	ed.FastestTurnAround = fastestTurnAround
 	ed.AverageTurnAround = averageDuration
+	ed.TurnAroundDistribution = newDistribution(turnAroundTimes)
+	ed.InclusionDistribution = newDistribution(inclusionLatencies)
 }
 
 // reportExecTime reports the turn around time for a transaction
@@ -156,12 +265,48 @@ type Metrics struct {
 	TotalTransactionsSentCount uint64
 	FailedTransactionsCount    uint64
 	TransactionDuration        ExecDuration
+
+	// Phases are the metrics of each phase of the rate profile
+	Phases []*PhaseMetrics
+
+	// Timeline is the activity of the run in each second
+	Timeline []*TimelineSample
+
+	// Blocks are the metrics of the blocks sealing the transactions, by block number
+	Blocks []*BlockMetrics
+
+	sealedTransactionsCount uint64
+}
+
+// PhaseMetrics are the metrics of the transactions sent during a phase of the rate profile
+type PhaseMetrics struct {
+	Phase                      *Phase
+	TotalTransactionsSentCount uint64
+	FailedTransactionsCount    uint64
+	TransactionDuration        ExecDuration
+
+	// SendDuration is the time spent sending the transactions of the phase
+	SendDuration time.Duration
+}
+
+func newPhaseMetrics(phase *Phase) *PhaseMetrics {
+	return &PhaseMetrics{
+		Phase: phase,
+		TransactionDuration: ExecDuration{
+			blockTransactions: make(map[uint64]uint64),
+		},
+	}
 }
 
 type Loadbot struct {
 	cfg       *Configuration
 	metrics   *Metrics
 	generator generator.TransactionGenerator
+
+	jsonClient *jsonrpc.Client
+	grpcClient txpoolOp.TxnPoolOperatorClient
+	signer     *crypto.EIP155Signer
+	gasPrice   *big.Int
 }
 
 // calcMaxTimeout calculates the max timeout for transactions receipts
@@ -225,14 +370,8 @@ func estimateGas(client *jsonrpc.Client, txn *types.Transaction) (uint64, error)
 	return gasEstimate, nil
 }
 
-func (l *Loadbot) executeTxn(
-	client txpoolOp.TxnPoolOperatorClient,
-) (web3.Hash, error) {
-	txn, err := l.generator.GenerateTransaction()
-	if err != nil {
-		return web3.Hash{}, err
-	}
-
+// addTxn adds the transaction to the pool of the node
+func (l *Loadbot) addTxn(txn *types.Transaction) (web3.Hash, error) {
 	addReq := &txpoolOp.AddTxnReq{
 		Raw: &any.Any{
 			Value: txn.MarshalRLP(),
@@ -240,7 +379,7 @@ func (l *Loadbot) executeTxn(
 		From: types.ZeroAddress.String(),
 	}
 
-	addRes, addErr := client.AddTxn(context.Background(), addReq)
+	addRes, addErr := l.grpcClient.AddTxn(context.Background(), addReq)
 	if addErr != nil {
 		return web3.Hash{}, fmt.Errorf("unable to add transaction, %w", addErr)
 	}
@@ -248,10 +387,19 @@ func (l *Loadbot) executeTxn(
 	return web3.Hash(types.StringToHash(addRes.TxHash)), nil
 }
 
+func (l *Loadbot) executeTxn() (web3.Hash, error) {
+	txn, err := l.generator.GenerateTransaction()
+	if err != nil {
+		return web3.Hash{}, err
+	}
+
+	return l.addTxn(txn)
+}
+
 func (l *Loadbot) Run() error {
-	sender, err := extractSenderAccount(l.cfg.Sender)
+	phases, err := buildPhases(l.cfg.Profile, l.cfg.Count, l.cfg.StartTPS, l.cfg.TPS, l.cfg.Steps)
 	if err != nil {
-		return fmt.Errorf("failed to extract sender account: %w", err)
+		return err
 	}
 
 	jsonClient, err := createJSONRPCClient(l.cfg.JSONRPC, l.cfg.MaxConns)
@@ -259,154 +407,183 @@ func (l *Loadbot) Run() error {
 		return fmt.Errorf("an error has occurred while creating JSON-RPC client: %w", err)
 	}
 
-	grpcClient, err := createGRPCClient(l.cfg.GRPC)
+	grpcClient, err := createGRPCClient(l.cfg)
 	if err != nil {
-		return fmt.Errorf("an error has occurred while creating JSON-RPC client: %w", err)
+		return fmt.Errorf("an error has occurred while creating gRPC client: %w", err)
 	}
 
 	defer func(client *jsonrpc.Client) {
 		_ = client.Close()
 	}(jsonClient)
 
-	nonce, err := getInitialSenderNonce(jsonClient, sender.Address)
+	l.jsonClient = jsonClient
+	l.grpcClient = grpcClient
+	l.signer = crypto.NewEIP155Signer(l.cfg.ChainID)
+
+	senders, err := l.extractSenders()
 	if err != nil {
-		return fmt.Errorf("unable to get initial sender nonce: %w", err)
+		return err
 	}
 
-	gasPrice := l.cfg.GasPrice
-	if gasPrice == nil {
+	l.gasPrice = l.cfg.GasPrice
+	if l.gasPrice == nil {
 		// No gas price specified, query the network for an estimation
 		avgGasPrice, err := getAverageGasPrice(jsonClient)
 		if err != nil {
 			return fmt.Errorf("unable to get average gas price: %w", err)
 		}
 
-		gasPrice = new(big.Int).SetUint64(avgGasPrice)
-	}
-
-	// Set up the transaction generator
-	generatorParams := &generator.GeneratorParams{
-		Nonce:         nonce,
-		ChainID:       l.cfg.ChainID,
-		SenderAddress: sender.Address,
-		SenderKey:     sender.PrivateKey,
-		GasPrice:      gasPrice,
-		Value:         l.cfg.Value,
+		l.gasPrice = new(big.Int).SetUint64(avgGasPrice)
 	}
 
-	var (
-		txnGenerator generator.TransactionGenerator
-		genErr       error = nil
-	)
-
-	switch l.cfg.GeneratorMode {
-	case transfer:
-		txnGenerator, genErr = generator.NewTransferGenerator(generatorParams)
-	case deploy:
-		txnGenerator, genErr = generator.NewDeployGenerator(generatorParams)
+	// Set up the transaction generator, deploying the contracts it calls
+	generatorParams := generator.GeneratorParams{
+		ChainID:          l.cfg.ChainID,
+		Senders:          senders,
+		Receiver:         l.cfg.Receiver,
+		GasPrice:         l.gasPrice,
+		Value:            l.cfg.Value,
+		ContractArtifact: l.cfg.ContractArtifact,
+		CallMethod:       l.cfg.CallMethod,
+		CallArgs:         l.cfg.CallArgs,
 	}
 
-	if genErr != nil {
-		return fmt.Errorf("unable to start generator, %w", genErr)
-	}
-
-	l.generator = txnGenerator
-
-	// Get the gas estimate
-	exampleTxn, err := l.generator.GetExampleTransaction()
+	txnGenerator, err := l.newGenerator(l.cfg.GeneratorMode, generatorParams)
 	if err != nil {
-		return fmt.Errorf("unable to get example transaction, %w", err)
-	}
-
-	gasLimit := l.cfg.GasLimit
-	if gasLimit == nil {
-		// No gas limit specified, query the network for an estimation
-		gasEstimate, estimateErr := estimateGas(jsonClient, exampleTxn)
-		if estimateErr != nil {
-			return fmt.Errorf("unable to get gas estimate, %w", err)
-		}
-
-		gasLimit = new(big.Int).SetUint64(gasEstimate)
+		return err
 	}
 
-	l.generator.SetGasEstimate(gasLimit.Uint64())
-
-	ticker := time.NewTicker(1 * time.Second / time.Duration(l.cfg.TPS))
-	defer ticker.Stop()
+	l.generator = txnGenerator
 
 	var wg sync.WaitGroup
 
 	receiptTimeout := calcMaxTimeout(l.cfg.Count, l.cfg.TPS)
 
 	startTime := time.Now()
+	stopTimeline := l.startTimeline()
 
-	for i := uint64(0); i < l.cfg.Count; i++ {
-		<-ticker.C
+	index := uint64(0)
 
-		l.metrics.TotalTransactionsSentCount += 1
+	for _, phase := range phases {
+		phaseMetrics := newPhaseMetrics(phase)
+		l.metrics.Phases = append(l.metrics.Phases, phaseMetrics)
 
-		wg.Add(1)
+		l.runPhase(phaseMetrics, index, receiptTimeout, &wg)
 
-		go func(index uint64) {
-			defer wg.Done()
-
-			// Start the performance timer
-			start := time.Now()
-
-			// Execute the transaction
-			txHash, err := l.executeTxn(grpcClient)
-			if err != nil {
-				l.generator.MarkFailedTxn(&generator.FailedTxnInfo{
-					Index:  index,
-					TxHash: txHash.String(),
-					Error: &generator.TxnError{
-						Error:     err,
-						ErrorType: generator.AddErrorType,
-					},
-				})
-				atomic.AddUint64(&l.metrics.FailedTransactionsCount, 1)
-
-				return
-			}
-
-			ctx, cancel := context.WithTimeout(context.Background(), receiptTimeout)
-			defer cancel()
-
-			receipt, err := tests.WaitForReceipt(ctx, jsonClient.Eth(), txHash)
-			if err != nil {
-				l.generator.MarkFailedTxn(&generator.FailedTxnInfo{
-					Index:  index,
-					TxHash: txHash.String(),
-					Error: &generator.TxnError{
-						Error:     err,
-						ErrorType: generator.ReceiptErrorType,
-					},
-				})
-				atomic.AddUint64(&l.metrics.FailedTransactionsCount, 1)
-
-				return
-			}
-
-			// Stop the performance timer
-			end := time.Now()
-
-			l.metrics.TransactionDuration.reportTurnAroundTime(
-				txHash,
-				&metadata{
-					turnAroundTime: end.Sub(start),
-					blockNumber:    receipt.BlockNumber,
-				},
-			)
-		}(i)
+		index += phase.Count
 	}
 
 	wg.Wait()
 
 	endTime := time.Now()
 
+	stopTimeline()
+
+	if err := l.collectBlocks(); err != nil {
+		return err
+	}
+
 	// Calculate the turn around metrics now that the loadbot is done
 	l.metrics.TransactionDuration.calcTurnAroundMetrics()
 	l.metrics.TransactionDuration.TotalExecTime = endTime.Sub(startTime)
 
+	for _, phaseMetrics := range l.metrics.Phases {
+		phaseMetrics.TransactionDuration.calcTurnAroundMetrics()
+	}
+
 	return nil
 }
+
+// runPhase sends the transactions of the phase at its rate. The wait group
+// is done once all the transactions of the phase are sealed or failed
+func (l *Loadbot) runPhase(
+	phaseMetrics *PhaseMetrics,
+	firstIndex uint64,
+	receiptTimeout time.Duration,
+	wg *sync.WaitGroup,
+) {
+	var phaseWg sync.WaitGroup
+
+	phase := phaseMetrics.Phase
+	startTime := time.Now()
+	next := startTime
+
+	for i := uint64(0); i < phase.Count; i++ {
+		atomic.AddUint64(&l.metrics.TotalTransactionsSentCount, 1)
+		phaseMetrics.TotalTransactionsSentCount += 1
+
+		phaseWg.Add(1)
+
+		go func(index uint64) {
+			defer phaseWg.Done()
+
+			l.runTxn(index, phaseMetrics, receiptTimeout)
+		}(firstIndex + i)
+
+		next = next.Add(phase.interval(i))
+		time.Sleep(time.Until(next))
+	}
+
+	phaseMetrics.SendDuration = time.Since(startTime)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		phaseWg.Wait()
+		phaseMetrics.TransactionDuration.TotalExecTime = time.Since(startTime)
+	}()
+}
+
+// runTxn sends a transaction and waits for its receipt, reporting the result
+// to the metrics of the run and of the phase
+func (l *Loadbot) runTxn(index uint64, phaseMetrics *PhaseMetrics, receiptTimeout time.Duration) {
+	// Start the performance timer
+	start := time.Now()
+
+	markFailed := func(txHash web3.Hash, err error, errorType generator.TxnErrorType) {
+		l.generator.MarkFailedTxn(&generator.FailedTxnInfo{
+			Index:  index,
+			TxHash: txHash.String(),
+			Error: &generator.TxnError{
+				Error:     err,
+				ErrorType: errorType,
+			},
+		})
+		atomic.AddUint64(&l.metrics.FailedTransactionsCount, 1)
+		atomic.AddUint64(&phaseMetrics.FailedTransactionsCount, 1)
+	}
+
+	// Execute the transaction
+	txHash, err := l.executeTxn()
+	if err != nil {
+		markFailed(txHash, err, generator.AddErrorType)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), receiptTimeout)
+	defer cancel()
+
+	receipt, err := tests.WaitForReceipt(ctx, l.jsonClient.Eth(), txHash)
+	if err != nil {
+		markFailed(txHash, err, generator.ReceiptErrorType)
+
+		return
+	}
+
+	// Stop the performance timer
+	end := time.Now()
+
+	data := &metadata{
+		turnAroundTime: end.Sub(start),
+		blockNumber:    receipt.BlockNumber,
+		submitTime:     start,
+	}
+
+	atomic.AddUint64(&l.metrics.sealedTransactionsCount, 1)
+
+	l.metrics.TransactionDuration.reportTurnAroundTime(txHash, data)
+	phaseMetrics.TransactionDuration.reportTurnAroundTime(txHash, data)
+}
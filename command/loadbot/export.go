@@ -0,0 +1,195 @@
+package loadbot
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/umbracle/go-web3"
+)
+
+const (
+	reportFile       = "report.json"
+	timelineFile     = "timeline.csv"
+	blocksFile       = "blocks.csv"
+	latencyFile      = "latency.csv"
+	errorsFile       = "errors.csv"
+	transactionsFile = "transactions.csv"
+)
+
+// exportReport writes the result of the run to the directory, as JSON
+// and as CSV files of the timeline, blocks, latencies, errors and transactions
+func exportReport(dir string, result *LoadbotResult, metrics *Metrics) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("unable to create the report directory, %w", err)
+	}
+
+	raw, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to encode the report, %w", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, reportFile), raw, 0600); err != nil {
+		return fmt.Errorf("unable to write the report, %w", err)
+	}
+
+	files := map[string][][]string{
+		timelineFile:     timelineRecords(result.TimelineData),
+		blocksFile:       blockRecords(result.BlockData.Blocks),
+		latencyFile:      latencyRecords(result.LatencyData),
+		errorsFile:       errorRecords(result.ErrorData),
+		transactionsFile: transactionRecords(metrics),
+	}
+
+	for name, records := range files {
+		if err := writeCSV(filepath.Join(dir, name), records); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeCSV(path string, records [][]string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create %s, %w", path, err)
+	}
+
+	defer file.Close()
+
+	if err := csv.NewWriter(file).WriteAll(records); err != nil {
+		return fmt.Errorf("unable to write %s, %w", path, err)
+	}
+
+	return nil
+}
+
+func formatUint(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func timelineRecords(timeline []TxnTimelineData) [][]string {
+	records := [][]string{
+		{"second", "submitted", "sealed", "failed", "pending_txns"},
+	}
+
+	for _, sample := range timeline {
+		records = append(records, []string{
+			formatUint(sample.Second),
+			formatUint(sample.Submitted),
+			formatUint(sample.Sealed),
+			formatUint(sample.Failed),
+			formatUint(sample.PendingTxns),
+		})
+	}
+
+	return records
+}
+
+func blockRecords(blocks []TxnBlockUtilizationData) [][]string {
+	records := [][]string{
+		{"number", "timestamp", "txns", "loadbot_txns", "gas_used", "gas_limit", "gas_utilization"},
+	}
+
+	for _, block := range blocks {
+		records = append(records, []string{
+			formatUint(block.Number),
+			formatUint(block.Timestamp),
+			formatUint(block.Transactions),
+			formatUint(block.LoadbotTransactions),
+			formatUint(block.GasUsed),
+			formatUint(block.GasLimit),
+			formatFloat(block.GasUtilization),
+		})
+	}
+
+	return records
+}
+
+func latencyRecords(latency TxnLatencyData) [][]string {
+	records := [][]string{
+		{"le", "inclusion", "turn_around"},
+	}
+
+	for i, bucket := range latency.Inclusion.Histogram {
+		records = append(records, []string{
+			bucket.UpperBound,
+			formatUint(bucket.Count),
+			formatUint(latency.TurnAround.Histogram[i].Count),
+		})
+	}
+
+	return records
+}
+
+func errorRecords(errors []TxnErrorCountData) [][]string {
+	records := [][]string{
+		{"type", "error", "count"},
+	}
+
+	for _, errorCount := range errors {
+		records = append(records, []string{
+			string(errorCount.ErrorType),
+			errorCount.Message,
+			formatUint(errorCount.Count),
+		})
+	}
+
+	return records
+}
+
+// transactionRecords are the sealed transactions, by submission time
+func transactionRecords(metrics *Metrics) [][]string {
+	type transaction struct {
+		hash  web3.Hash
+		phase string
+		data  *metadata
+	}
+
+	txns := make([]transaction, 0)
+
+	for _, phaseMetrics := range metrics.Phases {
+		phaseMetrics.TransactionDuration.turnAroundMap.Range(func(key, value interface{}) bool {
+			hash, _ := key.(web3.Hash)
+			data, ok := value.(*metadata)
+
+			if ok {
+				txns = append(txns, transaction{hash, phaseMetrics.Phase.Name, data})
+			}
+
+			return true
+		})
+	}
+
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].data.submitTime.Before(txns[j].data.submitTime)
+	})
+
+	records := [][]string{
+		{"hash", "phase", "block", "submitted_at", "turn_around", "inclusion_latency"},
+	}
+
+	for _, txn := range txns {
+		records = append(records, []string{
+			txn.hash.String(),
+			txn.phase,
+			formatUint(txn.data.blockNumber),
+			txn.data.submitTime.UTC().Format(time.RFC3339Nano),
+			formatFloat(txn.data.turnAroundTime.Seconds()),
+			formatFloat(txn.data.inclusionLatency.Seconds()),
+		})
+	}
+
+	return records
+}
@@ -1,8 +1,12 @@
 package generator
 
 import (
+	"fmt"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"math/big"
 	"sync"
+	"sync/atomic"
 )
 
 type BaseGenerator struct {
@@ -12,6 +16,17 @@ type BaseGenerator struct {
 	params       *GeneratorParams
 	signer       *crypto.EIP155Signer
 	estimatedGas uint64
+
+	// senderIndex is the index of the next sender, which are used in turn
+	senderIndex uint64
+}
+
+func newBaseGenerator(params *GeneratorParams) BaseGenerator {
+	return BaseGenerator{
+		failedTxns: make([]*FailedTxnInfo, 0),
+		params:     params,
+		signer:     crypto.NewEIP155Signer(params.ChainID),
+	}
 }
 
 func (bg *BaseGenerator) GetTransactionErrors() []*FailedTxnInfo {
@@ -31,3 +46,52 @@ func (bg *BaseGenerator) MarkFailedTxn(failedTxn *FailedTxnInfo) {
 func (bg *BaseGenerator) SetGasEstimate(gasEstimate uint64) {
 	bg.estimatedGas = gasEstimate
 }
+
+// nextSender returns the sender of the next transaction
+func (bg *BaseGenerator) nextSender() *Account {
+	index := atomic.AddUint64(&bg.senderIndex, 1) - 1
+
+	return bg.params.Senders[index%uint64(len(bg.params.Senders))]
+}
+
+// receiver returns the receiver of the transfers, a random address if not set
+func (bg *BaseGenerator) receiver() (types.Address, error) {
+	if bg.params.Receiver != types.ZeroAddress {
+		return bg.params.Receiver, nil
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return crypto.PubKeyToAddress(&key.PublicKey), nil
+}
+
+// signExample signs the transaction of the first sender, without nonce nor gas,
+// in order to estimate the gas of the generated transactions
+func (bg *BaseGenerator) signExample(txn *types.Transaction) (*types.Transaction, error) {
+	sender := bg.params.Senders[0]
+
+	txn.From = sender.Address
+	txn.GasPrice = bg.params.GasPrice
+	txn.V = big.NewInt(1) // it is necessary to encode in rlp
+
+	return bg.signer.SignTx(txn, sender.PrivateKey)
+}
+
+// sign signs the transaction of the sender with the nonce
+func (bg *BaseGenerator) sign(sender *Account, nonce uint64, txn *types.Transaction) (*types.Transaction, error) {
+	txn.From = sender.Address
+	txn.Gas = bg.estimatedGas
+	txn.GasPrice = bg.params.GasPrice
+	txn.Nonce = nonce
+	txn.V = big.NewInt(1) // it is necessary to encode in rlp
+
+	signed, err := bg.signer.SignTx(txn, sender.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,285 @@
+package generator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/go-web3/abi"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+var (
+	ErrMissingABI    = errors.New("the contract artifact has no ABI")
+	ErrUnknownMethod = errors.New("method not found in the contract ABI")
+)
+
+// CallGenerator generates the calls of a contract method, which arguments are rendered
+// from a JSON template. The strings of the template can contain the placeholders {{index}}
+// (index of the transaction), {{sender}}, {{receiver}} and {{random}} (random uint64)
+type CallGenerator struct {
+	BaseGenerator
+
+	method          *abi.Method
+	args            []interface{}
+	receiverAddress types.Address
+
+	// index is the index of the next transaction
+	index uint64
+}
+
+func NewCallGenerator(params *GeneratorParams) (*CallGenerator, error) {
+	callGenerator := &CallGenerator{
+		BaseGenerator: newBaseGenerator(params),
+	}
+
+	if len(params.ContractArtifact.ABI) == 0 {
+		return nil, ErrMissingABI
+	}
+
+	contractABI, err := abi.NewABI(string(params.ContractArtifact.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the contract ABI, %w", err)
+	}
+
+	callGenerator.method = contractABI.GetMethod(params.CallMethod)
+	if callGenerator.method == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMethod, params.CallMethod)
+	}
+
+	if callGenerator.args, err = parseArgsTemplate(params.CallArgs); err != nil {
+		return nil, err
+	}
+
+	if callGenerator.receiverAddress, err = callGenerator.receiver(); err != nil {
+		return nil, err
+	}
+
+	// check the template against the method inputs
+	if _, err := callGenerator.encodeCall(params.Senders[0].Address, 0); err != nil {
+		return nil, err
+	}
+
+	return callGenerator, nil
+}
+
+// parseArgsTemplate parses the JSON array of the arguments, keeping the precision of the numbers
+func parseArgsTemplate(template string) ([]interface{}, error) {
+	if template == "" {
+		return []interface{}{}, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(template))
+	decoder.UseNumber()
+
+	var args []interface{}
+	if err := decoder.Decode(&args); err != nil {
+		return nil, fmt.Errorf("the arguments must be a JSON array, %w", err)
+	}
+
+	return args, nil
+}
+
+// encodeCall renders the arguments of the transaction and encodes the call
+func (cg *CallGenerator) encodeCall(sender types.Address, index uint64) ([]byte, error) {
+	replacer := strings.NewReplacer(
+		"{{index}}", strconv.FormatUint(index, 10),
+		"{{sender}}", sender.String(),
+		"{{receiver}}", cg.receiverAddress.String(),
+		"{{random}}", strconv.FormatUint(rand.Uint64(), 10), //nolint:gosec
+	)
+
+	inputs := cg.method.Inputs.TupleElems()
+	if len(cg.args) != len(inputs) {
+		return nil, fmt.Errorf("%s expects %d arguments, got %d", cg.method.Name, len(inputs), len(cg.args))
+	}
+
+	args := make([]interface{}, len(inputs))
+
+	for i, input := range inputs {
+		arg, err := convertArg(render(cg.args[i], replacer), input.Elem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d of %s, %w", i, cg.method.Name, err)
+		}
+
+		args[i] = arg
+	}
+
+	input, err := cg.method.Encode(args)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode the call, %w", err)
+	}
+
+	return input, nil
+}
+
+// render replaces the placeholders in the strings of the JSON value
+func render(value interface{}, replacer *strings.Replacer) interface{} {
+	switch v := value.(type) {
+	case string:
+		return replacer.Replace(v)
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = render(elem, replacer)
+		}
+
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = render(elem, replacer)
+		}
+
+		return res
+	default:
+		return value
+	}
+}
+
+// convertArg converts the JSON value to the Go value encoding the ABI type
+func convertArg(value interface{}, t *abi.Type) (interface{}, error) {
+	switch t.Kind() {
+	case abi.KindBool:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case abi.KindString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case abi.KindAddress:
+		if s, ok := value.(string); ok {
+			var addr types.Address
+			if err := addr.UnmarshalText([]byte(s)); err != nil {
+				return nil, err
+			}
+
+			return addr, nil
+		}
+	case abi.KindUInt, abi.KindInt:
+		return convertNumber(value)
+	case abi.KindBytes, abi.KindFixedBytes:
+		if s, ok := value.(string); ok {
+			return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		}
+	case abi.KindSlice, abi.KindArray:
+		if list, ok := value.([]interface{}); ok {
+			res := make([]interface{}, len(list))
+
+			for i, elem := range list {
+				arg, err := convertArg(elem, t.Elem())
+				if err != nil {
+					return nil, err
+				}
+
+				res[i] = arg
+			}
+
+			return res, nil
+		}
+	case abi.KindTuple:
+		return convertTuple(value, t)
+	}
+
+	return nil, fmt.Errorf("unexpected %v for the type %s", value, t.String())
+}
+
+// convertNumber converts a JSON number, or a decimal or hex string, to a big integer
+func convertNumber(value interface{}) (*big.Int, error) {
+	var raw string
+
+	switch v := value.(type) {
+	case json.Number:
+		raw = v.String()
+	case string:
+		raw = v
+	default:
+		return nil, fmt.Errorf("unexpected %v for a number", value)
+	}
+
+	n, err := types.ParseUint256orHex(&raw)
+	if err != nil {
+		// negative numbers are only parsed as decimal
+		var ok bool
+		if n, ok = new(big.Int).SetString(raw, 10); !ok {
+			return nil, fmt.Errorf("invalid number %s", raw)
+		}
+	}
+
+	return n, nil
+}
+
+// convertTuple converts a JSON array or object to the elements of the tuple
+func convertTuple(value interface{}, t *abi.Type) (interface{}, error) {
+	elems := t.TupleElems()
+
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) != len(elems) {
+			return nil, fmt.Errorf("expected %d elements for the type %s", len(elems), t.String())
+		}
+
+		res := make([]interface{}, len(elems))
+
+		for i, elem := range elems {
+			arg, err := convertArg(v[i], elem.Elem)
+			if err != nil {
+				return nil, err
+			}
+
+			res[i] = arg
+		}
+
+		return res, nil
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(elems))
+
+		for _, elem := range elems {
+			arg, err := convertArg(v[elem.Name], elem.Elem)
+			if err != nil {
+				return nil, err
+			}
+
+			res[elem.Name] = arg
+		}
+
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("unexpected %v for the type %s", value, t.String())
+}
+
+func (cg *CallGenerator) GetExampleTransaction() (*types.Transaction, error) {
+	input, err := cg.encodeCall(cg.params.Senders[0].Address, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return cg.signExample(&types.Transaction{
+		To:    &cg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: input,
+	})
+}
+
+func (cg *CallGenerator) GenerateTransaction() (*types.Transaction, error) {
+	sender := cg.nextSender()
+	index := atomic.AddUint64(&cg.index, 1) - 1
+
+	input, err := cg.encodeCall(sender.Address, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return cg.sign(sender, sender.NextNonce(), &types.Transaction{
+		To:    &cg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: input,
+	})
+}
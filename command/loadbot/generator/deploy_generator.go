@@ -1,12 +1,8 @@
 package generator
 
 import (
-	"encoding/hex"
-	"fmt"
-	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"math/big"
-	"sync/atomic"
 )
 
 type DeployGenerator struct {
@@ -16,27 +12,20 @@ type DeployGenerator struct {
 }
 
 func (dg *DeployGenerator) GetExampleTransaction() (*types.Transaction, error) {
-	return dg.signer.SignTx(&types.Transaction{
-		From:     dg.params.SenderAddress,
-		Value:    big.NewInt(0),
-		GasPrice: dg.params.GasPrice,
-		Input:    dg.contractBytecode,
-		V:        big.NewInt(1), // it is necessary to encode in rlp
-	}, dg.params.SenderKey)
+	return dg.signExample(&types.Transaction{
+		Value: big.NewInt(0),
+		Input: dg.contractBytecode,
+	})
 }
 
 func NewDeployGenerator(params *GeneratorParams) (*DeployGenerator, error) {
-	deployGenerator := &DeployGenerator{}
-
-	deployGenerator.BaseGenerator = BaseGenerator{
-		failedTxns: make([]*FailedTxnInfo, 0),
-		params:     params,
-		signer:     crypto.NewEIP155Signer(params.ChainID),
+	deployGenerator := &DeployGenerator{
+		BaseGenerator: newBaseGenerator(params),
 	}
 
-	buf, err := hex.DecodeString(params.ContractArtifact.Bytecode)
+	buf, err := params.ContractArtifact.DecodeBytecode()
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode bytecode, %w", err)
+		return nil, err
 	}
 
 	deployGenerator.contractBytecode = buf
@@ -45,21 +34,10 @@ func NewDeployGenerator(params *GeneratorParams) (*DeployGenerator, error) {
 }
 
 func (dg *DeployGenerator) GenerateTransaction() (*types.Transaction, error) {
-	newNextNonce := atomic.AddUint64(&dg.params.Nonce, 1)
-
-	txn, err := dg.signer.SignTx(&types.Transaction{
-		From:     dg.params.SenderAddress,
-		Gas:      dg.estimatedGas,
-		Value:    big.NewInt(0),
-		GasPrice: dg.params.GasPrice,
-		Nonce:    newNextNonce - 1,
-		Input:    dg.contractBytecode,
-		V:        big.NewInt(1), // it is necessary to encode in rlp
-	}, dg.params.SenderKey)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
-	}
+	sender := dg.nextSender()
 
-	return txn, nil
+	return dg.sign(sender, sender.NextNonce(), &types.Transaction{
+		Value: big.NewInt(0),
+		Input: dg.contractBytecode,
+	})
 }
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/types"
+	"math/big"
+)
+
+// ERC20Generator generates the transfers of an ERC20 token deployed beforehand
+type ERC20Generator struct {
+	BaseGenerator
+
+	receiverAddress types.Address
+	input           []byte
+}
+
+func NewERC20Generator(params *GeneratorParams) (*ERC20Generator, error) {
+	erc20Generator := &ERC20Generator{
+		BaseGenerator: newBaseGenerator(params),
+	}
+
+	receiver, err := erc20Generator.receiver()
+	if err != nil {
+		return nil, err
+	}
+
+	erc20Generator.receiverAddress = receiver
+
+	// all the transfers send the same amount to the same receiver
+	input, err := ERC20ABI.GetMethod("transfer").Encode([]interface{}{receiver, params.Value})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode the transfer, %w", err)
+	}
+
+	erc20Generator.input = input
+
+	return erc20Generator, nil
+}
+
+func (eg *ERC20Generator) GetExampleTransaction() (*types.Transaction, error) {
+	return eg.signExample(&types.Transaction{
+		To:    &eg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: eg.input,
+	})
+}
+
+func (eg *ERC20Generator) GenerateTransaction() (*types.Transaction, error) {
+	sender := eg.nextSender()
+
+	return eg.sign(sender, sender.NextNonce(), &types.Transaction{
+		To:    &eg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: eg.input,
+	})
+}
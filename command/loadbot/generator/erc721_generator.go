@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/types"
+	"math/big"
+)
+
+// ERC721Generator generates the transfers of the tokens of an ERC721 collection deployed beforehand.
+// Each transaction transfers the token which ID is the address of the sender followed by the nonce,
+// so every sender owns the tokens it transfers
+type ERC721Generator struct {
+	BaseGenerator
+
+	receiverAddress types.Address
+}
+
+func NewERC721Generator(params *GeneratorParams) (*ERC721Generator, error) {
+	erc721Generator := &ERC721Generator{
+		BaseGenerator: newBaseGenerator(params),
+	}
+
+	receiver, err := erc721Generator.receiver()
+	if err != nil {
+		return nil, err
+	}
+
+	erc721Generator.receiverAddress = receiver
+
+	return erc721Generator, nil
+}
+
+// ERC721TokenID returns the ID of the n-th token initially owned by the account
+func ERC721TokenID(owner types.Address, n uint64) *big.Int {
+	id := new(big.Int).SetBytes(owner.Bytes())
+
+	return id.Lsh(id, 96).Add(id, new(big.Int).SetUint64(n))
+}
+
+func (eg *ERC721Generator) encodeTransfer(from types.Address, nonce uint64) ([]byte, error) {
+	input, err := ERC721ABI.GetMethod("transferFrom").Encode([]interface{}{
+		from,
+		eg.receiverAddress,
+		ERC721TokenID(from, nonce),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode the transfer, %w", err)
+	}
+
+	return input, nil
+}
+
+func (eg *ERC721Generator) GetExampleTransaction() (*types.Transaction, error) {
+	input, err := eg.encodeTransfer(eg.params.Senders[0].Address, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return eg.signExample(&types.Transaction{
+		To:    &eg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: input,
+	})
+}
+
+func (eg *ERC721Generator) GenerateTransaction() (*types.Transaction, error) {
+	sender := eg.nextSender()
+	nonce := sender.NextNonce()
+
+	input, err := eg.encodeTransfer(sender.Address, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return eg.sign(sender, nonce, &types.Transaction{
+		To:    &eg.params.ContractAddress,
+		Value: big.NewInt(0),
+		Input: input,
+	})
+}
@@ -2,10 +2,14 @@ package generator
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"github.com/0xPolygon/polygon-edge/types"
 	"io/ioutil"
 	"math/big"
+	"strings"
+	"sync/atomic"
 )
 
 type TransactionGenerator interface {
@@ -29,7 +33,18 @@ const (
 )
 
 type ContractArtifact struct {
-	Bytecode string `json:"bytecode"`
+	Bytecode string          `json:"bytecode"`
+	ABI      json.RawMessage `json:"abi,omitempty"`
+}
+
+// DecodeBytecode decodes the hex encoded bytecode of the artifact
+func (a *ContractArtifact) DecodeBytecode() ([]byte, error) {
+	buf, err := hex.DecodeString(strings.TrimPrefix(a.Bytecode, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode bytecode, %w", err)
+	}
+
+	return buf, nil
 }
 
 type TxnError struct {
@@ -43,14 +58,42 @@ type FailedTxnInfo struct {
 	Error  *TxnError
 }
 
+// Account is a sender of the transactions, with its own nonce
+type Account struct {
+	Address    types.Address
+	PrivateKey *ecdsa.PrivateKey
+
+	nonce uint64
+}
+
+func NewAccount(address types.Address, privateKey *ecdsa.PrivateKey, nonce uint64) *Account {
+	return &Account{
+		Address:    address,
+		PrivateKey: privateKey,
+		nonce:      nonce,
+	}
+}
+
+// NextNonce returns the nonce of the next transaction of the account
+func (a *Account) NextNonce() uint64 {
+	return atomic.AddUint64(&a.nonce, 1) - 1
+}
+
 type GeneratorParams struct {
-	Nonce            uint64
 	ChainID          uint64
-	SenderAddress    types.Address
-	SenderKey        *ecdsa.PrivateKey
+	Senders          []*Account
+	Receiver         types.Address // a random receiver is used if not set
 	Value            *big.Int
 	GasPrice         *big.Int
 	ContractArtifact *ContractArtifact
+
+	// ContractAddress is the contract called by the token and call generators
+	ContractAddress types.Address
+
+	// CallMethod and CallArgs are the method of the contract ABI called by the call generator,
+	// and the JSON template of its arguments
+	CallMethod string
+	CallArgs   string
 }
 
 // ReadContractArtifact reads the contract bytecode from the specified path
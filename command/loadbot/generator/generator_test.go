@@ -0,0 +1,298 @@
+package generator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testChainID = 100
+	testGas     = 1000000
+)
+
+// newTestTransition returns a transition of a state where the accounts have a balance
+func newTestTransition(t *testing.T, accounts ...*Account) *state.Transition {
+	t.Helper()
+
+	executor := state.NewExecutor(
+		&chain.Params{Forks: chain.AllForksEnabled, ChainID: testChainID},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	executor.SetRuntime(evm.NewEVM())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.Hash{}
+		}
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{}
+	for _, account := range accounts {
+		alloc[account.Address] = &chain.GenesisAccount{Balance: big.NewInt(1000000000000)}
+	}
+
+	transition, err := executor.BeginTxn(
+		executor.WriteGenesis(alloc),
+		&types.Header{Number: 1, GasLimit: 100 * testGas},
+		types.ZeroAddress,
+	)
+	assert.NoError(t, err)
+
+	return transition
+}
+
+func newTestAccount(t *testing.T) *Account {
+	t.Helper()
+
+	key, addr := tests.GenerateKeyAndAddr(t)
+
+	return NewAccount(addr, key, 0)
+}
+
+func newTestParams(senders ...*Account) *GeneratorParams {
+	return &GeneratorParams{
+		ChainID:  testChainID,
+		Senders:  senders,
+		Receiver: types.StringToAddress("1234"),
+		Value:    big.NewInt(5),
+		GasPrice: big.NewInt(1),
+	}
+}
+
+// write applies the transaction, returning its receipt
+func write(t *testing.T, transition *state.Transition, txn *types.Transaction) *types.Receipt {
+	t.Helper()
+
+	assert.NoError(t, transition.Write(txn))
+
+	receipts := transition.Receipts()
+
+	return receipts[len(receipts)-1]
+}
+
+func succeeded(receipt *types.Receipt) bool {
+	return receipt.Status != nil && *receipt.Status == types.ReceiptSuccess
+}
+
+// deploy deploys the contract from the account, returning its address
+func deploy(t *testing.T, transition *state.Transition, account *Account, bytecode string) types.Address {
+	t.Helper()
+
+	code, err := (&ContractArtifact{Bytecode: bytecode}).DecodeBytecode()
+	assert.NoError(t, err)
+
+	txn, err := crypto.NewEIP155Signer(testChainID).SignTx(&types.Transaction{
+		From:     account.Address,
+		Nonce:    account.NextNonce(),
+		Gas:      testGas,
+		GasPrice: big.NewInt(1),
+		Value:    big.NewInt(0),
+		Input:    code,
+		V:        big.NewInt(1),
+	}, account.PrivateKey)
+	assert.NoError(t, err)
+
+	receipt := write(t, transition, txn)
+	assert.True(t, succeeded(receipt))
+
+	return receipt.ContractAddress
+}
+
+func TestERC20Generator(t *testing.T) {
+	deployer, other, empty := newTestAccount(t), newTestAccount(t), newTestAccount(t)
+	transition := newTestTransition(t, deployer, other, empty)
+
+	token := deploy(t, transition, deployer, ERC20Bytecode)
+
+	balanceOf := func(addr types.Address) *big.Int {
+		return new(big.Int).SetBytes(transition.GetStorage(token, types.BytesToHash(addr.Bytes())).Bytes())
+	}
+
+	supply, _ := new(big.Int).SetString("1000000000000000000000000000", 10)
+	assert.Equal(t, supply, balanceOf(deployer.Address))
+
+	// the deployer sends a share of the supply to the other sender
+	params := newTestParams(deployer)
+	params.Receiver = other.Address
+	params.Value = big.NewInt(1000)
+	params.ContractAddress = token
+
+	gen, err := NewERC20Generator(params)
+	assert.NoError(t, err)
+	gen.SetGasEstimate(testGas)
+
+	txn, err := gen.GenerateTransaction()
+	assert.NoError(t, err)
+	assert.True(t, succeeded(write(t, transition, txn)))
+
+	// both senders transfer in turn
+	params = newTestParams(deployer, other)
+	params.ContractAddress = token
+
+	gen, err = NewERC20Generator(params)
+	assert.NoError(t, err)
+	gen.SetGasEstimate(testGas)
+
+	for i := 0; i < 4; i++ {
+		txn, err := gen.GenerateTransaction()
+		assert.NoError(t, err)
+
+		receipt := write(t, transition, txn)
+		assert.True(t, succeeded(receipt))
+		assert.Len(t, receipt.Logs, 1)
+	}
+
+	assert.Equal(t, big.NewInt(20), balanceOf(params.Receiver))
+	assert.Equal(t, big.NewInt(990), balanceOf(other.Address))
+	assert.Equal(t, new(big.Int).Sub(supply, big.NewInt(1010)), balanceOf(deployer.Address))
+
+	// a sender without tokens can't transfer
+	params = newTestParams(empty)
+	params.ContractAddress = token
+
+	gen, err = NewERC20Generator(params)
+	assert.NoError(t, err)
+	gen.SetGasEstimate(testGas)
+
+	txn, err = gen.GenerateTransaction()
+	assert.NoError(t, err)
+	assert.False(t, succeeded(write(t, transition, txn)))
+}
+
+func TestERC721Generator(t *testing.T) {
+	deployer, other := newTestAccount(t), newTestAccount(t)
+	transition := newTestTransition(t, deployer, other)
+
+	collection := deploy(t, transition, deployer, ERC721Bytecode)
+
+	ownerOf := func(id *big.Int) types.Address {
+		return types.BytesToAddress(transition.GetStorage(collection, types.BytesToHash(id.Bytes())).Bytes())
+	}
+
+	params := newTestParams(deployer, other)
+	params.ContractAddress = collection
+
+	gen, err := NewERC721Generator(params)
+	assert.NoError(t, err)
+	gen.SetGasEstimate(testGas)
+
+	txns := make([]*types.Transaction, 4)
+
+	for i := range txns {
+		txns[i], err = gen.GenerateTransaction()
+		assert.NoError(t, err)
+
+		receipt := write(t, transition, txns[i])
+		assert.True(t, succeeded(receipt))
+		assert.Len(t, receipt.Logs, 1)
+
+		assert.Equal(t, params.Receiver, ownerOf(ERC721TokenID(txns[i].From, txns[i].Nonce)))
+	}
+
+	// the token is no longer owned by the sender
+	input, err := gen.encodeTransfer(txns[0].From, txns[0].Nonce)
+	assert.NoError(t, err)
+
+	txn, err := gen.sign(deployer, deployer.NextNonce(), &types.Transaction{
+		To:    &collection,
+		Value: big.NewInt(0),
+		Input: input,
+	})
+	assert.NoError(t, err)
+	assert.False(t, succeeded(write(t, transition, txn)))
+}
+
+func TestCallGenerator(t *testing.T) {
+	sender := newTestAccount(t)
+
+	params := newTestParams(sender)
+	params.ContractArtifact = &ContractArtifact{
+		ABI: []byte(`[{"type":"function","name":"transfer","inputs":[` +
+			`{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[]}]`),
+	}
+	params.CallMethod = "transfer"
+	params.CallArgs = `["{{receiver}}", "{{index}}"]`
+
+	gen, err := NewCallGenerator(params)
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		txn, err := gen.GenerateTransaction()
+		assert.NoError(t, err)
+
+		expected, err := ERC20ABI.GetMethod("transfer").Encode([]interface{}{params.Receiver, big.NewInt(int64(i))})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, txn.Input)
+	}
+
+	t.Run("invalid template", func(t *testing.T) {
+		for _, args := range []string{
+			`["{{receiver}}"]`,
+			`["{{receiver}}", "one"]`,
+			`["0x1234", 1]`,
+			`{"to": "{{receiver}}"}`,
+		} {
+			params.CallArgs = args
+
+			_, err := NewCallGenerator(params)
+			assert.Error(t, err, args)
+		}
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		params.CallMethod = "approve"
+
+		_, err := NewCallGenerator(params)
+		assert.ErrorIs(t, err, ErrUnknownMethod)
+	})
+}
+
+// countingGenerator counts the generated transactions
+type countingGenerator struct {
+	BaseGenerator
+
+	count int
+}
+
+func (cg *countingGenerator) GenerateTransaction() (*types.Transaction, error) {
+	cg.count++
+
+	return nil, nil
+}
+
+func (cg *countingGenerator) GetExampleTransaction() (*types.Transaction, error) {
+	return nil, nil
+}
+
+func TestMixedGenerator(t *testing.T) {
+	a, b, c := &countingGenerator{}, &countingGenerator{}, &countingGenerator{}
+
+	gen, err := NewMixedGenerator([]WeightedGenerator{
+		{Generator: a, Weight: 5},
+		{Generator: b, Weight: 3},
+		{Generator: c, Weight: 2},
+	})
+	assert.NoError(t, err)
+
+	// the mix holds on every sequence as long as the sum of the weights
+	for i := 1; i <= 3; i++ {
+		for j := 0; j < 10; j++ {
+			_, _ = gen.GenerateTransaction()
+		}
+
+		assert.Equal(t, []int{5 * i, 3 * i, 2 * i}, []int{a.count, b.count, c.count})
+	}
+
+	_, err = NewMixedGenerator([]WeightedGenerator{{Generator: a, Weight: 0}})
+	assert.ErrorIs(t, err, ErrNoGenerators)
+}
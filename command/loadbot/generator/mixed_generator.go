@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"errors"
+	"github.com/0xPolygon/polygon-edge/types"
+	"sync"
+)
+
+var ErrNoGenerators = errors.New("no generators to mix")
+
+// WeightedGenerator is a generator of a mix, with its share of the transactions
+type WeightedGenerator struct {
+	Generator TransactionGenerator
+	Weight    uint64
+}
+
+// MixedGenerator generates the transactions of several generators in proportion to their weights.
+// The generators are interleaved with the smooth weighted round-robin, so the mix holds on every
+// sequence of transactions as long as the sum of the weights
+type MixedGenerator struct {
+	BaseGenerator
+
+	generators []WeightedGenerator
+
+	lock        sync.Mutex
+	current     []int64 // current weights of the round-robin
+	totalWeight int64
+}
+
+func NewMixedGenerator(generators []WeightedGenerator) (*MixedGenerator, error) {
+	mixedGenerator := &MixedGenerator{
+		BaseGenerator: BaseGenerator{
+			failedTxns: make([]*FailedTxnInfo, 0),
+		},
+		generators: make([]WeightedGenerator, 0, len(generators)),
+	}
+
+	for _, g := range generators {
+		if g.Weight == 0 {
+			continue
+		}
+
+		mixedGenerator.generators = append(mixedGenerator.generators, g)
+		mixedGenerator.totalWeight += int64(g.Weight)
+	}
+
+	if len(mixedGenerator.generators) == 0 {
+		return nil, ErrNoGenerators
+	}
+
+	mixedGenerator.current = make([]int64, len(mixedGenerator.generators))
+
+	return mixedGenerator, nil
+}
+
+// next returns the generator of the next transaction
+func (mg *MixedGenerator) next() TransactionGenerator {
+	mg.lock.Lock()
+	defer mg.lock.Unlock()
+
+	selected := 0
+
+	for i, g := range mg.generators {
+		mg.current[i] += int64(g.Weight)
+
+		if mg.current[i] > mg.current[selected] {
+			selected = i
+		}
+	}
+
+	mg.current[selected] -= mg.totalWeight
+
+	return mg.generators[selected].Generator
+}
+
+func (mg *MixedGenerator) GenerateTransaction() (*types.Transaction, error) {
+	return mg.next().GenerateTransaction()
+}
+
+// GetExampleTransaction returns the example of the first generator. The gas is estimated
+// for each generator of the mix before mixing them
+func (mg *MixedGenerator) GetExampleTransaction() (*types.Transaction, error) {
+	return mg.generators[0].Generator.GetExampleTransaction()
+}
+
+// SetGasEstimate sets the gas of all the generators of the mix
+func (mg *MixedGenerator) SetGasEstimate(gasEstimate uint64) {
+	for _, g := range mg.generators {
+		g.Generator.SetGasEstimate(gasEstimate)
+	}
+}
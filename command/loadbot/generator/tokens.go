@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"github.com/umbracle/go-web3/abi"
+)
+
+// The token contracts deployed by the loadbot before running the token transfers.
+// They are minimal implementations of the transfers of the ERC20 and ERC721 standards,
+// written directly in EVM bytecode
+
+const (
+	// ERC20Bytecode is the bytecode of a token minting its supply of 10^27 to the deployer.
+	// It implements transfer(address,uint256), balanceOf(address) and totalSupply()
+	ERC20Bytecode = "6b033b2e3c9fd0803ce800000033556b033b2e3c9fd0803ce80000006000523360007fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60206000a36100cc806100556000396000f360003560e01c8063a9059cbb1461002c57806370a082311461009357806318160ddd146100b6575b600080fd5b60243533548181106100275781810333555060043573ffffffffffffffffffffffffffffffffffffffff1680548201815581600052337fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60206000a3600160005260206000f35b60043573ffffffffffffffffffffffffffffffffffffffff165460005260206000f35b6b033b2e3c9fd0803ce800000060005260206000f3"
+
+	// ERC721Bytecode is the bytecode of a collection where each account initially owns the tokens
+	// with the ID prefixed by its address, (address << 96) + n. It implements
+	// transferFrom(address,address,uint256), which only the owner can call, and ownerOf(uint256)
+	//nolint:lll
+	ERC721Bytecode = "6100bc8061000d6000396000f360003560e01c806323b872dd146100215780636352211e146100a3575b600080fd5b60443580548061003157508060601c5b8033141561001c5760043573ffffffffffffffffffffffffffffffffffffffff16141561001c5760243573ffffffffffffffffffffffffffffffffffffffff16801561001c57808255337fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60006000a4005b6004358054806100b357508060601c5b60005260206000f3"
+)
+
+//nolint:lll
+var (
+	ERC20ABI = abi.MustNewABI(`[
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"function","name":"balanceOf","constant":true,"inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"totalSupply","constant":true,"inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+	]`)
+
+	ERC721ABI = abi.MustNewABI(`[
+		{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},
+		{"type":"function","name":"ownerOf","constant":true,"inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]}
+	]`)
+)
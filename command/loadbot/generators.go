@@ -1,12 +1,14 @@
 package loadbot
 
 import (
+	"crypto/ecdsa"
 	"fmt"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/go-web3/jsonrpc"
-	"google.golang.org/grpc"
 	"os"
 	"strings"
 )
@@ -22,8 +24,16 @@ func createJSONRPCClient(endpoint string, maxConns int) (*jsonrpc.Client, error)
 	return client, nil
 }
 
-func createGRPCClient(endpoint string) (txpoolOp.TxnPoolOperatorClient, error) {
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+func createGRPCClient(cfg *Configuration) (txpoolOp.TxnPoolOperatorClient, error) {
+	grpcFlag := &helper.GRPCFlag{
+		Addr:    cfg.GRPC,
+		TLSCA:   cfg.TLSCA,
+		TLSCert: cfg.TLSCert,
+		TLSKey:  cfg.TLSKey,
+		Token:   cfg.GRPCToken,
+	}
+
+	conn, err := grpcFlag.Conn()
 	if err != nil {
 		return nil, err
 	}
@@ -31,12 +41,29 @@ func createGRPCClient(endpoint string) (txpoolOp.TxnPoolOperatorClient, error) {
 	return txpoolOp.NewTxnPoolOperatorClient(conn), nil
 }
 
-func extractSenderAccount(address types.Address) (*Account, error) {
-	sender := &Account{
-		Address:    address,
-		PrivateKey: nil,
+// extractSenders returns the sender accounts, with their keys read from the
+// LOADBOT_<address> environment variables and their current nonces
+func (l *Loadbot) extractSenders() ([]*generator.Account, error) {
+	senders := make([]*generator.Account, 0, len(l.cfg.Senders))
+
+	for _, address := range l.cfg.Senders {
+		privateKey, err := extractSenderKey(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract sender account %s: %w", address, err)
+		}
+
+		nonce, err := getInitialSenderNonce(l.jsonClient, address)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get initial sender nonce: %w", err)
+		}
+
+		senders = append(senders, generator.NewAccount(address, privateKey, nonce))
 	}
 
+	return senders, nil
+}
+
+func extractSenderKey(address types.Address) (*ecdsa.PrivateKey, error) {
 	privateKeyRaw := os.Getenv("LOADBOT_" + address.String())
 	privateKeyRaw = strings.TrimPrefix(privateKeyRaw, "0x")
 	privateKey, err := crypto.BytesToPrivateKey([]byte(privateKeyRaw))
@@ -45,7 +72,5 @@ func extractSenderAccount(address types.Address) (*Account, error) {
 		return nil, fmt.Errorf("failed to extract ECDSA private key from bytes: %w", err)
 	}
 
-	sender.PrivateKey = privateKey
-
-	return sender, nil
+	return privateKey, nil
 }
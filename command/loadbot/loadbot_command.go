@@ -7,12 +7,14 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	helperFlags "github.com/0xPolygon/polygon-edge/helper/flags"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/go-web3"
 	"math/big"
 	"net"
 	"net/url"
-	"sort"
+	"os"
+	"strconv"
 	"strings"
 )
 
@@ -29,29 +31,31 @@ func (l *LoadbotCommand) DefineFlags() {
 	l.Base.DefineFlags(l.Formatter)
 
 	l.FlagMap["tps"] = helper.FlagDescriptor{
-		Description: "Number of transactions to send per second. Default: 100",
+		Description: "Number of transactions to send per second, the peak rate of the ramp, step " +
+			"and spike profiles. Default: 100",
 		Arguments: []string{
 			"TPS",
 		},
 	}
 
 	l.FlagMap["sender"] = helper.FlagDescriptor{
-		Description: "The account used to send the transactions.",
+		Description: "The account used to send the transactions, which key is read from the LOADBOT_<ADDRESS> " +
+			"environment variable. This flag can be used multiple times, the accounts sending in turn",
 		Arguments: []string{
 			"SENDER",
 		},
 	}
 
 	l.FlagMap["receiver"] = helper.FlagDescriptor{
-		Description: "The account used to receive the transactions.",
+		Description: "The account used to receive the transfers. If omitted, a random account is used",
 		Arguments: []string{
 			"RECEIVER",
 		},
 	}
 
 	l.FlagMap["value"] = helper.FlagDescriptor{
-		Description: "The value sent in each transaction in wei. If negative, " +
-			"a random value will be generated. Default: 100",
+		Description: "The value sent in each transaction in wei, or the amount of tokens of the erc20 mode. " +
+			"Default: 0x100",
 		Arguments: []string{
 			"VALUE",
 		},
@@ -80,13 +84,48 @@ func (l *LoadbotCommand) DefineFlags() {
 		},
 	}
 
+	helper.DefineCredentialFlags(l.FlagMap)
+
 	l.FlagMap["mode"] = helper.FlagDescriptor{
-		Description: "The mode of operation [transfer, deploy]. Default: transfer",
+		Description: "The mode of operation [transfer, deploy, erc20, erc721, call, mixed]. The token modes " +
+			"deploy the token before the run. Default: transfer",
 		Arguments: []string{
 			"MODE",
 		},
 	}
 
+	l.FlagMap["mix"] = helper.FlagDescriptor{
+		Description: "The share of each mode of the mixed mode, as MODE=WEIGHT pairs separated by commas " +
+			"(e.g. transfer=70,erc20=20,call=10)",
+		Arguments: []string{
+			"MIX",
+		},
+	}
+
+	l.FlagMap["profile"] = helper.FlagDescriptor{
+		Description: "The rate profile [constant, ramp, step, spike]. The ramp and step profiles go from the " +
+			"start TPS to the TPS, the spike profile sends at the start TPS with a spike at the TPS. " +
+			"Default: constant",
+		Arguments: []string{
+			"PROFILE",
+		},
+	}
+
+	l.FlagMap["start-tps"] = helper.FlagDescriptor{
+		Description: "The starting rate of the ramp and step profiles, and the base rate of the spike profile. " +
+			"Default: 10% of the TPS",
+		Arguments: []string{
+			"START_TPS",
+		},
+	}
+
+	l.FlagMap["steps"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf("The number of steps of the step profile. Default: %d", defaultSteps),
+		Arguments: []string{
+			"STEPS",
+		},
+	}
+
 	l.FlagMap["chain-id"] = helper.FlagDescriptor{
 		Description: "The network chain ID. Default: 100",
 		Arguments: []string{
@@ -119,13 +158,44 @@ func (l *LoadbotCommand) DefineFlags() {
 	}
 
 	l.FlagMap["contract"] = helper.FlagDescriptor{
-		Description: "The path to the contract JSON artifact containing the bytecode. If omitted, a default " +
-			"contract is used",
+		Description: "The path to the contract JSON artifact containing the bytecode, and the ABI for the call " +
+			"mode. If omitted, a default contract is used",
 		Arguments: []string{
 			"CONTRACT_PATH",
 		},
 	}
 
+	l.FlagMap["contract-address"] = helper.FlagDescriptor{
+		Description: "The address of the contract called by the call mode. If omitted, the contract artifact " +
+			"is deployed before the run",
+		Arguments: []string{
+			"CONTRACT_ADDRESS",
+		},
+	}
+
+	l.FlagMap["method"] = helper.FlagDescriptor{
+		Description: "The method of the contract ABI called by the call mode",
+		Arguments: []string{
+			"METHOD",
+		},
+	}
+
+	l.FlagMap["args"] = helper.FlagDescriptor{
+		Description: "The JSON array of the arguments of the method called by the call mode. The strings can " +
+			"contain the {{index}}, {{sender}}, {{receiver}} and {{random}} placeholders",
+		Arguments: []string{
+			"ARGS",
+		},
+	}
+
+	l.FlagMap["report-dir"] = helper.FlagDescriptor{
+		Description: "The directory the report of the run is exported to, as a JSON file and as CSV files of " +
+			"the TPS timeline, blocks, latency histograms, errors and transactions for plotting",
+		Arguments: []string{
+			"REPORT_DIR",
+		},
+	}
+
 	l.FlagMap["max-conns"] = helper.FlagDescriptor{
 		Description: "Sets the maximum no.of connections allowed per host. Default: 2*tps",
 		Arguments: []string{
@@ -160,17 +230,29 @@ func (l *LoadbotCommand) Run(args []string) int {
 		tps          uint64
 		mode         string
 		chainID      uint64
-		senderRaw    string
+		sendersRaw   = make(helperFlags.ArrayFlags, 0)
 		receiverRaw  string
 		valueRaw     string
 		count        uint64
 		jsonrpc      string
 		grpc         string
+		tlsCA        string
+		tlsCert      string
+		tlsKey       string
+		grpcToken    string
 		maxConns     int
 		detailed     bool
 		gasPrice     string
 		gasLimit     string
 		contractPath string
+		mixRaw       string
+		profile      string
+		startTPS     uint64
+		steps        uint64
+		contractRaw  string
+		method       string
+		callArgs     string
+		reportDir    string
 	)
 
 	// Map flags to placeholders
@@ -178,16 +260,28 @@ func (l *LoadbotCommand) Run(args []string) int {
 	flags.StringVar(&mode, "mode", string(transfer), "")
 	flags.BoolVar(&detailed, "detailed", false, "")
 	flags.Uint64Var(&chainID, "chain-id", 100, "")
-	flags.StringVar(&senderRaw, "sender", "", "")
+	flags.Var(&sendersRaw, "sender", "")
 	flags.StringVar(&receiverRaw, "receiver", "", "")
 	flags.StringVar(&valueRaw, "value", "0x100", "")
 	flags.Uint64Var(&count, "count", 1000, "")
 	flags.StringVar(&jsonrpc, "jsonrpc", "", "")
 	flags.StringVar(&grpc, "grpc-address", "", "")
+	flags.StringVar(&tlsCA, "tls-ca", "", "")
+	flags.StringVar(&tlsCert, "tls-cert", "", "")
+	flags.StringVar(&tlsKey, "tls-key", "", "")
+	flags.StringVar(&grpcToken, "grpc-token", os.Getenv(helper.GRPCTokenEnv), "")
 	flags.IntVar(&maxConns, "max-conns", 0, "")
 	flags.StringVar(&gasPrice, "gas-price", "", "")
 	flags.StringVar(&gasLimit, "gas-limit", "", "")
 	flags.StringVar(&contractPath, "contract", "", "")
+	flags.StringVar(&mixRaw, "mix", "", "")
+	flags.StringVar(&profile, "profile", string(constantProfile), "")
+	flags.Uint64Var(&startTPS, "start-tps", 0, "")
+	flags.Uint64Var(&steps, "steps", defaultSteps, "")
+	flags.StringVar(&contractRaw, "contract-address", "", "")
+	flags.StringVar(&method, "method", "", "")
+	flags.StringVar(&callArgs, "args", "", "")
+	flags.StringVar(&reportDir, "report-dir", "", "")
 
 	var err error
 	// Parse cli arguments
@@ -198,12 +292,36 @@ func (l *LoadbotCommand) Run(args []string) int {
 	}
 
 	convMode := Mode(strings.ToLower(mode))
-	if convMode != transfer && convMode != deploy {
+	if !isValidMode(convMode) {
 		l.Formatter.OutputError(errors.New("invalid loadbot mode"))
 
 		return 1
 	}
 
+	var mix []ModeWeight
+
+	if convMode == mixed {
+		if mix, err = parseMix(mixRaw); err != nil {
+			l.Formatter.OutputError(fmt.Errorf("invalid mix: %w", err))
+
+			return 1
+		}
+	}
+
+	if (convMode == call || hasMode(mix, call)) && method == "" {
+		l.Formatter.OutputError(errors.New("the call mode requires the method"))
+
+		return 1
+	}
+
+	// The start rate is 10% of the rate if not specified by the user
+	if startTPS == 0 {
+		startTPS = tps / 10
+		if startTPS == 0 {
+			startTPS = 1
+		}
+	}
+
 	// maxConns is set to 2*tps if not specified by the user.
 	if maxConns == 0 {
 		maxConns = int(2 * tps)
@@ -237,19 +355,38 @@ func (l *LoadbotCommand) Run(args []string) int {
 		}
 	}
 
-	var sender types.Address
-
-	if err = sender.UnmarshalText([]byte(senderRaw)); err != nil {
-		l.Formatter.OutputError(fmt.Errorf("failed to decode sender address: %w", err))
+	if len(sendersRaw) == 0 {
+		l.Formatter.OutputError(errors.New("at least one sender is required"))
 
 		return 1
 	}
 
+	senders := make([]types.Address, len(sendersRaw))
+
+	for i, senderRaw := range sendersRaw {
+		if err = senders[i].UnmarshalText([]byte(senderRaw)); err != nil {
+			l.Formatter.OutputError(fmt.Errorf("failed to decode sender address: %w", err))
+
+			return 1
+		}
+	}
+
 	var receiver types.Address
-	if err = receiver.UnmarshalText([]byte(receiverRaw)); err != nil {
-		l.Formatter.OutputError(fmt.Errorf("failed to decode receiver address: %w", err))
+	if receiverRaw != "" {
+		if err = receiver.UnmarshalText([]byte(receiverRaw)); err != nil {
+			l.Formatter.OutputError(fmt.Errorf("failed to decode receiver address: %w", err))
 
-		return 1
+			return 1
+		}
+	}
+
+	var contractAddress types.Address
+	if contractRaw != "" {
+		if err = contractAddress.UnmarshalText([]byte(contractRaw)); err != nil {
+			l.Formatter.OutputError(fmt.Errorf("failed to decode contract address: %w", err))
+
+			return 1
+		}
 	}
 
 	if _, err := url.ParseRequestURI(jsonrpc); err != nil {
@@ -291,18 +428,29 @@ func (l *LoadbotCommand) Run(args []string) int {
 
 	configuration := &Configuration{
 		TPS:              tps,
-		Sender:           sender,
+		Senders:          senders,
 		Receiver:         receiver,
 		Count:            count,
 		Value:            value,
 		JSONRPC:          jsonrpc,
 		GRPC:             grpc,
+		TLSCA:            tlsCA,
+		TLSCert:          tlsCert,
+		TLSKey:           tlsKey,
+		GRPCToken:        grpcToken,
 		MaxConns:         maxConns,
 		GeneratorMode:    convMode,
 		ChainID:          chainID,
 		GasPrice:         bigGasPrice,
 		GasLimit:         bigGasLimit,
 		ContractArtifact: contractArtifact,
+		Mix:              mix,
+		Profile:          Profile(strings.ToLower(profile)),
+		StartTPS:         startTPS,
+		Steps:            steps,
+		ContractAddress:  contractAddress,
+		CallMethod:       method,
+		CallArgs:         callArgs,
 	}
 
 	// Create the metrics placeholder
@@ -331,11 +479,20 @@ func (l *LoadbotCommand) Run(args []string) int {
 		},
 	}
 	res.extractExecutionData(metrics)
+	res.extractErrorData(loadBot.generator)
 
 	if detailed {
 		res.extractDetailedErrors(loadBot.generator)
 	}
 
+	if reportDir != "" {
+		if err := exportReport(reportDir, res, metrics); err != nil {
+			l.Formatter.OutputError(fmt.Errorf("unable to export the report: %w", err))
+
+			return 1
+		}
+	}
+
 	l.Formatter.OutputResult(res)
 
 	return 0
@@ -353,12 +510,69 @@ type TxnTurnAroundData struct {
 	TotalExecTime     float64 `json:"totalExecTime"`
 }
 
+// TxnDistributionData is the distribution of a latency, in seconds
+type TxnDistributionData struct {
+	P50       float64              `json:"p50"`
+	P90       float64              `json:"p90"`
+	P99       float64              `json:"p99"`
+	Max       float64              `json:"max"`
+	Histogram []TxnHistogramBucket `json:"histogram"`
+}
+
+// TxnHistogramBucket counts the latencies up to its bound in seconds, excluding the lower buckets
+type TxnHistogramBucket struct {
+	UpperBound string `json:"le"`
+	Count      uint64 `json:"count"`
+}
+
+type TxnLatencyData struct {
+	// Inclusion is the distribution of the times between the submission
+	// of the transactions and the timestamp of their block
+	Inclusion TxnDistributionData `json:"inclusion"`
+
+	// TurnAround is the distribution of the times between the submission
+	// of the transactions and the retrieval of their receipt
+	TurnAround TxnDistributionData `json:"turnAround"`
+}
+
 type TxnBlockData struct {
 	// BlocksRequired is the required number of blocks to seal the data
 	BlocksRequired uint64 `json:"blocksRequired"`
 
 	// BlockTransactionsMap maps the block number to the number of loadbot transactions in it
 	BlockTransactionsMap map[uint64]uint64 `json:"blockTransactionsMap"`
+
+	// AverageGasUtilization is the average share of the gas limit used by the blocks, in percent
+	AverageGasUtilization float64 `json:"averageGasUtilization"`
+
+	// Blocks are the blocks sealing the transactions, by block number
+	Blocks []TxnBlockUtilizationData `json:"blocks"`
+}
+
+type TxnBlockUtilizationData struct {
+	Number              uint64  `json:"number"`
+	Timestamp           uint64  `json:"timestamp"`
+	Transactions        uint64  `json:"transactions"`
+	LoadbotTransactions uint64  `json:"loadbotTransactions"`
+	GasUsed             uint64  `json:"gasUsed"`
+	GasLimit            uint64  `json:"gasLimit"`
+	GasUtilization      float64 `json:"gasUtilization"`
+}
+
+// TxnTimelineData is the activity of the run during a second
+type TxnTimelineData struct {
+	Second      uint64 `json:"second"`
+	Submitted   uint64 `json:"submitted"`
+	Sealed      uint64 `json:"sealed"`
+	Failed      uint64 `json:"failed"`
+	PendingTxns uint64 `json:"pendingTxns"`
+}
+
+// TxnErrorCountData is the number of failed transactions with the same error
+type TxnErrorCountData struct {
+	ErrorType generator.TxnErrorType `json:"errorType"`
+	Message   string                 `json:"message"`
+	Count     uint64                 `json:"count"`
 }
 
 type TxnDetailedErrorData struct {
@@ -367,37 +581,150 @@ type TxnDetailedErrorData struct {
 	DetailedErrorMap map[generator.TxnErrorType][]*generator.FailedTxnInfo `json:"detailedErrorMap"`
 }
 
+// TxnPhaseData are the results of a phase of the rate profile
+type TxnPhaseData struct {
+	Name           string            `json:"name"`
+	StartTPS       uint64            `json:"startTps"`
+	EndTPS         uint64            `json:"endTps"`
+	ActualTPS      float64           `json:"actualTps"`
+	CountData      TxnCountData      `json:"countData"`
+	TurnAroundData TxnTurnAroundData `json:"turnAroundData"`
+	LatencyData    TxnLatencyData    `json:"latencyData"`
+	BlocksRequired uint64            `json:"blocksRequired"`
+}
+
 type LoadbotResult struct {
 	CountData         TxnCountData         `json:"countData"`
 	TurnAroundData    TxnTurnAroundData    `json:"turnAroundData"`
+	LatencyData       TxnLatencyData       `json:"latencyData"`
 	BlockData         TxnBlockData         `json:"blockData"`
+	PhaseData         []TxnPhaseData       `json:"phaseData"`
+	TimelineData      []TxnTimelineData    `json:"timelineData"`
+	ErrorData         []TxnErrorCountData  `json:"errorData"`
 	DetailedErrorData TxnDetailedErrorData `json:"detailedErrorData,omitempty"`
 }
 
-func (lr *LoadbotResult) extractExecutionData(metrics *Metrics) {
-	lr.TurnAroundData.FastestTurnAround = common.ToFixedFloat(
-		metrics.TransactionDuration.FastestTurnAround.Seconds(),
-		durationPrecision,
-	)
+func newTurnAroundData(duration *ExecDuration) TxnTurnAroundData {
+	return TxnTurnAroundData{
+		FastestTurnAround: common.ToFixedFloat(duration.FastestTurnAround.Seconds(), durationPrecision),
+		SlowestTurnAround: common.ToFixedFloat(duration.SlowestTurnAround.Seconds(), durationPrecision),
+		AverageTurnAround: common.ToFixedFloat(duration.AverageTurnAround.Seconds(), durationPrecision),
+		TotalExecTime:     common.ToFixedFloat(duration.TotalExecTime.Seconds(), durationPrecision),
+	}
+}
 
-	lr.TurnAroundData.SlowestTurnAround = common.ToFixedFloat(
-		metrics.TransactionDuration.SlowestTurnAround.Seconds(),
-		durationPrecision,
-	)
+func newDistributionData(distribution *Distribution) TxnDistributionData {
+	histogram := make([]TxnHistogramBucket, len(distribution.Histogram))
 
-	lr.TurnAroundData.AverageTurnAround = common.ToFixedFloat(
-		metrics.TransactionDuration.AverageTurnAround.Seconds(),
-		durationPrecision,
-	)
+	for i, bucket := range distribution.Histogram {
+		upperBound := "+Inf"
+		if i < len(distribution.Histogram)-1 {
+			upperBound = strconv.FormatFloat(bucket.UpperBound.Seconds(), 'f', -1, 64)
+		}
 
-	lr.TurnAroundData.TotalExecTime = common.ToFixedFloat(
-		metrics.TransactionDuration.TotalExecTime.Seconds(),
-		durationPrecision,
-	)
+		histogram[i] = TxnHistogramBucket{
+			UpperBound: upperBound,
+			Count:      bucket.Count,
+		}
+	}
+
+	return TxnDistributionData{
+		P50:       common.ToFixedFloat(distribution.P50.Seconds(), durationPrecision),
+		P90:       common.ToFixedFloat(distribution.P90.Seconds(), durationPrecision),
+		P99:       common.ToFixedFloat(distribution.P99.Seconds(), durationPrecision),
+		Max:       common.ToFixedFloat(distribution.Max.Seconds(), durationPrecision),
+		Histogram: histogram,
+	}
+}
+
+func newLatencyData(duration *ExecDuration) TxnLatencyData {
+	return TxnLatencyData{
+		Inclusion:  newDistributionData(&duration.InclusionDistribution),
+		TurnAround: newDistributionData(&duration.TurnAroundDistribution),
+	}
+}
+
+func (lr *LoadbotResult) extractExecutionData(metrics *Metrics) {
+	lr.TurnAroundData = newTurnAroundData(&metrics.TransactionDuration)
+	lr.LatencyData = newLatencyData(&metrics.TransactionDuration)
 
 	lr.BlockData = TxnBlockData{
 		BlocksRequired:       uint64(len(metrics.TransactionDuration.blockTransactions)),
 		BlockTransactionsMap: metrics.TransactionDuration.blockTransactions,
+		Blocks:               make([]TxnBlockUtilizationData, len(metrics.Blocks)),
+	}
+
+	totalUtilization := float64(0)
+
+	for i, block := range metrics.Blocks {
+		totalUtilization += block.GasUtilization()
+
+		lr.BlockData.Blocks[i] = TxnBlockUtilizationData{
+			Number:              block.Number,
+			Timestamp:           block.Timestamp,
+			Transactions:        block.Transactions,
+			LoadbotTransactions: block.LoadbotTransactions,
+			GasUsed:             block.GasUsed,
+			GasLimit:            block.GasLimit,
+			GasUtilization:      common.ToFixedFloat(block.GasUtilization(), durationPrecision),
+		}
+	}
+
+	if len(metrics.Blocks) != 0 {
+		lr.BlockData.AverageGasUtilization = common.ToFixedFloat(
+			totalUtilization/float64(len(metrics.Blocks)),
+			durationPrecision,
+		)
+	}
+
+	lr.TimelineData = make([]TxnTimelineData, len(metrics.Timeline))
+
+	for i, sample := range metrics.Timeline {
+		lr.TimelineData[i] = TxnTimelineData{
+			Second:      sample.Second,
+			Submitted:   sample.Submitted,
+			Sealed:      sample.Sealed,
+			Failed:      sample.Failed,
+			PendingTxns: sample.PendingTxns,
+		}
+	}
+
+	lr.PhaseData = make([]TxnPhaseData, len(metrics.Phases))
+
+	for i, phaseMetrics := range metrics.Phases {
+		actualTPS := float64(0)
+		if phaseMetrics.SendDuration > 0 {
+			actualTPS = float64(phaseMetrics.TotalTransactionsSentCount) / phaseMetrics.SendDuration.Seconds()
+		}
+
+		lr.PhaseData[i] = TxnPhaseData{
+			Name:      phaseMetrics.Phase.Name,
+			StartTPS:  phaseMetrics.Phase.StartTPS,
+			EndTPS:    phaseMetrics.Phase.EndTPS,
+			ActualTPS: common.ToFixedFloat(actualTPS, durationPrecision),
+			CountData: TxnCountData{
+				Total:  phaseMetrics.TotalTransactionsSentCount,
+				Failed: phaseMetrics.FailedTransactionsCount,
+			},
+			TurnAroundData: newTurnAroundData(&phaseMetrics.TransactionDuration),
+			LatencyData:    newLatencyData(&phaseMetrics.TransactionDuration),
+			BlocksRequired: uint64(len(phaseMetrics.TransactionDuration.blockTransactions)),
+		}
+	}
+}
+
+// extractErrorData groups the failed transactions by error
+func (lr *LoadbotResult) extractErrorData(gen generator.TransactionGenerator) {
+	breakdown := newErrorBreakdown(gen.GetTransactionErrors())
+
+	lr.ErrorData = make([]TxnErrorCountData, len(breakdown))
+
+	for i, errorCount := range breakdown {
+		lr.ErrorData[i] = TxnErrorCountData{
+			ErrorType: errorCount.ErrorType,
+			Message:   errorCount.Message,
+			Count:     errorCount.Count,
+		}
 	}
 }
 
@@ -441,29 +768,99 @@ func (lr *LoadbotResult) Output() string {
 		fmt.Sprintf("Total loadbot execution time|%fs", lr.TurnAroundData.TotalExecTime),
 	}))
 
+	buffer.WriteString("\n\n[LATENCY DATA]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Inclusion latency p50|%fs", lr.LatencyData.Inclusion.P50),
+		fmt.Sprintf("Inclusion latency p90|%fs", lr.LatencyData.Inclusion.P90),
+		fmt.Sprintf("Inclusion latency p99|%fs", lr.LatencyData.Inclusion.P99),
+		fmt.Sprintf("Inclusion latency max|%fs", lr.LatencyData.Inclusion.Max),
+		fmt.Sprintf("Turn around p50|%fs", lr.LatencyData.TurnAround.P50),
+		fmt.Sprintf("Turn around p90|%fs", lr.LatencyData.TurnAround.P90),
+		fmt.Sprintf("Turn around p99|%fs", lr.LatencyData.TurnAround.P99),
+		fmt.Sprintf("Turn around max|%fs", lr.LatencyData.TurnAround.Max),
+	}))
+
+	buffer.WriteString("\n\n[INCLUSION LATENCY HISTOGRAM]\n")
+	buffer.WriteString(helper.FormatKV(formatHistogram(lr.LatencyData.Inclusion.Histogram)))
+
+	// Write out the phases of the profile, if it has more than one
+	if len(lr.PhaseData) > 1 {
+		buffer.WriteString("\n\n[PHASES]\n")
+
+		for _, phase := range lr.PhaseData {
+			buffer.WriteString(fmt.Sprintf("\n[%s]\n", strings.ToUpper(phase.Name)))
+			buffer.WriteString(helper.FormatKV([]string{
+				fmt.Sprintf("Target TPS|%d -> %d", phase.StartTPS, phase.EndTPS),
+				fmt.Sprintf("Actual TPS|%f", phase.ActualTPS),
+				fmt.Sprintf("Transactions submitted|%d", phase.CountData.Total),
+				fmt.Sprintf("Transactions failed|%d", phase.CountData.Failed),
+				fmt.Sprintf("Average transaction turn around|%fs", phase.TurnAroundData.AverageTurnAround),
+				fmt.Sprintf("Fastest transaction turn around|%fs", phase.TurnAroundData.FastestTurnAround),
+				fmt.Sprintf("Slowest transaction turn around|%fs", phase.TurnAroundData.SlowestTurnAround),
+				fmt.Sprintf("Phase execution time|%fs", phase.TurnAroundData.TotalExecTime),
+				fmt.Sprintf("Inclusion latency p50|%fs", phase.LatencyData.Inclusion.P50),
+				fmt.Sprintf("Inclusion latency p99|%fs", phase.LatencyData.Inclusion.P99),
+				fmt.Sprintf("Blocks required|%d", phase.BlocksRequired),
+			}))
+			buffer.WriteString("\n")
+		}
+	}
+
 	buffer.WriteString("\n\n[BLOCK DATA]\n")
 	buffer.WriteString(helper.FormatKV([]string{
 		fmt.Sprintf("Blocks required|%d", lr.BlockData.BlocksRequired),
+		fmt.Sprintf("Average gas utilization|%.2f%%", lr.BlockData.AverageGasUtilization),
 	}))
 
-	if lr.BlockData.BlocksRequired != 0 {
+	if len(lr.BlockData.Blocks) != 0 {
 		buffer.WriteString("\n\n")
 
-		keys := make([]uint64, 0, lr.BlockData.BlocksRequired)
+		formattedStrings := make([]string, 0, len(lr.BlockData.Blocks))
 
-		for k := range lr.BlockData.BlockTransactionsMap {
-			keys = append(keys, k)
+		for _, block := range lr.BlockData.Blocks {
+			formattedStrings = append(formattedStrings,
+				fmt.Sprintf("Block #%d|%d txns (%d loadbot), gas used %d/%d (%.2f%%)",
+					block.Number,
+					block.Transactions,
+					block.LoadbotTransactions,
+					block.GasUsed,
+					block.GasLimit,
+					block.GasUtilization,
+				),
+			)
 		}
 
-		sort.Slice(keys, func(i, j int) bool {
-			return keys[i] < keys[j]
-		})
+		buffer.WriteString(helper.FormatKV(formattedStrings))
+	}
+
+	if len(lr.TimelineData) != 0 {
+		buffer.WriteString("\n\n[TIMELINE]\n")
+
+		formattedStrings := make([]string, 0, len(lr.TimelineData))
+
+		for _, sample := range lr.TimelineData {
+			formattedStrings = append(formattedStrings,
+				fmt.Sprintf("Second %d|%d submitted, %d sealed, %d failed, %d pending",
+					sample.Second,
+					sample.Submitted,
+					sample.Sealed,
+					sample.Failed,
+					sample.PendingTxns,
+				),
+			)
+		}
+
+		buffer.WriteString(helper.FormatKV(formattedStrings))
+	}
+
+	if len(lr.ErrorData) != 0 {
+		buffer.WriteString("\n\n[ERROR DATA]\n")
 
-		formattedStrings := make([]string, 0)
+		formattedStrings := make([]string, 0, len(lr.ErrorData))
 
-		for _, blockNumber := range keys {
+		for _, errorCount := range lr.ErrorData {
 			formattedStrings = append(formattedStrings,
-				fmt.Sprintf("Block #%d|%d txns", blockNumber, lr.BlockData.BlockTransactionsMap[blockNumber]),
+				fmt.Sprintf("%s: %s|%d txns", errorCount.ErrorType, errorCount.Message, errorCount.Count),
 			)
 		}
 
@@ -513,3 +910,19 @@ func (lr *LoadbotResult) Output() string {
 
 	return buffer.String()
 }
+
+// formatHistogram formats the buckets of the histogram
+func formatHistogram(histogram []TxnHistogramBucket) []string {
+	formattedStrings := make([]string, 0, len(histogram))
+
+	for i, bucket := range histogram {
+		bound := fmt.Sprintf("<= %ss", bucket.UpperBound)
+		if i == len(histogram)-1 {
+			bound = fmt.Sprintf("> %ss", histogram[i-1].UpperBound)
+		}
+
+		formattedStrings = append(formattedStrings, fmt.Sprintf("%s|%d txns", bound, bucket.Count))
+	}
+
+	return formattedStrings
+}
@@ -0,0 +1,122 @@
+package loadbot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Profile string
+
+const (
+	// constantProfile sends all the transactions at the TPS
+	constantProfile Profile = "constant"
+
+	// rampProfile increases the rate linearly from the start TPS to the TPS
+	rampProfile Profile = "ramp"
+
+	// stepProfile increases the rate from the start TPS to the TPS in steps of equal length
+	stepProfile Profile = "step"
+
+	// spikeProfile sends at the start TPS, then a spike at the TPS, and recovers at the start TPS
+	spikeProfile Profile = "spike"
+)
+
+const (
+	defaultSteps = 4
+
+	// share of the transactions sent in each phase of the spike profile, in percent
+	spikeBaselineShare = 40
+	spikeShare         = 20
+)
+
+var (
+	ErrInvalidProfile = errors.New("invalid rate profile")
+	ErrInvalidTPS     = errors.New("the TPS must be greater than zero")
+)
+
+// Phase is a part of a run, sending its transactions at a rate
+// changing linearly from StartTPS to EndTPS
+type Phase struct {
+	Name     string
+	Count    uint64
+	StartTPS uint64
+	EndTPS   uint64
+}
+
+// interval returns the time between the i-th transaction of the phase and the next one
+func (p *Phase) interval(i uint64) time.Duration {
+	tps := float64(p.StartTPS)
+	if p.Count > 1 {
+		tps += (float64(p.EndTPS) - float64(p.StartTPS)) * float64(i) / float64(p.Count-1)
+	}
+
+	return time.Duration(float64(time.Second) / tps)
+}
+
+// buildPhases splits the transactions into the phases of the profile
+func buildPhases(profile Profile, count, startTPS, tps, steps uint64) ([]*Phase, error) {
+	if tps == 0 || startTPS == 0 {
+		return nil, ErrInvalidTPS
+	}
+
+	var phases []*Phase
+
+	switch profile {
+	case constantProfile:
+		phases = []*Phase{
+			{Name: string(constantProfile), Count: count, StartTPS: tps, EndTPS: tps},
+		}
+	case rampProfile:
+		phases = []*Phase{
+			{Name: string(rampProfile), Count: count, StartTPS: startTPS, EndTPS: tps},
+		}
+	case stepProfile:
+		if steps == 0 {
+			return nil, fmt.Errorf("%w: the step profile requires at least one step", ErrInvalidProfile)
+		}
+
+		for i := uint64(0); i < steps; i++ {
+			stepTPS := tps
+			if steps > 1 {
+				// the steps can decrease the rate as well
+				stepTPS = uint64(int64(startTPS) + (int64(tps)-int64(startTPS))*int64(i)/int64(steps-1))
+			}
+
+			stepCount := count / steps
+			if i == steps-1 {
+				// the last step sends the remainder
+				stepCount = count - stepCount*(steps-1)
+			}
+
+			phases = append(phases, &Phase{
+				Name:     fmt.Sprintf("step-%d", i+1),
+				Count:    stepCount,
+				StartTPS: stepTPS,
+				EndTPS:   stepTPS,
+			})
+		}
+	case spikeProfile:
+		baseline := count * spikeBaselineShare / 100
+		spike := count * spikeShare / 100
+
+		phases = []*Phase{
+			{Name: "baseline", Count: baseline, StartTPS: startTPS, EndTPS: startTPS},
+			{Name: "spike", Count: spike, StartTPS: tps, EndTPS: tps},
+			{Name: "recovery", Count: count - baseline - spike, StartTPS: startTPS, EndTPS: startTPS},
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProfile, profile)
+	}
+
+	// skip the empty phases of the small runs
+	res := make([]*Phase, 0, len(phases))
+
+	for _, phase := range phases {
+		if phase.Count > 0 {
+			res = append(res, phase)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,101 @@
+package loadbot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPhases(t *testing.T) {
+	cases := []struct {
+		profile  Profile
+		expected []*Phase
+	}{
+		{
+			constantProfile,
+			[]*Phase{
+				{Name: "constant", Count: 100, StartTPS: 50, EndTPS: 50},
+			},
+		},
+		{
+			rampProfile,
+			[]*Phase{
+				{Name: "ramp", Count: 100, StartTPS: 10, EndTPS: 50},
+			},
+		},
+		{
+			stepProfile,
+			[]*Phase{
+				{Name: "step-1", Count: 33, StartTPS: 10, EndTPS: 10},
+				{Name: "step-2", Count: 33, StartTPS: 30, EndTPS: 30},
+				{Name: "step-3", Count: 34, StartTPS: 50, EndTPS: 50},
+			},
+		},
+		{
+			spikeProfile,
+			[]*Phase{
+				{Name: "baseline", Count: 40, StartTPS: 10, EndTPS: 10},
+				{Name: "spike", Count: 20, StartTPS: 50, EndTPS: 50},
+				{Name: "recovery", Count: 40, StartTPS: 10, EndTPS: 10},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(string(c.profile), func(t *testing.T) {
+			phases, err := buildPhases(c.profile, 100, 10, 50, 3)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, phases)
+		})
+	}
+
+	t.Run("empty phases", func(t *testing.T) {
+		phases, err := buildPhases(spikeProfile, 2, 10, 50, 3)
+		assert.NoError(t, err)
+		assert.Len(t, phases, 1)
+		assert.Equal(t, "recovery", phases[0].Name)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := buildPhases("wave", 100, 10, 50, 3)
+		assert.ErrorIs(t, err, ErrInvalidProfile)
+
+		_, err = buildPhases(stepProfile, 100, 10, 50, 0)
+		assert.ErrorIs(t, err, ErrInvalidProfile)
+
+		_, err = buildPhases(constantProfile, 100, 10, 0, 3)
+		assert.ErrorIs(t, err, ErrInvalidTPS)
+	})
+}
+
+func TestPhase_Interval(t *testing.T) {
+	phase := &Phase{Count: 5, StartTPS: 10, EndTPS: 50}
+
+	assert.Equal(t, 100*time.Millisecond, phase.interval(0))
+	assert.Equal(t, time.Second/30, phase.interval(2))
+	assert.Equal(t, 20*time.Millisecond, phase.interval(4))
+}
+
+func TestParseMix(t *testing.T) {
+	mix, err := parseMix("transfer=70, ERC20=20,call=10")
+	assert.NoError(t, err)
+	assert.Equal(t, []ModeWeight{
+		{Mode: transfer, Weight: 70},
+		{Mode: erc20, Weight: 20},
+		{Mode: call, Weight: 10},
+	}, mix)
+
+	for _, raw := range []string{
+		"",
+		"transfer",
+		"transfer=0",
+		"transfer=-1",
+		"mixed=10",
+		"unknown=10",
+		"transfer=10,transfer=20",
+	} {
+		_, err := parseMix(raw)
+		assert.Error(t, err, raw)
+	}
+}
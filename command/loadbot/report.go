@@ -0,0 +1,302 @@
+package loadbot
+
+import (
+	"context"
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/umbracle/go-web3"
+)
+
+const (
+	timelineInterval = time.Second
+	poolStatusWait   = time.Second
+)
+
+// latencyBuckets are the upper bounds of the latency histograms,
+// the last bucket counting the latencies above the last bound
+var latencyBuckets = []time.Duration{
+	500 * time.Millisecond,
+	1 * time.Second,
+	2 * time.Second,
+	3 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	15 * time.Second,
+	30 * time.Second,
+	60 * time.Second,
+	120 * time.Second,
+}
+
+// HistogramBucket counts the latencies up to its bound, excluding the lower buckets.
+// The bound of the last bucket is infinite
+type HistogramBucket struct {
+	UpperBound time.Duration
+	Count      uint64
+}
+
+// Distribution is the distribution of the latencies of the transactions
+type Distribution struct {
+	Count     uint64
+	P50       time.Duration
+	P90       time.Duration
+	P99       time.Duration
+	Max       time.Duration
+	Histogram []HistogramBucket
+}
+
+// newDistribution calculates the distribution of the latencies
+func newDistribution(latencies []time.Duration) Distribution {
+	histogram := make([]HistogramBucket, len(latencyBuckets)+1)
+
+	for i, bound := range latencyBuckets {
+		histogram[i].UpperBound = bound
+	}
+
+	histogram[len(latencyBuckets)].UpperBound = time.Duration(math.MaxInt64)
+
+	if len(latencies) == 0 {
+		return Distribution{Histogram: histogram}
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	for _, latency := range sorted {
+		bucket := sort.Search(len(latencyBuckets), func(i int) bool {
+			return latency <= latencyBuckets[i]
+		})
+
+		histogram[bucket].Count++
+	}
+
+	return Distribution{
+		Count:     uint64(len(sorted)),
+		P50:       percentile(sorted, 50),
+		P90:       percentile(sorted, 90),
+		P99:       percentile(sorted, 99),
+		Max:       sorted[len(sorted)-1],
+		Histogram: histogram,
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// TimelineSample is the activity of the run during a second
+type TimelineSample struct {
+	// Second is the second of the run, starting from 1
+	Second uint64
+
+	// Submitted, Sealed and Failed are the transactions submitted, sealed
+	// and failed during the second
+	Submitted uint64
+	Sealed    uint64
+	Failed    uint64
+
+	// PendingTxns is the size of the pool at the end of the second
+	PendingTxns uint64
+}
+
+// BlockMetrics are the metrics of a block containing loadbot transactions
+type BlockMetrics struct {
+	Number       uint64
+	Timestamp    uint64
+	GasUsed      uint64
+	GasLimit     uint64
+	Transactions uint64
+
+	// LoadbotTransactions is the number of loadbot transactions in the block
+	LoadbotTransactions uint64
+}
+
+// GasUtilization returns the share of the gas limit used by the block, in percent
+func (b *BlockMetrics) GasUtilization() float64 {
+	if b.GasLimit == 0 {
+		return 0
+	}
+
+	return float64(b.GasUsed) / float64(b.GasLimit) * 100
+}
+
+// ErrorCount is the number of failed transactions with the same error
+type ErrorCount struct {
+	ErrorType generator.TxnErrorType
+	Message   string
+	Count     uint64
+}
+
+// newErrorBreakdown groups the failed transactions by error, the most frequent first
+func newErrorBreakdown(failedTxns []*generator.FailedTxnInfo) []ErrorCount {
+	type errorKey struct {
+		errorType generator.TxnErrorType
+		message   string
+	}
+
+	counts := make(map[errorKey]uint64)
+
+	for _, failedTxn := range failedTxns {
+		counts[errorKey{failedTxn.Error.ErrorType, failedTxn.Error.Error.Error()}]++
+	}
+
+	breakdown := make([]ErrorCount, 0, len(counts))
+
+	for key, count := range counts {
+		breakdown = append(breakdown, ErrorCount{
+			ErrorType: key.errorType,
+			Message:   key.message,
+			Count:     count,
+		})
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Count != breakdown[j].Count {
+			return breakdown[i].Count > breakdown[j].Count
+		}
+
+		if breakdown[i].ErrorType != breakdown[j].ErrorType {
+			return breakdown[i].ErrorType < breakdown[j].ErrorType
+		}
+
+		return breakdown[i].Message < breakdown[j].Message
+	})
+
+	return breakdown
+}
+
+// startTimeline samples the activity of the run every second, until the returned function is called
+func (l *Loadbot) startTimeline() func() {
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer close(doneCh)
+
+		ticker := time.NewTicker(timelineInterval)
+		defer ticker.Stop()
+
+		var submitted, sealed, failed uint64
+
+		for second := uint64(1); ; second++ {
+			stopped := false
+
+			select {
+			case <-ticker.C:
+			case <-stopCh:
+				// the last sample covers the rest of the run
+				stopped = true
+			}
+
+			sample := &TimelineSample{
+				Second:      second,
+				PendingTxns: l.getPendingTxns(),
+			}
+
+			sample.Submitted, submitted = delta(&l.metrics.TotalTransactionsSentCount, submitted)
+			sample.Sealed, sealed = delta(&l.metrics.sealedTransactionsCount, sealed)
+			sample.Failed, failed = delta(&l.metrics.FailedTransactionsCount, failed)
+
+			l.metrics.Timeline = append(l.metrics.Timeline, sample)
+
+			if stopped {
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stopCh)
+		<-doneCh
+	}
+}
+
+// delta returns the increase of the counter since the last value, and its current value
+func delta(counter *uint64, last uint64) (uint64, uint64) {
+	current := atomic.LoadUint64(counter)
+
+	return current - last, current
+}
+
+// getPendingTxns returns the number of transactions in the pool,
+// which is zero if the pool status is unavailable
+func (l *Loadbot) getPendingTxns() uint64 {
+	ctx, cancel := context.WithTimeout(context.Background(), poolStatusWait)
+	defer cancel()
+
+	status, err := l.grpcClient.Status(ctx, &empty.Empty{})
+	if err != nil {
+		return 0
+	}
+
+	return status.Length
+}
+
+// collectBlocks queries the blocks sealing the transactions of the run, and sets
+// the inclusion latency of the transactions from the block timestamps
+func (l *Loadbot) collectBlocks() error {
+	included := make(map[uint64][]*metadata)
+
+	l.metrics.TransactionDuration.turnAroundMap.Range(func(_, value interface{}) bool {
+		data, ok := value.(*metadata)
+		if ok {
+			included[data.blockNumber] = append(included[data.blockNumber], data)
+		}
+
+		return true
+	})
+
+	l.metrics.Blocks = make([]*BlockMetrics, 0, len(included))
+
+	for number, txns := range included {
+		block, err := l.jsonClient.Eth().GetBlockByNumber(web3.BlockNumber(number), false)
+		if err != nil {
+			return fmt.Errorf("unable to query block %d, %w", number, err)
+		}
+
+		if block == nil {
+			return fmt.Errorf("block %d not found", number)
+		}
+
+		// the block timestamps have a resolution of a second,
+		// so the transactions sealed within it have no latency
+		sealTime := time.Unix(int64(block.Timestamp), 0)
+
+		for _, data := range txns {
+			data.inclusionLatency = sealTime.Sub(data.submitTime)
+			if data.inclusionLatency < 0 {
+				data.inclusionLatency = 0
+			}
+		}
+
+		l.metrics.Blocks = append(l.metrics.Blocks, &BlockMetrics{
+			Number:              number,
+			Timestamp:           block.Timestamp,
+			GasUsed:             block.GasUsed,
+			GasLimit:            block.GasLimit,
+			Transactions:        uint64(len(block.TransactionsHashes)),
+			LoadbotTransactions: uint64(len(txns)),
+		})
+	}
+
+	sort.Slice(l.metrics.Blocks, func(i, j int) bool {
+		return l.metrics.Blocks[i].Number < l.metrics.Blocks[j].Number
+	})
+
+	return nil
+}
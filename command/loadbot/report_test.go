@@ -0,0 +1,156 @@
+package loadbot
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/go-web3"
+)
+
+func TestNewDistribution(t *testing.T) {
+	latencies := make([]time.Duration, 0, 100)
+
+	// 1s to 100s, in reverse order
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Second)
+	}
+
+	distribution := newDistribution(latencies)
+
+	assert.Equal(t, uint64(100), distribution.Count)
+	assert.Equal(t, 50*time.Second, distribution.P50)
+	assert.Equal(t, 90*time.Second, distribution.P90)
+	assert.Equal(t, 99*time.Second, distribution.P99)
+	assert.Equal(t, 100*time.Second, distribution.Max)
+
+	counts := make([]uint64, len(distribution.Histogram))
+	for i, bucket := range distribution.Histogram {
+		counts[i] = bucket.Count
+	}
+
+	// <= 0.5s, 1s, 2s, 3s, 5s, 10s, 15s, 30s, 60s, 120s, > 120s
+	assert.Equal(t, []uint64{0, 1, 1, 1, 2, 5, 5, 15, 30, 40, 0}, counts)
+
+	t.Run("empty", func(t *testing.T) {
+		distribution := newDistribution(nil)
+
+		assert.Equal(t, uint64(0), distribution.Count)
+		assert.Equal(t, time.Duration(0), distribution.Max)
+		assert.Len(t, distribution.Histogram, len(latencyBuckets)+1)
+	})
+}
+
+func TestNewErrorBreakdown(t *testing.T) {
+	failedTxn := func(errorType generator.TxnErrorType, message string) *generator.FailedTxnInfo {
+		return &generator.FailedTxnInfo{
+			Error: &generator.TxnError{
+				Error:     errors.New(message),
+				ErrorType: errorType,
+			},
+		}
+	}
+
+	breakdown := newErrorBreakdown([]*generator.FailedTxnInfo{
+		failedTxn(generator.AddErrorType, "nonce too low"),
+		failedTxn(generator.ReceiptErrorType, "timeout"),
+		failedTxn(generator.AddErrorType, "nonce too low"),
+		failedTxn(generator.AddErrorType, "underpriced"),
+	})
+
+	assert.Equal(t, []ErrorCount{
+		{ErrorType: generator.AddErrorType, Message: "nonce too low", Count: 2},
+		{ErrorType: generator.AddErrorType, Message: "underpriced", Count: 1},
+		{ErrorType: generator.ReceiptErrorType, Message: "timeout", Count: 1},
+	}, breakdown)
+}
+
+func TestExportReport(t *testing.T) {
+	submitTime := time.Now()
+
+	phaseMetrics := newPhaseMetrics(&Phase{Name: "constant", Count: 2})
+
+	metrics := &Metrics{
+		TransactionDuration: ExecDuration{
+			blockTransactions: make(map[uint64]uint64),
+		},
+		Phases: []*PhaseMetrics{phaseMetrics},
+		Timeline: []*TimelineSample{
+			{Second: 1, Submitted: 2, PendingTxns: 2},
+			{Second: 2, Sealed: 1, PendingTxns: 1},
+		},
+		Blocks: []*BlockMetrics{
+			{Number: 5, GasUsed: 21000, GasLimit: 84000, Transactions: 1, LoadbotTransactions: 1},
+		},
+	}
+
+	report := func(txHash web3.Hash, data *metadata) {
+		metrics.TransactionDuration.reportTurnAroundTime(txHash, data)
+		phaseMetrics.TransactionDuration.reportTurnAroundTime(txHash, data)
+	}
+
+	report(web3.Hash{0x2}, &metadata{
+		turnAroundTime:   3 * time.Second,
+		blockNumber:      6,
+		submitTime:       submitTime.Add(time.Second),
+		inclusionLatency: 2 * time.Second,
+	})
+	report(web3.Hash{0x1}, &metadata{
+		turnAroundTime:   2 * time.Second,
+		blockNumber:      5,
+		submitTime:       submitTime,
+		inclusionLatency: time.Second,
+	})
+
+	metrics.TransactionDuration.calcTurnAroundMetrics()
+
+	result := &LoadbotResult{}
+	result.extractExecutionData(metrics)
+
+	dir := t.TempDir()
+	assert.NoError(t, exportReport(dir, result, metrics))
+
+	readCSV := func(name string) [][]string {
+		file, err := os.Open(filepath.Join(dir, name))
+		assert.NoError(t, err)
+
+		defer file.Close()
+
+		records, err := csv.NewReader(file).ReadAll()
+		assert.NoError(t, err)
+
+		return records
+	}
+
+	assert.Equal(t, [][]string{
+		{"second", "submitted", "sealed", "failed", "pending_txns"},
+		{"1", "2", "0", "0", "2"},
+		{"2", "0", "1", "0", "1"},
+	}, readCSV(timelineFile))
+
+	assert.Equal(t, [][]string{
+		{"number", "timestamp", "txns", "loadbot_txns", "gas_used", "gas_limit", "gas_utilization"},
+		{"5", "0", "1", "1", "21000", "84000", "25"},
+	}, readCSV(blocksFile))
+
+	transactions := readCSV(transactionsFile)
+	assert.Len(t, transactions, 3)
+	assert.Equal(t, []string{web3.Hash{0x1}.String(), "constant", "5"}, transactions[1][:3])
+	assert.Equal(t, []string{"2", "1"}, transactions[1][4:])
+	assert.Equal(t, web3.Hash{0x2}.String(), transactions[2][0])
+
+	latency := readCSV(latencyFile)
+	assert.Len(t, latency, len(latencyBuckets)+2)
+	assert.Equal(t, []string{"1", "1", "0"}, latency[2])
+	assert.Equal(t, []string{"+Inf", "0", "0"}, latency[len(latency)-1])
+
+	assert.Equal(t, [][]string{{"type", "error", "count"}}, readCSV(errorsFile))
+
+	_, err := os.Stat(filepath.Join(dir, reportFile))
+	assert.NoError(t, err)
+}
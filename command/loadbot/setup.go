@@ -0,0 +1,234 @@
+package loadbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/command/loadbot/generator"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/go-web3"
+	"math/big"
+)
+
+var ErrSetupTxnFailed = errors.New("setup transaction failed")
+
+// newGenerator creates the generator of the mode, deploying the contracts it calls,
+// and sets the gas limit of its transactions
+func (l *Loadbot) newGenerator(
+	mode Mode,
+	params generator.GeneratorParams,
+) (generator.TransactionGenerator, error) {
+	var (
+		txnGenerator generator.TransactionGenerator
+		genErr       error
+	)
+
+	// the generators are created before deploying the contracts, which sets the
+	// contract address of the params, so that invalid arguments fail early
+	switch mode {
+	case transfer:
+		txnGenerator, genErr = generator.NewTransferGenerator(&params)
+	case deploy:
+		txnGenerator, genErr = generator.NewDeployGenerator(&params)
+	case erc20:
+		if txnGenerator, genErr = generator.NewERC20Generator(&params); genErr == nil {
+			params.ContractAddress, genErr = l.deployERC20(params.Senders)
+		}
+	case erc721:
+		if txnGenerator, genErr = generator.NewERC721Generator(&params); genErr == nil {
+			params.ContractAddress, genErr = l.deployContract(params.Senders[0], generator.ERC721Bytecode)
+		}
+	case call:
+		if txnGenerator, genErr = generator.NewCallGenerator(&params); genErr == nil {
+			params.ContractAddress = l.cfg.ContractAddress
+			if params.ContractAddress == types.ZeroAddress {
+				params.ContractAddress, genErr = l.deployContract(params.Senders[0], params.ContractArtifact.Bytecode)
+			}
+		}
+	case mixed:
+		// the gas limit is set by each generator of the mix
+		return l.newMixedGenerator(params)
+	default:
+		genErr = fmt.Errorf("invalid loadbot mode %s", mode)
+	}
+
+	if genErr != nil {
+		return nil, fmt.Errorf("unable to start the %s generator, %w", mode, genErr)
+	}
+
+	if err := l.setGasLimit(txnGenerator); err != nil {
+		return nil, err
+	}
+
+	return txnGenerator, nil
+}
+
+func (l *Loadbot) newMixedGenerator(params generator.GeneratorParams) (generator.TransactionGenerator, error) {
+	weightedGenerators := make([]generator.WeightedGenerator, 0, len(l.cfg.Mix))
+
+	for _, modeWeight := range l.cfg.Mix {
+		txnGenerator, err := l.newGenerator(modeWeight.Mode, params)
+		if err != nil {
+			return nil, err
+		}
+
+		weightedGenerators = append(weightedGenerators, generator.WeightedGenerator{
+			Generator: txnGenerator,
+			Weight:    modeWeight.Weight,
+		})
+	}
+
+	return generator.NewMixedGenerator(weightedGenerators)
+}
+
+// setGasLimit sets the gas limit of the transactions of the generator,
+// estimated from its example transaction if not set
+func (l *Loadbot) setGasLimit(txnGenerator generator.TransactionGenerator) error {
+	if l.cfg.GasLimit != nil {
+		txnGenerator.SetGasEstimate(l.cfg.GasLimit.Uint64())
+
+		return nil
+	}
+
+	exampleTxn, err := txnGenerator.GetExampleTransaction()
+	if err != nil {
+		return fmt.Errorf("unable to get example transaction, %w", err)
+	}
+
+	// No gas limit specified, query the network for an estimation
+	gasEstimate, err := estimateGas(l.jsonClient, exampleTxn)
+	if err != nil {
+		return fmt.Errorf("unable to get gas estimate, %w", err)
+	}
+
+	txnGenerator.SetGasEstimate(gasEstimate)
+
+	return nil
+}
+
+// deployContract deploys the contract from the sender, returning its address
+func (l *Loadbot) deployContract(sender *generator.Account, bytecode string) (types.Address, error) {
+	code, err := (&generator.ContractArtifact{Bytecode: bytecode}).DecodeBytecode()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	txHash, err := l.sendSetupTxn(sender, nil, code)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	receipt, err := l.waitForSetupTxn(txHash)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	deployed, err := l.jsonClient.Eth().GetCode(receipt.ContractAddress, web3.Latest)
+	if err != nil {
+		return types.ZeroAddress, fmt.Errorf("unable to query the deployed contract, %w", err)
+	}
+
+	if deployed == "0x" || deployed == "" {
+		return types.ZeroAddress, fmt.Errorf("%w: contract deployment %s", ErrSetupTxnFailed, txHash)
+	}
+
+	return types.Address(receipt.ContractAddress), nil
+}
+
+// deployERC20 deploys the token from the first sender, which sends
+// an equal share of the supply to the other senders
+func (l *Loadbot) deployERC20(senders []*generator.Account) (types.Address, error) {
+	token, err := l.deployContract(senders[0], generator.ERC20Bytecode)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	if len(senders) == 1 {
+		return token, nil
+	}
+
+	supplyRaw, err := l.jsonClient.Eth().Call(&web3.CallMsg{
+		To:   (*web3.Address)(&token),
+		Data: generator.ERC20ABI.GetMethod("totalSupply").ID(),
+	}, web3.Latest)
+	if err != nil {
+		return types.ZeroAddress, fmt.Errorf("unable to query the token supply, %w", err)
+	}
+
+	supply, ok := new(big.Int).SetString(supplyRaw, 0)
+	if !ok {
+		return types.ZeroAddress, fmt.Errorf("invalid token supply %s", supplyRaw)
+	}
+
+	share := supply.Div(supply, big.NewInt(int64(len(senders))))
+	hashes := make([]web3.Hash, 0, len(senders)-1)
+
+	for _, sender := range senders[1:] {
+		input, err := generator.ERC20ABI.GetMethod("transfer").Encode([]interface{}{sender.Address, share})
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
+		txHash, err := l.sendSetupTxn(senders[0], &token, input)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
+		hashes = append(hashes, txHash)
+	}
+
+	for _, txHash := range hashes {
+		receipt, err := l.waitForSetupTxn(txHash)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
+		// the transfers which revert emit no event
+		if len(receipt.Logs) == 0 {
+			return types.ZeroAddress, fmt.Errorf("%w: token transfer %s", ErrSetupTxnFailed, txHash)
+		}
+	}
+
+	return token, nil
+}
+
+// sendSetupTxn sends a transaction preparing the run, with the estimated gas limit
+func (l *Loadbot) sendSetupTxn(sender *generator.Account, to *types.Address, input []byte) (web3.Hash, error) {
+	txn := &types.Transaction{
+		From:     sender.Address,
+		To:       to,
+		Value:    big.NewInt(0),
+		GasPrice: l.gasPrice,
+		Input:    input,
+		V:        big.NewInt(1), // it is necessary to encode in rlp
+	}
+
+	gasEstimate, err := estimateGas(l.jsonClient, txn)
+	if err != nil {
+		return web3.Hash{}, err
+	}
+
+	txn.Gas = gasEstimate
+	txn.Nonce = sender.NextNonce()
+
+	signedTxn, err := l.signer.SignTx(txn, sender.PrivateKey)
+	if err != nil {
+		return web3.Hash{}, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return l.addTxn(signedTxn)
+}
+
+// waitForSetupTxn waits for the receipt of the setup transaction
+func (l *Loadbot) waitForSetupTxn(txHash web3.Hash) (*web3.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), maxReceiptWait)
+	defer cancel()
+
+	receipt, err := tests.WaitForReceipt(ctx, l.jsonClient.Eth(), txHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the receipt of the setup transaction %s, %w", txHash, err)
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,108 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+// PeersAllow is the command to add a peer to the allowlist
+type PeersAllow struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersAllow) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["id"] = helper.FlagDescriptor{
+		Description: "Libp2p node ID of the peer to allow",
+		Arguments: []string{
+			"PEER_ID",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersAllow) GetHelperText() string {
+	return "Adds a peer to the allowlist, accepting its connections"
+}
+
+func (p *PeersAllow) GetBaseCommand() string {
+	return "peers allow"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersAllow) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersAllow) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersAllow) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var id string
+
+	flags.StringVar(&id, "id", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if id == "" {
+		p.Formatter.OutputError(errors.New("peer id argument not provided"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+	if _, err := clt.PeersAllow(context.Background(), &proto.PeersAllowRequest{Id: id}); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&PeersAllowResult{ID: id})
+
+	return 0
+}
+
+type PeersAllowResult struct {
+	ID string `json:"id"`
+}
+
+func (r *PeersAllowResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER ALLOWED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
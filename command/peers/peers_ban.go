@@ -0,0 +1,157 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+// PeersBan is the command to ban a peer
+type PeersBan struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersBan) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["id"] = helper.FlagDescriptor{
+		Description: "Libp2p node ID of the peer to ban",
+		Arguments: []string{
+			"PEER_ID",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+
+	p.FlagMap["duration"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Duration of the ban, the ban is permanent if zero. Default: %s",
+			network.DefaultBanDuration,
+		),
+		Arguments: []string{
+			"DURATION",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	p.FlagMap["reason"] = helper.FlagDescriptor{
+		Description: "Reason of the ban",
+		Arguments: []string{
+			"REASON",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersBan) GetHelperText() string {
+	return "Disconnects from a peer and refuses its connections until the ban expires"
+}
+
+func (p *PeersBan) GetBaseCommand() string {
+	return "peers ban"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersBan) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersBan) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersBan) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var (
+		id       string
+		reason   string
+		duration time.Duration
+	)
+
+	flags.StringVar(&id, "id", "", "")
+	flags.StringVar(&reason, "reason", "", "")
+	flags.DurationVar(&duration, "duration", network.DefaultBanDuration, "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if id == "" {
+		p.Formatter.OutputError(errors.New("peer id argument not provided"))
+
+		return 1
+	}
+
+	if duration < 0 {
+		p.Formatter.OutputError(errors.New("invalid ban duration"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+	if _, err := clt.PeersBan(context.Background(), &proto.PeersBanRequest{
+		Id:       id,
+		Reason:   reason,
+		Duration: int64(duration.Seconds()),
+	}); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&PeersBanResult{
+		ID:       id,
+		Duration: duration.String(),
+	})
+
+	return 0
+}
+
+type PeersBanResult struct {
+	ID       string `json:"id"`
+	Duration string `json:"duration"`
+}
+
+func (r *PeersBanResult) Output() string {
+	var buffer bytes.Buffer
+
+	duration := r.Duration
+	if duration == "0s" {
+		duration = "permanent"
+	}
+
+	buffer.WriteString("\n[PEER BANNED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+		fmt.Sprintf("Duration|%s", duration),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
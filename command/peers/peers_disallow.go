@@ -0,0 +1,109 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+// PeersDisallow is the command to remove a peer allowed at runtime from the allowlist
+type PeersDisallow struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersDisallow) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["id"] = helper.FlagDescriptor{
+		Description: "Libp2p node ID of the peer allowed at runtime",
+		Arguments: []string{
+			"PEER_ID",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersDisallow) GetHelperText() string {
+	return "Removes a peer allowed at runtime from the allowlist, " +
+		"disconnecting from it unless still allowed by the config or the node registry"
+}
+
+func (p *PeersDisallow) GetBaseCommand() string {
+	return "peers disallow"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersDisallow) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersDisallow) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersDisallow) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var id string
+
+	flags.StringVar(&id, "id", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if id == "" {
+		p.Formatter.OutputError(errors.New("peer id argument not provided"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+	if _, err := clt.PeersDisallow(context.Background(), &proto.PeersDisallowRequest{Id: id}); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&PeersDisallowResult{ID: id})
+
+	return 0
+}
+
+type PeersDisallowResult struct {
+	ID string `json:"id"`
+}
+
+func (r *PeersDisallowResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER DISALLOWED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,128 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PeersListAllowed is the command to list the allowed peers
+type PeersListAllowed struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersListAllowed) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersListAllowed) GetHelperText() string {
+	return "Returns the list of allowed peers, with the source allowing them"
+}
+
+func (p *PeersListAllowed) GetBaseCommand() string {
+	return "peers list-allowed"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersListAllowed) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersListAllowed) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersListAllowed) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+
+	resp, err := clt.PeersListAllowed(context.Background(), &empty.Empty{})
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(NewPeersListAllowedResult(resp))
+
+	return 0
+}
+
+type AllowedPeerResult struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+}
+
+type PeersListAllowedResult struct {
+	Enabled bool                `json:"enabled"`
+	Peers   []AllowedPeerResult `json:"peers"`
+}
+
+func NewPeersListAllowedResult(resp *proto.PeersListAllowedResponse) *PeersListAllowedResult {
+	peers := make([]AllowedPeerResult, len(resp.Peers))
+
+	for i, p := range resp.Peers {
+		peers[i] = AllowedPeerResult{
+			ID:     p.Id,
+			Source: p.Source,
+		}
+	}
+
+	return &PeersListAllowedResult{
+		Enabled: resp.Enabled,
+		Peers:   peers,
+	}
+}
+
+func (r *PeersListAllowedResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[ALLOWED PEERS]\n")
+
+	switch {
+	case !r.Enabled:
+		buffer.WriteString("Allowlist disabled, the connections of all the peers are accepted")
+	case len(r.Peers) == 0:
+		buffer.WriteString("No allowed peers")
+	default:
+		buffer.WriteString(fmt.Sprintf("Number of allowed peers: %d\n\n", len(r.Peers)))
+
+		rows := make([]string, len(r.Peers)+1)
+		rows[0] = "ID|Source"
+
+		for i, p := range r.Peers {
+			rows[i+1] = fmt.Sprintf("%s|%s", p.ID, p.Source)
+		}
+
+		buffer.WriteString(helper.FormatKV(rows))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
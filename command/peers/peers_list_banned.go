@@ -0,0 +1,131 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PeersListBanned is the command to list the banned peers
+type PeersListBanned struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersListBanned) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersListBanned) GetHelperText() string {
+	return "Returns the list of banned peers"
+}
+
+func (p *PeersListBanned) GetBaseCommand() string {
+	return "peers list-banned"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersListBanned) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersListBanned) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersListBanned) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+
+	resp, err := clt.PeersListBanned(context.Background(), &empty.Empty{})
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(NewPeersListBannedResult(resp))
+
+	return 0
+}
+
+type BannedPeerResult struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+	Until  string `json:"until"`
+}
+
+type PeersListBannedResult struct {
+	Peers []BannedPeerResult `json:"peers"`
+}
+
+func NewPeersListBannedResult(resp *proto.PeersListBannedResponse) *PeersListBannedResult {
+	peers := make([]BannedPeerResult, len(resp.Peers))
+
+	for i, p := range resp.Peers {
+		until := "permanent"
+		if p.Until != 0 {
+			until = time.Unix(p.Until, 0).UTC().Format(time.RFC3339)
+		}
+
+		peers[i] = BannedPeerResult{
+			ID:     p.Id,
+			Reason: p.Reason,
+			Until:  until,
+		}
+	}
+
+	return &PeersListBannedResult{
+		Peers: peers,
+	}
+}
+
+func (r *PeersListBannedResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BANNED PEERS]\n")
+
+	if len(r.Peers) == 0 {
+		buffer.WriteString("No banned peers")
+	} else {
+		buffer.WriteString(fmt.Sprintf("Number of banned peers: %d\n\n", len(r.Peers)))
+
+		rows := make([]string, len(r.Peers)+1)
+		rows[0] = "ID|Until|Reason"
+
+		for i, p := range r.Peers {
+			rows[i+1] = fmt.Sprintf("%s|%s|%s", p.ID, p.Until, p.Reason)
+		}
+
+		buffer.WriteString(helper.FormatKV(rows))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
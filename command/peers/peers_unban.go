@@ -0,0 +1,108 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+// PeersUnban is the command to lift the ban of a peer
+type PeersUnban struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *PeersUnban) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["id"] = helper.FlagDescriptor{
+		Description: "Libp2p node ID of the banned peer",
+		Arguments: []string{
+			"PEER_ID",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *PeersUnban) GetHelperText() string {
+	return "Lifts the ban of a peer, accepting its connections again"
+}
+
+func (p *PeersUnban) GetBaseCommand() string {
+	return "peers unban"
+}
+
+// Help implements the cli.Command interface
+func (p *PeersUnban) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *PeersUnban) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *PeersUnban) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var id string
+
+	flags.StringVar(&id, "id", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if id == "" {
+		p.Formatter.OutputError(errors.New("peer id argument not provided"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := proto.NewSystemClient(conn)
+	if _, err := clt.PeersUnban(context.Background(), &proto.PeersUnbanRequest{Id: id}); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&PeersUnbanResult{ID: id})
+
+	return 0
+}
+
+type PeersUnbanResult struct {
+	ID string `json:"id"`
+}
+
+func (r *PeersUnbanResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER UNBANNED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/hashicorp/go-hclog"
@@ -100,6 +101,119 @@ func (c *ServerCommand) DefineFlags() {
 		FlagOptional: true,
 	}
 
+	c.FlagMap["enable-personal"] = helper.FlagDescriptor{
+		Description: "Exposes the personal JSON-RPC namespace, used for managing the accounts " +
+			"stored in the node keystore. It is served only if the JSON-RPC service is bound to a loopback address, " +
+			"to the browser scripts from the origins set by jsonrpc-allowed-origin only. Default: false",
+		Arguments: []string{
+			"ENABLE_PERSONAL",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["enable-admin"] = helper.FlagDescriptor{
+		Description: "Exposes the admin and ibft JSON-RPC namespaces, used for managing the node. " +
+			"They are served only if the JSON-RPC service is bound to a loopback address, to the browser scripts " +
+			"from the origins set by jsonrpc-allowed-origin only, and within the roles of the anonymous " +
+			"callers of the grpc-auth-file policy, if set. Default: false",
+		Arguments: []string{
+			"ENABLE_ADMIN",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["jsonrpc-allowed-origin"] = helper.FlagDescriptor{
+		Description: "Allows the browser requests from the given origin (scheme://host:port) once the personal " +
+			"or admin namespaces are enabled. The other cross-origin requests are rejected. Can be repeated",
+		Arguments: []string{
+			"JSONRPC_ALLOWED_ORIGIN",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["graphql"] = helper.FlagDescriptor{
+		Description: "Sets the address and port for the EIP-1767 GraphQL service (address:port). " +
+			"The service is disabled if no address is set",
+		Arguments: []string{
+			"GRAPHQL_ADDRESS",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["grpc-tls-cert"] = helper.FlagDescriptor{
+		Description: "Sets the path to the TLS certificate of the gRPC operator service, which is plaintext if not set",
+		Arguments: []string{
+			"GRPC_TLS_CERT",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["grpc-tls-key"] = helper.FlagDescriptor{
+		Description: "Sets the path to the key of the TLS certificate of the gRPC operator service",
+		Arguments: []string{
+			"GRPC_TLS_KEY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["grpc-tls-client-ca"] = helper.FlagDescriptor{
+		Description: "Sets the path to the CA certificate the gRPC clients must present a certificate " +
+			"signed by (mutual TLS). The client certificates are not required if not set",
+		Arguments: []string{
+			"GRPC_TLS_CLIENT_CA",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["grpc-auth-file"] = helper.FlagDescriptor{
+		Description: "Sets the path to the JSON policy granting roles (read-only, peer-admin, consensus-admin, " +
+			"txpool-admin, admin) to the tokens and client certificates of the gRPC operator service. " +
+			"All the calls are allowed if not set",
+		Arguments: []string{
+			"GRPC_AUTH_FILE",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["jsonrpc-tls-cert"] = helper.FlagDescriptor{
+		Description: "Sets the path to the TLS certificate of the JSON-RPC (HTTP and WS) and GraphQL services, " +
+			"which are plaintext if not set",
+		Arguments: []string{
+			"JSONRPC_TLS_CERT",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["jsonrpc-tls-key"] = helper.FlagDescriptor{
+		Description: "Sets the path to the key of the TLS certificate of the JSON-RPC and GraphQL services",
+		Arguments: []string{
+			"JSONRPC_TLS_KEY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["graphql-max-depth"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum nesting depth of a GraphQL query. Default: %d",
+			jsonrpc.DefaultGraphQLMaxDepth,
+		),
+		Arguments: []string{
+			"GRAPHQL_MAX_DEPTH",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["graphql-max-complexity"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum complexity of a GraphQL query, as the number of resolved objects. Default: %d",
+			jsonrpc.DefaultGraphQLMaxComplexity,
+		),
+		Arguments: []string{
+			"GRAPHQL_MAX_COMPLEXITY",
+		},
+		FlagOptional: true,
+	}
+
 	c.FlagMap["libp2p"] = helper.FlagDescriptor{
 		Description: fmt.Sprintf(
 			"Sets the address and port for the libp2p service (address:port). Default: address: 127.0.0.1:%d",
@@ -151,6 +265,71 @@ func (c *ServerCommand) DefineFlags() {
 		FlagOptional: true,
 	}
 
+	c.FlagMap["max-inbound-peers"] = helper.FlagDescriptor{
+		Description: "Sets the client's max inbound peer count. Default: max-peers",
+		Arguments: []string{
+			"PEER_COUNT",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["max-outbound-peers"] = helper.FlagDescriptor{
+		Description: "Sets the client's max outbound peer count. Default: max-peers",
+		Arguments: []string{
+			"PEER_COUNT",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["nat-port-map"] = helper.FlagDescriptor{
+		Description: "Maps the libp2p port on the NAT device using UPnP or NAT-PMP. Default: false",
+		Arguments: []string{
+			"NAT_PORT_MAP",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["relay"] = helper.FlagDescriptor{
+		Description: "Relays the connections of the peers behind a NAT until they are hole punched, " +
+			"and helps them detect their reachability. Meant for the publicly reachable bootnodes. Default: false",
+		Arguments: []string{
+			"RELAY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["auto-relay"] = helper.FlagDescriptor{
+		Description: "Accepts the connections through the bootnodes acting as relays once the client " +
+			"is detected behind a NAT, and hole punches them into direct connections. Default: false",
+		Arguments: []string{
+			"AUTO_RELAY",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["network-role"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the role of the client in a sentry topology, either %s or %s. A validator only connects "+
+				"to its sentries, set as persistent peers, and a sentry never advertises its validators, "+
+				"set as private peers. The peers are listed in the config file",
+			network.RoleValidator,
+			network.RoleSentry,
+		),
+		Arguments: []string{
+			"NETWORK_ROLE",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["allowlist"] = helper.FlagDescriptor{
+		Description: "Only accepts the connections of the allowed peers, listed in the config file, " +
+			"allowed at runtime or held by the node registry of the chain. Default: false",
+		Arguments: []string{
+			"ALLOWLIST",
+		},
+		FlagOptional: true,
+	}
+
 	c.FlagMap["price-limit"] = helper.FlagDescriptor{
 		Description: fmt.Sprintf(
 			"Sets minimum gas price limit to enforce for acceptance into the pool. Default: %d",
@@ -162,6 +341,103 @@ func (c *ServerCommand) DefineFlags() {
 		FlagOptional: true,
 	}
 
+	c.FlagMap["price-bump"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the minimum gas price increase (in percent) required to replace "+
+				"a transaction with the same nonce in the pool. Default: %d",
+			helper.DefaultConfig().TxPool.PriceBump,
+		),
+		Arguments: []string{
+			"PRICE_BUMP",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["max-account-enqueued"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum number of enqueued transactions per account (0 for no limit). Default: %d",
+			helper.DefaultConfig().TxPool.MaxAccountEnqueued,
+		),
+		Arguments: []string{
+			"MAX_ACCOUNT_ENQUEUED",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["max-account-promoted"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the maximum number of promoted transactions per account (0 for no limit). Default: %d",
+			helper.DefaultConfig().TxPool.MaxAccountPromoted,
+		),
+		Arguments: []string{
+			"MAX_ACCOUNT_PROMOTED",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["enqueued-lifetime"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the time (in seconds) enqueued transactions with nonce gaps are kept in the pool "+
+				"without any account activity (0 to keep them forever). Default: %d",
+			helper.DefaultConfig().TxPool.EnqueuedLifetime,
+		),
+		Arguments: []string{
+			"ENQUEUED_LIFETIME",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["no-journal"] = helper.FlagDescriptor{
+		Description: "Disables the journal of local transactions, which are then lost on restart. Default: false",
+		Arguments: []string{
+			"NO_JOURNAL",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["journal-rotate"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the interval (in seconds) of regenerating the journal of local transactions. Default: %d",
+			helper.DefaultConfig().TxPool.JournalRotate,
+		),
+		Arguments: []string{
+			"JOURNAL_ROTATE",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["legacy-tx-gossip"] = helper.FlagDescriptor{
+		Description: "Also gossips full transactions on the legacy txpool topic, " +
+			"for peers not supporting hash announcements. Default: false",
+		Arguments: []string{
+			"LEGACY_TX_GOSSIP",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["private-tx-lifetime"] = helper.FlagDescriptor{
+		Description: fmt.Sprintf(
+			"Sets the number of blocks private transactions, forwarded only to the validators, "+
+				"are kept in the pool before being dropped. Default: %d",
+			helper.DefaultConfig().TxPool.PrivateTxLifetime,
+		),
+		Arguments: []string{
+			"PRIVATE_TX_LIFETIME",
+		},
+		FlagOptional: true,
+	}
+
+	c.FlagMap["admission-policy"] = helper.FlagDescriptor{
+		Description: "Sets the path of the JSON file with the transaction admission policy " +
+			"(allowlist, denylist and deployAllowlist), reloadable with 'txpool reload-policy'. " +
+			"The policy is enforced only by the txpool of this node, when adding transactions and building blocks",
+		Arguments: []string{
+			"ADMISSION_POLICY",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
 	c.FlagMap["max-slots"] = helper.FlagDescriptor{
 		Description: fmt.Sprintf("Sets maximum slots in the pool. Default: %d", helper.DefaultConfig().TxPool.MaxSlots),
 		Arguments: []string{
@@ -73,6 +73,7 @@ func (c *StatusCommand) Run(args []string) int {
 		CurrentBlockNumber: status.Current.Number,
 		CurrentBlockHash:   status.Current.Hash,
 		LibP2PAddress:      status.P2PAddr,
+		Reachability:       status.Reachability,
 	}
 
 	c.Formatter.OutputResult(res)
@@ -85,6 +86,7 @@ type StatusResult struct {
 	CurrentBlockNumber int64  `json:"current_block_number"`
 	CurrentBlockHash   string `json:"current_block_hash"`
 	LibP2PAddress      string `json:"libp2p_address"`
+	Reachability       string `json:"reachability"`
 }
 
 func (r *StatusResult) Output() string {
@@ -96,6 +98,7 @@ func (r *StatusResult) Output() string {
 		fmt.Sprintf("Current Block Number (base 10)|%d", r.CurrentBlockNumber),
 		fmt.Sprintf("Current Block Hash|%s", r.CurrentBlockHash),
 		fmt.Sprintf("Libp2p Address|%s", r.LibP2PAddress),
+		fmt.Sprintf("Reachability|%s", r.Reachability),
 	}))
 
 	return buffer.String()
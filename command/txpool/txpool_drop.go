@@ -0,0 +1,137 @@
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+// TxPoolDrop is the command to drop a transaction or
+// all transactions of an account from the pool
+type TxPoolDrop struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *TxPoolDrop) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["hash"] = helper.FlagDescriptor{
+		Description: "Hash of the transaction to drop. Promoted transactions of the same account " +
+			"with higher nonces are demoted",
+		Arguments: []string{
+			"TX_HASH",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+
+	p.FlagMap["addr"] = helper.FlagDescriptor{
+		Description: "Address of the account to drop all transactions of",
+		Arguments: []string{
+			"ETH_ADDRESS",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *TxPoolDrop) GetHelperText() string {
+	return "Drops a transaction, or all transactions of an account, from the pool"
+}
+
+func (p *TxPoolDrop) GetBaseCommand() string {
+	return "txpool drop"
+}
+
+// Help implements the cli.Command interface
+func (p *TxPoolDrop) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *TxPoolDrop) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *TxPoolDrop) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var hash, addr string
+
+	flags.StringVar(&hash, "hash", "", "")
+	flags.StringVar(&addr, "addr", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if (hash == "") == (addr == "") {
+		p.Formatter.OutputError(errors.New("either a transaction hash or an account address must be specified"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := txpoolOp.NewTxnPoolOperatorClient(conn)
+
+	var resp *txpoolOp.DropTxnResp
+
+	if hash != "" {
+		resp, err = clt.DropTxn(context.Background(), &txpoolOp.DropTxnReq{Hash: hash})
+	} else {
+		resp, err = clt.DropAccount(context.Background(), &txpoolOp.DropAccountReq{Address: addr})
+	}
+
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&TxPoolDropResult{
+		Dropped: resp.TxHashes,
+	})
+
+	return 0
+}
+
+type TxPoolDropResult struct {
+	Dropped []string `json:"dropped"`
+}
+
+func (r *TxPoolDropResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL DROP]\n")
+
+	if len(r.Dropped) == 0 {
+		buffer.WriteString("No transactions dropped\n")
+
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("Dropped transactions: %d\n\n", len(r.Dropped)))
+	buffer.WriteString(helper.FormatList(r.Dropped))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,154 @@
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+// TxPoolGet is the command to query a transaction in the pool by hash
+type TxPoolGet struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *TxPoolGet) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["hash"] = helper.FlagDescriptor{
+		Description: "Hash of the transaction",
+		Arguments: []string{
+			"TX_HASH",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      false,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *TxPoolGet) GetHelperText() string {
+	return "Returns the transaction in the pool with the given hash"
+}
+
+func (p *TxPoolGet) GetBaseCommand() string {
+	return "txpool get"
+}
+
+// Help implements the cli.Command interface
+func (p *TxPoolGet) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *TxPoolGet) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *TxPoolGet) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var hash string
+
+	flags.StringVar(&hash, "hash", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	if hash == "" {
+		p.Formatter.OutputError(errors.New("transaction hash not specified"))
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := txpoolOp.NewTxnPoolOperatorClient(conn)
+
+	resp, err := clt.GetTxn(context.Background(), &txpoolOp.GetTxnReq{Hash: hash})
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&TxPoolGetResult{
+		Txn: newPoolTxnResult(resp),
+	})
+
+	return 0
+}
+
+type TxPoolGetResult struct {
+	Txn *PoolTxnResult `json:"transaction"`
+}
+
+func (r *TxPoolGetResult) Output() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL TRANSACTION]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Hash|%s", r.Txn.Hash),
+		fmt.Sprintf("Status|%s", r.Txn.Status),
+		fmt.Sprintf("From|%s", r.Txn.From),
+		fmt.Sprintf("To|%s", r.Txn.To),
+		fmt.Sprintf("Nonce|%d", r.Txn.Nonce),
+		fmt.Sprintf("Gas Price|%s", r.Txn.GasPrice),
+		fmt.Sprintf("Gas|%d", r.Txn.Gas),
+		fmt.Sprintf("Value|%s", r.Txn.Value),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+// transaction statuses in the pool
+const (
+	statusPromoted = "promoted"
+	statusEnqueued = "enqueued"
+)
+
+type PoolTxnResult struct {
+	Hash     string `json:"hash"`
+	Status   string `json:"status"`
+	From     string `json:"from"`
+	To       string `json:"to,omitempty"`
+	Nonce    uint64 `json:"nonce"`
+	GasPrice string `json:"gasPrice"`
+	Gas      uint64 `json:"gas"`
+	Value    string `json:"value"`
+}
+
+func newPoolTxnResult(txn *txpoolOp.PoolTxn) *PoolTxnResult {
+	status := statusEnqueued
+	if txn.Promoted {
+		status = statusPromoted
+	}
+
+	return &PoolTxnResult{
+		Hash:     txn.Hash,
+		Status:   status,
+		From:     txn.From,
+		To:       txn.To,
+		Nonce:    txn.Nonce,
+		GasPrice: txn.GasPrice,
+		Gas:      txn.Gas,
+		Value:    txn.Value,
+	}
+}
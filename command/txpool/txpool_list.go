@@ -0,0 +1,169 @@
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+// TxPoolList is the command to list the transactions in the pool per account
+type TxPoolList struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *TxPoolList) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+
+	p.FlagMap["addr"] = helper.FlagDescriptor{
+		Description: "Address of the account to list the transactions of. Default: all accounts",
+		Arguments: []string{
+			"ETH_ADDRESS",
+		},
+		ArgumentsOptional: false,
+		FlagOptional:      true,
+	}
+}
+
+// GetHelperText returns a simple description of the command
+func (p *TxPoolList) GetHelperText() string {
+	return "Lists the promoted and enqueued transactions in the pool per account"
+}
+
+func (p *TxPoolList) GetBaseCommand() string {
+	return "txpool list"
+}
+
+// Help implements the cli.Command interface
+func (p *TxPoolList) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *TxPoolList) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *TxPoolList) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	var addr string
+
+	flags.StringVar(&addr, "addr", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := txpoolOp.NewTxnPoolOperatorClient(conn)
+
+	resp, err := clt.ListTxns(context.Background(), &txpoolOp.ListTxnsReq{Address: addr})
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(newTxPoolListResult(resp))
+
+	return 0
+}
+
+type TxPoolListResult struct {
+	Accounts []*AccountTxnsResult `json:"accounts"`
+}
+
+type AccountTxnsResult struct {
+	Address   string           `json:"address"`
+	NextNonce uint64           `json:"nextNonce"`
+	Promoted  []*PoolTxnResult `json:"promoted"`
+	Enqueued  []*PoolTxnResult `json:"enqueued"`
+}
+
+func newTxPoolListResult(resp *txpoolOp.ListTxnsResp) *TxPoolListResult {
+	toResults := func(txns []*txpoolOp.PoolTxn) []*PoolTxnResult {
+		res := make([]*PoolTxnResult, len(txns))
+		for i, txn := range txns {
+			res[i] = newPoolTxnResult(txn)
+		}
+
+		return res
+	}
+
+	res := &TxPoolListResult{
+		Accounts: make([]*AccountTxnsResult, len(resp.Accounts)),
+	}
+
+	for i, account := range resp.Accounts {
+		res.Accounts[i] = &AccountTxnsResult{
+			Address:   account.Address,
+			NextNonce: account.NextNonce,
+			Promoted:  toResults(account.Promoted),
+			Enqueued:  toResults(account.Enqueued),
+		}
+	}
+
+	return res
+}
+
+func (r *TxPoolListResult) Output() string {
+	var buffer bytes.Buffer
+
+	writeTxns := func(title string, txns []*PoolTxnResult) {
+		buffer.WriteString(fmt.Sprintf("\n[%s]\n", title))
+
+		if len(txns) == 0 {
+			buffer.WriteString("No transactions\n")
+
+			return
+		}
+
+		rows := make([]string, len(txns)+1)
+		rows[0] = "Nonce|Hash|Gas Price|Gas"
+
+		for i, txn := range txns {
+			rows[i+1] = fmt.Sprintf("%d|%s|%s|%d", txn.Nonce, txn.Hash, txn.GasPrice, txn.Gas)
+		}
+
+		buffer.WriteString(helper.FormatList(rows))
+		buffer.WriteString("\n")
+	}
+
+	buffer.WriteString("\n[TXPOOL TRANSACTIONS]\n")
+
+	if len(r.Accounts) == 0 {
+		buffer.WriteString("No transactions in the pool\n")
+
+		return buffer.String()
+	}
+
+	for _, account := range r.Accounts {
+		buffer.WriteString(fmt.Sprintf("\n[ACCOUNT %s]\n", account.Address))
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Next nonce|%d", account.NextNonce),
+		}))
+		buffer.WriteString("\n")
+
+		writeTxns("PROMOTED", account.Promoted)
+		writeTxns("ENQUEUED", account.Enqueued)
+	}
+
+	return buffer.String()
+}
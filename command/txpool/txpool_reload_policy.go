@@ -0,0 +1,109 @@
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TxPoolReloadPolicy is the command to reload the admission policy file
+type TxPoolReloadPolicy struct {
+	helper.Base
+	Formatter *helper.FormatterFlag
+	GRPC      *helper.GRPCFlag
+}
+
+// DefineFlags defines the command flags
+func (p *TxPoolReloadPolicy) DefineFlags() {
+	p.Base.DefineFlags(p.Formatter, p.GRPC)
+}
+
+// GetHelperText returns a simple description of the command
+func (p *TxPoolReloadPolicy) GetHelperText() string {
+	return "Reloads the transaction admission policy from the file set with --admission-policy"
+}
+
+func (p *TxPoolReloadPolicy) GetBaseCommand() string {
+	return "txpool reload-policy"
+}
+
+// Help implements the cli.Command interface
+func (p *TxPoolReloadPolicy) Help() string {
+	p.DefineFlags()
+
+	return helper.GenerateHelp(p.Synopsis(), helper.GenerateUsage(p.GetBaseCommand(), p.FlagMap), p.FlagMap)
+}
+
+// Synopsis implements the cli.Command interface
+func (p *TxPoolReloadPolicy) Synopsis() string {
+	return p.GetHelperText()
+}
+
+// Run implements the cli.Command interface
+func (p *TxPoolReloadPolicy) Run(args []string) int {
+	flags := p.Base.NewFlagSet(p.GetBaseCommand(), p.Formatter, p.GRPC)
+
+	if err := flags.Parse(args); err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	conn, err := p.GRPC.Conn()
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	clt := txpoolOp.NewTxnPoolOperatorClient(conn)
+
+	resp, err := clt.ReloadAdmissionPolicy(context.Background(), &empty.Empty{})
+	if err != nil {
+		p.Formatter.OutputError(err)
+
+		return 1
+	}
+
+	p.Formatter.OutputResult(&TxPoolReloadPolicyResult{
+		Allowlist:       resp.Allowlist,
+		Denylist:        resp.Denylist,
+		DeployAllowlist: resp.DeployAllowlist,
+	})
+
+	return 0
+}
+
+type TxPoolReloadPolicyResult struct {
+	Allowlist       []string `json:"allowlist"`
+	Denylist        []string `json:"denylist"`
+	DeployAllowlist []string `json:"deployAllowlist"`
+}
+
+func (r *TxPoolReloadPolicyResult) Output() string {
+	var buffer bytes.Buffer
+
+	writeList := func(title string, addrs []string) {
+		buffer.WriteString(fmt.Sprintf("\n[%s]\n", title))
+
+		if len(addrs) == 0 {
+			buffer.WriteString("No entries\n")
+
+			return
+		}
+
+		buffer.WriteString(helper.FormatList(addrs))
+		buffer.WriteString("\n")
+	}
+
+	buffer.WriteString("\n[ADMISSION POLICY RELOADED]\n")
+	writeList("ALLOWLIST", r.Allowlist)
+	writeList("DENYLIST", r.Denylist)
+	writeList("DEPLOY ALLOWLIST", r.DeployAllowlist)
+
+	return buffer.String()
+}
@@ -68,6 +68,33 @@ func (t *TxPoolSubscribeCommand) DefineFlags() {
 		ArgumentsOptional: true,
 		FlagOptional:      true,
 	}
+
+	t.FlagMap["replaced"] = helper.FlagDescriptor{
+		Description: "Subscribes for replaced tx events in the TxPool",
+		Arguments: []string{
+			"LISTEN_REPLACED",
+		},
+		ArgumentsOptional: true,
+		FlagOptional:      true,
+	}
+
+	t.FlagMap["evicted"] = helper.FlagDescriptor{
+		Description: "Subscribes for tx events of evictions from the full TxPool",
+		Arguments: []string{
+			"LISTEN_EVICTED",
+		},
+		ArgumentsOptional: true,
+		FlagOptional:      true,
+	}
+
+	t.FlagMap["expired"] = helper.FlagDescriptor{
+		Description: "Subscribes for expired tx events in the account queues",
+		Arguments: []string{
+			"LISTEN_EXPIRED",
+		},
+		ArgumentsOptional: true,
+		FlagOptional:      true,
+	}
 }
 
 // GetHelperText returns a simple description of the command
@@ -101,6 +128,9 @@ func (t *TxPoolSubscribeCommand) Run(args []string) int {
 		enqueued bool
 		dropped  bool
 		demoted  bool
+		replaced bool
+		evicted  bool
+		expired  bool
 	)
 
 	flags.BoolVar(&added, "added", false, "")
@@ -108,6 +138,9 @@ func (t *TxPoolSubscribeCommand) Run(args []string) int {
 	flags.BoolVar(&enqueued, "enqueued", false, "")
 	flags.BoolVar(&dropped, "dropped", false, "")
 	flags.BoolVar(&demoted, "demoted", false, "")
+	flags.BoolVar(&replaced, "replaced", false, "")
+	flags.BoolVar(&evicted, "evicted", false, "")
+	flags.BoolVar(&expired, "expired", false, "")
 
 	if err := flags.Parse(args); err != nil {
 		t.Formatter.OutputError(err)
@@ -136,6 +169,18 @@ func (t *TxPoolSubscribeCommand) Run(args []string) int {
 		eventTypes = append(eventTypes, txpoolProto.EventType_DEMOTED)
 	}
 
+	if replaced {
+		eventTypes = append(eventTypes, txpoolProto.EventType_REPLACED)
+	}
+
+	if evicted {
+		eventTypes = append(eventTypes, txpoolProto.EventType_EVICTED)
+	}
+
+	if expired {
+		eventTypes = append(eventTypes, txpoolProto.EventType_EXPIRED)
+	}
+
 	if len(eventTypes) == 0 {
 		// Any kind of event subscription is default
 		eventTypes = append(
@@ -145,6 +190,9 @@ func (t *TxPoolSubscribeCommand) Run(args []string) int {
 			txpoolProto.EventType_ENQUEUED,
 			txpoolProto.EventType_DROPPED,
 			txpoolProto.EventType_DEMOTED,
+			txpoolProto.EventType_REPLACED,
+			txpoolProto.EventType_EVICTED,
+			txpoolProto.EventType_EXPIRED,
 		)
 	}
 
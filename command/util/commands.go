@@ -4,6 +4,8 @@ import (
 	"os"
 
 	"github.com/0xPolygon/polygon-edge/command/dev"
+	"github.com/0xPolygon/polygon-edge/command/devnet"
+	"github.com/0xPolygon/polygon-edge/command/dnstree"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/ibft"
@@ -49,11 +51,21 @@ func Commands() map[string]cli.CommandFactory {
 	peersAddCmd := peers.PeersAdd{Base: base, Formatter: formatter, GRPC: grpc}
 	peersListCmd := peers.PeersList{Base: base, Formatter: formatter, GRPC: grpc}
 	peersStatusCmd := peers.PeersStatus{Base: base, Formatter: formatter, GRPC: grpc}
+	peersBanCmd := peers.PeersBan{Base: base, Formatter: formatter, GRPC: grpc}
+	peersUnbanCmd := peers.PeersUnban{Base: base, Formatter: formatter, GRPC: grpc}
+	peersListBannedCmd := peers.PeersListBanned{Base: base, Formatter: formatter, GRPC: grpc}
+	peersAllowCmd := peers.PeersAllow{Base: base, Formatter: formatter, GRPC: grpc}
+	peersDisallowCmd := peers.PeersDisallow{Base: base, Formatter: formatter, GRPC: grpc}
+	peersListAllowedCmd := peers.PeersListAllowed{Base: base, Formatter: formatter, GRPC: grpc}
 
 	txPoolCmd := txpool.TxPoolCommand{}
 	txPoolAddCmd := txpool.TxPoolAdd{Base: base, Formatter: formatter, GRPC: grpc}
 	txPoolStatusCmd := txpool.TxPoolStatus{Base: base, Formatter: formatter, GRPC: grpc}
 	txPoolSubscribeCmd := txpool.TxPoolSubscribeCommand{Base: base, Formatter: formatter, GRPC: grpc}
+	txPoolReloadPolicyCmd := txpool.TxPoolReloadPolicy{Base: base, Formatter: formatter, GRPC: grpc}
+	txPoolListCmd := txpool.TxPoolList{Base: base, Formatter: formatter, GRPC: grpc}
+	txPoolGetCmd := txpool.TxPoolGet{Base: base, Formatter: formatter, GRPC: grpc}
+	txPoolDropCmd := txpool.TxPoolDrop{Base: base, Formatter: formatter, GRPC: grpc}
 
 	loadbotCmd := loadbot.LoadbotCommand{Base: base, Formatter: formatter}
 
@@ -61,6 +73,15 @@ func Commands() map[string]cli.CommandFactory {
 	secretsGenerateCmd := secrets.SecretsGenerate{Base: base}
 	secretsInitCmd := secrets.SecretsInit{Base: base, Formatter: formatter}
 
+	dnsTreeCmd := dnstree.DNSTreeCommand{}
+	dnsTreeBuildCmd := dnstree.DNSTreeBuild{Base: base, Formatter: formatter}
+
+	devnetCmd := devnet.DevnetCommand{}
+	devnetStartCmd := devnet.DevnetStart{Base: base, Formatter: formatter}
+	devnetStopCmd := devnet.DevnetStop{Base: base, Formatter: formatter}
+	devnetStatusCmd := devnet.DevnetStatus{Base: base, Formatter: formatter}
+	devnetResetCmd := devnet.DevnetReset{Base: base, Formatter: formatter}
+
 	return map[string]cli.CommandFactory{
 
 		// GENERIC COMMANDS //
@@ -89,6 +110,24 @@ func Commands() map[string]cli.CommandFactory {
 		peersListCmd.GetBaseCommand(): func() (cli.Command, error) {
 			return &peersListCmd, nil
 		},
+		peersBanCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersBanCmd, nil
+		},
+		peersUnbanCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersUnbanCmd, nil
+		},
+		peersListBannedCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersListBannedCmd, nil
+		},
+		peersAllowCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersAllowCmd, nil
+		},
+		peersDisallowCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersDisallowCmd, nil
+		},
+		peersListAllowedCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &peersListAllowedCmd, nil
+		},
 
 		// IBFT COMMANDS //
 
@@ -122,6 +161,18 @@ func Commands() map[string]cli.CommandFactory {
 		txPoolSubscribeCmd.GetBaseCommand(): func() (cli.Command, error) {
 			return &txPoolSubscribeCmd, nil
 		},
+		txPoolReloadPolicyCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &txPoolReloadPolicyCmd, nil
+		},
+		txPoolListCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &txPoolListCmd, nil
+		},
+		txPoolGetCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &txPoolGetCmd, nil
+		},
+		txPoolDropCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &txPoolDropCmd, nil
+		},
 
 		// BLOCKCHAIN COMMANDS //
 
@@ -146,6 +197,32 @@ func Commands() map[string]cli.CommandFactory {
 			return &secretsInitCmd, nil
 		},
 
+		// DNS TREE COMMANDS //
+		dnsTreeCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &dnsTreeCmd, nil
+		},
+		dnsTreeBuildCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &dnsTreeBuildCmd, nil
+		},
+
+		// DEVNET COMMANDS //
+
+		devnetCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &devnetCmd, nil
+		},
+		devnetStartCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &devnetStartCmd, nil
+		},
+		devnetStopCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &devnetStopCmd, nil
+		},
+		devnetStatusCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &devnetStatusCmd, nil
+		},
+		devnetResetCmd.GetBaseCommand(): func() (cli.Command, error) {
+			return &devnetResetCmd, nil
+		},
+
 		// LOADBOT COMMANDS //
 
 		loadbotCmd.GetBaseCommand(): func() (cli.Command, error) {
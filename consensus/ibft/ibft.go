@@ -17,7 +17,9 @@ import (
 	"github.com/0xPolygon/polygon-edge/protocol"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
 	any "google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -45,6 +47,8 @@ type txPoolInterface interface {
 	Drop(tx *types.Transaction)
 	Demote(tx *types.Transaction)
 	ResetWithHeaders(headers ...*types.Header)
+	Bundles(number uint64) []*txpool.Bundle
+	RemoveBundle(hash types.Hash)
 }
 
 type syncerInterface interface {
@@ -267,6 +271,11 @@ func Factory(
 
 	p.logger.Info("validator key", "addr", p.validatorKeyAddr.String())
 
+	// private transactions are forwarded to the peers proving to be validators
+	if params.Txpool != nil {
+		params.Txpool.SetValidatorIdentity(&validatorIdentity{ibft: p})
+	}
+
 	// start the transport protocol
 	if err := p.setupTransport(); err != nil {
 		return nil, err
@@ -296,6 +305,11 @@ func (i *Ibft) GetSyncProgression() *protocol.Progression {
 	return i.syncer.GetSyncProgression()
 }
 
+// Operator returns the operator service, used for managing the validator set
+func (i *Ibft) Operator() proto.IbftOperatorServer {
+	return i.operator
+}
+
 type transport interface {
 	Gossip(msg *proto.MessageReq) error
 }
@@ -321,7 +335,7 @@ func (i *Ibft) setupTransport() error {
 	}
 
 	// Subscribe to the newly created topic
-	err = topic.Subscribe(func(obj interface{}) {
+	err = topic.SubscribeWithSender(func(obj interface{}, from peer.ID) {
 		msg, ok := obj.(*proto.MessageReq)
 		if !ok {
 			i.logger.Error("invalid type assertion for message request")
@@ -338,6 +352,7 @@ func (i *Ibft) setupTransport() error {
 		// decode sender
 		if err := validateMsg(msg); err != nil {
 			i.logger.Error("failed to validate msg", "err", err)
+			i.network.ReportPeer(from, network.PenaltyInvalidMessage, err.Error())
 
 			return
 		}
@@ -610,7 +625,7 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 	// If the mechanism is PoA -> always build a regular block, regardless of epoch
 	txns := []*types.Transaction{}
 	if i.mechanism.ShouldWriteTransactions(header.Number) {
-		txns = i.writeTransactions(gasLimit, transition)
+		txns = i.writeTransactions(gasLimit, header.Number, transition)
 	}
 
 	_, root := transition.Commit()
@@ -643,12 +658,17 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 
 type transitionInterface interface {
 	Write(txn *types.Transaction) error
+	WriteBundle(txns []*types.Transaction) error
 }
 
-// writeTransactions writes transactions from the txpool to the transition object
-// and returns transactions that were included in the transition (new block)
-func (i *Ibft) writeTransactions(gasLimit uint64, transition transitionInterface) []*types.Transaction {
-	var successful []*types.Transaction
+// writeTransactions writes the bundles and then the transactions from the txpool to the
+// transition object and returns transactions that were included in the transition (new block)
+func (i *Ibft) writeTransactions(
+	gasLimit uint64,
+	blockNumber uint64,
+	transition transitionInterface,
+) []*types.Transaction {
+	successful := i.writeBundles(blockNumber, transition)
 
 	i.txpool.Prepare()
 
@@ -687,6 +707,36 @@ func (i *Ibft) writeTransactions(gasLimit uint64, transition transitionInterface
 	return successful
 }
 
+// writeBundles writes the bundles targeting the given block to the transition object.
+// Each bundle is included atomically, or dropped if any of its transactions fails
+func (i *Ibft) writeBundles(blockNumber uint64, transition transitionInterface) []*types.Transaction {
+	var successful []*types.Transaction
+
+	for _, bundle := range i.txpool.Bundles(blockNumber) {
+		if err := transition.WriteBundle(bundle.Txs); err != nil {
+			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { // nolint:errorlint
+				// the bundle may fit in the next blocks of its range
+				continue
+			}
+
+			i.logger.Debug("dropping bundle", "hash", bundle.Hash, "err", err)
+			i.txpool.RemoveBundle(bundle.Hash)
+
+			continue
+		}
+
+		i.txpool.RemoveBundle(bundle.Hash)
+
+		successful = append(successful, bundle.Txs...)
+	}
+
+	if len(successful) > 0 {
+		i.logger.Info("picked out bundle txns", "num", len(successful))
+	}
+
+	return successful
+}
+
 // runAcceptState runs the Accept state loop
 //
 // The Accept state always checks the snapshot, and the validator set. If the current node is not in the validators set,
@@ -830,13 +880,18 @@ func (i *Ibft) runAcceptState() { // start new round
 				continue
 			}
 
+			// any failure of the hook rejects the block
 			if hookErr := i.runHook(VerifyBlockHook, block); hookErr != nil && !errors.Is(hookErr, ErrMissingHook) {
-				if errors.As(hookErr, &errBlockVerificationFailed) {
-					i.logger.Error("block verification failed, block at the end of epoch has transactions")
-					i.handleStateErr(errBlockVerificationFailed)
-				} else {
-					i.logger.Error(fmt.Sprintf("Unable to run hook %s, %v", VerifyBlockHook, hookErr))
-				}
+				i.logger.Error(fmt.Sprintf("block verification failed, hook %s: %v", VerifyBlockHook, hookErr))
+				i.handleStateErr(errBlockVerificationFailed)
+
+				continue
+			}
+
+			// reject blocks of proposers not enforcing the admission policy
+			if err := i.verifyAdmission(parent, block); err != nil {
+				i.logger.Error("block verification failed", "err", err)
+				i.handleStateErr(errBlockVerificationFailed)
 
 				continue
 			}
@@ -1212,6 +1267,16 @@ func (i *Ibft) verifyHeaderImpl(snap *Snapshot, parent, header *types.Header) er
 	return nil
 }
 
+// verifyAdmission checks the transactions of the proposed block
+// against the admission policy of the chain (if any)
+func (i *Ibft) verifyAdmission(parent *types.Header, block *types.Block) error {
+	if i.executor == nil {
+		return nil
+	}
+
+	return i.executor.CheckAdmission(parent, block)
+}
+
 // VerifyHeader wrapper for verifying headers
 func (i *Ibft) VerifyHeader(parent, header *types.Header) error {
 	snap, err := i.getSnapshot(parent.Number)
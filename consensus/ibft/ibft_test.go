@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/protocol"
 	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -214,6 +215,42 @@ func TestTransition_AcceptState_Validator_VerifyFails(t *testing.T) {
 	})
 }
 
+func TestTransition_AcceptState_Validator_VerifyHookFails(t *testing.T) {
+	i := newMockIbft(t, []string{"A", "B", "C"}, "B")
+	i.state.view = proto.ViewMsg(1, 0)
+	i.setState(AcceptState)
+
+	// any error of the hook triggers a round change
+	i.mechanism.GetHookMap()[VerifyBlockHook] = func(interface{}) error {
+		return errors.New("hook failure")
+	}
+
+	block := i.DummyBlock()
+	header, err := writeSeal(i.pool.get("A").priv, block.Header)
+
+	assert.NoError(t, err)
+
+	block.Header = header
+
+	// A sends the message
+	i.emitMsg(&proto.MessageReq{
+		From: "A",
+		Type: proto.MessageReq_Preprepare,
+		Proposal: &any.Any{
+			Value: block.MarshalRLP(),
+		},
+		View: proto.ViewMsg(1, 0),
+	})
+
+	i.runCycle()
+
+	i.expect(expectResult{
+		sequence: 1,
+		state:    RoundChangeState,
+		err:      errBlockVerificationFailed,
+	})
+}
+
 func TestTransition_AcceptState_Validator_ProposerInvalid(t *testing.T) {
 	i := newMockIbft(t, []string{"A", "B", "C"}, "B")
 	i.state.view = proto.ViewMsg(1, 0)
@@ -568,7 +605,7 @@ func TestWriteTransactions(t *testing.T) {
 			m.txpool = mockTxPool
 			mockTransition := setupMockTransition(test, mockTxPool)
 
-			included := m.writeTransactions(1000, mockTransition)
+			included := m.writeTransactions(1000, 1, mockTransition)
 
 			assert.Equal(t, uint64(test.expectedTxPoolLength), m.txpool.Length())
 			assert.Equal(t, test.expectedIncludedTxnsCount, len(included))
@@ -582,6 +619,40 @@ func TestWriteTransactions(t *testing.T) {
 	}
 }
 
+func TestWriteTransactions_Bundles(t *testing.T) {
+	var (
+		included       = []*types.Transaction{{Nonce: 1}, {Nonce: 2}}
+		failed         = []*types.Transaction{{Nonce: 3}, {Nonce: 4}}
+		gasLimited     = []*types.Transaction{{Nonce: 5}}
+		notTargeted    = []*types.Transaction{{Nonce: 6}}
+		poolTxn        = &types.Transaction{Nonce: 7}
+		bundleIncl     = &txpool.Bundle{Hash: types.StringToHash("1"), Txs: included, MinBlockNumber: 1, MaxBlockNumber: 1}
+		bundleFail     = &txpool.Bundle{Hash: types.StringToHash("2"), Txs: failed, MinBlockNumber: 1, MaxBlockNumber: 2}
+		bundleGas      = &txpool.Bundle{Hash: types.StringToHash("3"), Txs: gasLimited, MinBlockNumber: 1, MaxBlockNumber: 2}
+		bundleNotYet   = &txpool.Bundle{Hash: types.StringToHash("4"), Txs: notTargeted, MinBlockNumber: 2, MaxBlockNumber: 2}
+		mockTxPool     = &mockTxPool{}
+		mockTransition = &mockTransition{
+			unrecoverableTransactions:  []*types.Transaction{failed[1]},
+			gasLimitReachedTransaction: gasLimited[0],
+		}
+	)
+
+	m := newMockIbft(t, []string{"A", "B", "C"}, "A")
+	mockTxPool.transactions = []*types.Transaction{poolTxn}
+	mockTxPool.bundles = []*txpool.Bundle{bundleIncl, bundleFail, bundleGas, bundleNotYet}
+	m.txpool = mockTxPool
+
+	written := m.writeTransactions(1000, 1, mockTransition)
+
+	// the bundles are written atomically, before the pool txs
+	assert.Equal(t, []*types.Transaction{included[0], included[1], poolTxn}, written)
+	assert.Equal(t, written, mockTransition.transactionsWritten)
+
+	// the included and failed bundles are removed,
+	// the others may be included in the next block
+	assert.Equal(t, []*txpool.Bundle{bundleGas, bundleNotYet}, mockTxPool.bundles)
+}
+
 func TestRunSyncState_NewHeadReceivedFromPeer_CallsTxPoolResetWithHeaders(t *testing.T) {
 	m := newMockIbft(t, []string{"A", "B", "C"}, "A")
 	m.setState(SyncState)
@@ -683,6 +754,7 @@ type mockTxPool struct {
 	nonceDecreased        map[*types.Transaction]bool
 	resetWithHeaderCalled bool
 	resetWithHeadersParam []*types.Header
+	bundles               []*txpool.Bundle
 }
 
 func (p *mockTxPool) Prepare() {
@@ -728,6 +800,28 @@ func (p *mockTxPool) ResetWithHeaders(headers ...*types.Header) {
 	p.resetWithHeadersParam = headers
 }
 
+func (p *mockTxPool) Bundles(number uint64) []*txpool.Bundle {
+	var ready []*txpool.Bundle
+
+	for _, b := range p.bundles {
+		if b.MinBlockNumber <= number && number <= b.MaxBlockNumber {
+			ready = append(ready, b)
+		}
+	}
+
+	return ready
+}
+
+func (p *mockTxPool) RemoveBundle(hash types.Hash) {
+	for i, b := range p.bundles {
+		if b.Hash == hash {
+			p.bundles = append(p.bundles[:i], p.bundles[i+1:]...)
+
+			return
+		}
+	}
+}
+
 type mockTransition struct {
 	transactionsWritten        []*types.Transaction
 	recoverableTransactions    []*types.Transaction
@@ -757,6 +851,20 @@ func (t *mockTransition) Write(txn *types.Transaction) error {
 	return nil
 }
 
+func (t *mockTransition) WriteBundle(txns []*types.Transaction) error {
+	written := t.transactionsWritten
+
+	for _, txn := range txns {
+		if err := t.Write(txn); err != nil {
+			t.transactionsWritten = written
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 type mockIbft struct {
 	t *testing.T
 	*Ibft
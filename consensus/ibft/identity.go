@@ -0,0 +1,37 @@
+package ibft
+
+import (
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// validatorIdentity identifies the node among the validators,
+// so that the txpool forwards private transactions to the validators only
+type validatorIdentity struct {
+	ibft *Ibft
+}
+
+// Address returns the validator address of the node
+func (v *validatorIdentity) Address() types.Address {
+	return v.ibft.validatorKeyAddr
+}
+
+// Validators returns the validators of the next block
+func (v *validatorIdentity) Validators() []types.Address {
+	if v.ibft.store == nil {
+		// the snapshots are not set up yet
+		return nil
+	}
+
+	snap, err := v.ibft.getSnapshot(v.ibft.blockchain.Header().Number)
+	if err != nil || snap == nil {
+		return nil
+	}
+
+	return snap.Set
+}
+
+// Sign signs the given hash with the validator key
+func (v *validatorIdentity) Sign(hash []byte) ([]byte, error) {
+	return crypto.Sign(v.ibft.validatorKey, hash)
+}
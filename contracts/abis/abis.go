@@ -6,3 +6,6 @@ import (
 
 var StakingABI = abi.MustNewABI(StakingJSONABI)
 var StressTestABI = abi.MustNewABI(StressTestJSONABI)
+var AdmissionRegistryABI = abi.MustNewABI(AdmissionRegistryJSONABI)
+var SponsorRegistryABI = abi.MustNewABI(SponsorRegistryJSONABI)
+var NodeRegistryABI = abi.MustNewABI(NodeRegistryJSONABI)
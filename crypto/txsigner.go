@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -27,13 +28,16 @@ type TxSigner interface {
 	CalculateV(parity byte) []byte
 }
 
-// NewSigner creates a new signer object (EIP155 or FrontierSigner)
+// NewSigner creates a new signer object (FeeDelegationSigner, EIP155 or FrontierSigner)
 func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
 	var signer TxSigner
 
-	if forks.EIP155 {
+	switch {
+	case forks.EIP155 && forks.FeeDelegation:
+		signer = NewFeeDelegationSigner(chainID)
+	case forks.EIP155:
 		signer = &EIP155Signer{chainID: chainID}
-	} else {
+	default:
 		signer = &FrontierSigner{}
 	}
 
@@ -173,18 +177,28 @@ func (e *EIP155Signer) Sender(tx *types.Transaction) (types.Address, error) {
 		return (&FrontierSigner{}).Sender(tx)
 	}
 
+	return e.recover(e.Hash(tx), tx.V, tx.R, tx.S)
+}
+
+// recover returns the address which signed the given hash with an EIP155 signature
+func (e *EIP155Signer) recover(hash types.Hash, v, r, s *big.Int) (types.Address, error) {
 	// Reverse the V calculation to find the original V in the range [0, 1]
 	// v = CHAIN_ID * 2 + 35 + {0, 1}
+	bigV := big.NewInt(0)
+	if v != nil {
+		bigV.SetBytes(v.Bytes())
+	}
+
 	mulOperand := big.NewInt(0).Mul(big.NewInt(int64(e.chainID)), big.NewInt(2))
 	bigV.Sub(bigV, mulOperand)
 	bigV.Sub(bigV, big35)
 
-	sig, err := encodeSignature(tx.R, tx.S, byte(bigV.Int64()))
+	sig, err := encodeSignature(r, s, byte(bigV.Int64()))
 	if err != nil {
 		return types.Address{}, err
 	}
 
-	pub, err := Ecrecover(e.Hash(tx).Bytes(), sig)
+	pub, err := Ecrecover(hash.Bytes(), sig)
 	if err != nil {
 		return types.Address{}, err
 	}
@@ -227,6 +241,140 @@ func (e *EIP155Signer) CalculateV(parity byte) []byte {
 	return reference.Bytes()
 }
 
+// NewFeeDelegationSigner returns a new FeeDelegationSigner object
+func NewFeeDelegationSigner(chainID uint64) *FeeDelegationSigner {
+	return &FeeDelegationSigner{
+		EIP155Signer: EIP155Signer{chainID: chainID},
+	}
+}
+
+// FeeDelegationSigner is the EIP155Signer extended with fee delegated transactions.
+// The sender signs the transaction including the address of the fee payer,
+// then the fee payer signs it again including the signature of the sender.
+type FeeDelegationSigner struct {
+	EIP155Signer
+}
+
+// Hash returns the hash signed by the sender of the transaction
+func (f *FeeDelegationSigner) Hash(tx *types.Transaction) types.Hash {
+	if !tx.IsFeeDelegated() {
+		return f.EIP155Signer.Hash(tx)
+	}
+
+	return calcFeeDelegatedTxHash(tx, f.chainID, false)
+}
+
+// FeePayerHash returns the hash signed by the fee payer of the transaction
+func (f *FeeDelegationSigner) FeePayerHash(tx *types.Transaction) types.Hash {
+	return calcFeeDelegatedTxHash(tx, f.chainID, true)
+}
+
+// Sender returns the transaction sender
+func (f *FeeDelegationSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if !tx.IsFeeDelegated() {
+		return f.EIP155Signer.Sender(tx)
+	}
+
+	return f.recover(f.Hash(tx), tx.V, tx.R, tx.S)
+}
+
+// FeePayer returns the account which signed the transaction as the fee payer
+func (f *FeeDelegationSigner) FeePayer(tx *types.Transaction) (types.Address, error) {
+	if !tx.IsFeeDelegated() {
+		return types.Address{}, ErrNotFeeDelegated
+	}
+
+	return f.recover(f.FeePayerHash(tx), tx.FeePayerV, tx.FeePayerR, tx.FeePayerS)
+}
+
+// SignTx signs the transaction as the sender using the passed in private key
+func (f *FeeDelegationSigner) SignTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	tx = tx.Copy()
+
+	h := f.Hash(tx)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes(f.CalculateV(sig[64]))
+
+	return tx, nil
+}
+
+// SignFeePayer signs the transaction as the fee payer using the passed in private key.
+// The transaction needs to be already signed by the sender
+func (f *FeeDelegationSigner) SignFeePayer(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if !tx.IsFeeDelegated() {
+		return nil, ErrNotFeeDelegated
+	}
+
+	tx = tx.Copy()
+
+	h := f.FeePayerHash(tx)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.FeePayerR = new(big.Int).SetBytes(sig[:32])
+	tx.FeePayerS = new(big.Int).SetBytes(sig[32:64])
+	tx.FeePayerV = new(big.Int).SetBytes(f.CalculateV(sig[64]))
+
+	return tx, nil
+}
+
+// ErrNotFeeDelegated is returned when fee payer operations are applied to a regular transaction
+var ErrNotFeeDelegated = errors.New("transaction is not fee delegated")
+
+// calcFeeDelegatedTxHash calculates the hash signed by the sender or, if forFeePayer
+// is set, by the fee payer of a fee delegated transaction
+func calcFeeDelegatedTxHash(tx *types.Transaction, chainID uint64, forFeePayer bool) types.Hash {
+	a := signerPool.Get()
+
+	v := a.NewArray()
+	v.Set(a.NewUint(tx.Nonce))
+	v.Set(a.NewBigInt(tx.GasPrice))
+	v.Set(a.NewUint(tx.Gas))
+
+	if tx.To == nil {
+		v.Set(a.NewNull())
+	} else {
+		v.Set(a.NewCopyBytes((*tx.To).Bytes()))
+	}
+
+	v.Set(a.NewBigInt(tx.Value))
+	v.Set(a.NewCopyBytes(tx.Input))
+	v.Set(a.NewCopyBytes((*tx.FeePayer).Bytes()))
+
+	// the fee payer signs the signature of the sender
+	if forFeePayer {
+		v.Set(a.NewBigInt(tx.V))
+		v.Set(a.NewBigInt(tx.R))
+		v.Set(a.NewBigInt(tx.S))
+	}
+
+	v.Set(a.NewUint(chainID))
+	v.Set(a.NewUint(0))
+	v.Set(a.NewUint(0))
+
+	hash := keccak.Keccak256Rlp(nil, v)
+
+	signerPool.Put(a)
+
+	return types.BytesToHash(hash)
+}
+
 // encodeSignature generates a signature value based on the R, S and V value
 func encodeSignature(R, S *big.Int, V byte) ([]byte, error) {
 	if !ValidateSignatureValues(V, R, S) {
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -138,3 +139,87 @@ func TestEIP155Signer_ChainIDMismatch(t *testing.T) {
 		}
 	}
 }
+
+func TestFeeDelegationSigner(t *testing.T) {
+	toAddress := types.StringToAddress("1")
+
+	senderKey, err := GenerateKey()
+	assert.NoError(t, err)
+
+	feePayerKey, err := GenerateKey()
+	assert.NoError(t, err)
+
+	sender := PubKeyToAddress(&senderKey.PublicKey)
+	feePayer := PubKeyToAddress(&feePayerKey.PublicKey)
+
+	signer := NewFeeDelegationSigner(100)
+
+	// regular transactions are signed like with the EIP155 signer
+	regularTx, err := signer.SignTx(&types.Transaction{
+		To:       &toAddress,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(0),
+	}, senderKey)
+	assert.NoError(t, err)
+
+	from, err := NewEIP155Signer(100).Sender(regularTx)
+	assert.NoError(t, err)
+	assert.Equal(t, sender, from)
+
+	_, err = signer.FeePayer(regularTx)
+	assert.ErrorIs(t, err, ErrNotFeeDelegated)
+
+	// the sender signs first, then the fee payer
+	signedTx, err := signer.SignTx(&types.Transaction{
+		To:       &toAddress,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(1),
+		FeePayer: &feePayer,
+	}, senderKey)
+	assert.NoError(t, err)
+
+	signedTx, err = signer.SignFeePayer(signedTx, feePayerKey)
+	assert.NoError(t, err)
+
+	from, err = signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, sender, from)
+
+	payer, err := signer.FeePayer(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, feePayer, payer)
+
+	// the fee payer signature covers the sender signature
+	tamperedTx := signedTx.Copy()
+	tamperedTx.R = new(big.Int).Add(tamperedTx.R, big.NewInt(1))
+
+	payer, err = signer.FeePayer(tamperedTx)
+	if err == nil {
+		assert.NotEqual(t, feePayer, payer)
+	}
+
+	// the sender signature covers the fee payer address
+	otherTx := signedTx.Copy()
+	otherTx.FeePayer = &toAddress
+
+	from, err = signer.Sender(otherTx)
+	if err == nil {
+		assert.NotEqual(t, sender, from)
+	}
+}
+
+func TestNewSigner_FeeDelegationFork(t *testing.T) {
+	forks := chain.AllForksEnabled.At(0)
+
+	// the EIP155 signer is kept until the fee delegation fork
+	assert.IsType(t, &EIP155Signer{}, NewSigner(forks, 100))
+
+	forks.FeeDelegation = true
+
+	assert.IsType(t, &FeeDelegationSigner{}, NewSigner(forks, 100))
+
+	// fee delegation relies on the EIP155 signature
+	forks.EIP155 = false
+
+	assert.IsType(t, &FrontierSigner{}, NewSigner(forks, 100))
+}
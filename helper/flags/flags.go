@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
 	"github.com/multiformats/go-multiaddr"
 )
 
@@ -30,6 +31,17 @@ type BootnodeFlags struct {
 	Addrs  []string
 }
 
+// HasDNSTree returns true if a bootnode is the URL of a DNS tree, listing any number of nodes
+func (i *BootnodeFlags) HasDNSTree() bool {
+	for _, addr := range i.Addrs {
+		if dnsdisc.IsURL(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (i *BootnodeFlags) String() string {
 	return formatArrayForOutput(i.Addrs)
 }
@@ -37,6 +49,16 @@ func (i *BootnodeFlags) String() string {
 func (i *BootnodeFlags) Set(value string) error {
 	i.AreSet = true
 
+	if dnsdisc.IsURL(value) {
+		if err := dnsdisc.ValidateURL(value); err != nil {
+			return err
+		}
+
+		i.Addrs = append(i.Addrs, value)
+
+		return nil
+	}
+
 	if _, err := multiaddr.NewMultiaddr(value); err != nil {
 		return err
 	}
@@ -0,0 +1,161 @@
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCert is a certificate and its key written to PEM files
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+// newTestCert creates a certificate signed by the parent, self-signed if nil
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	c := &testCert{
+		cert:     cert,
+		key:      key,
+		certFile: filepath.Join(dir, name+".crt"),
+		keyFile:  filepath.Join(dir, name+".key"),
+	}
+
+	assert.NoError(t, ioutil.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, ioutil.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return c
+}
+
+// handshake connects the client to a server, returning the handshake error of the server
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) error {
+	t.Helper()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	assert.NoError(t, err)
+
+	defer lis.Close()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			errCh <- err
+
+			return
+		}
+
+		defer conn.Close()
+
+		errCh <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err == nil {
+		// the client certificate is verified once the client handshake is done
+		_, _ = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+
+	return <-errCh
+}
+
+func TestTLS(t *testing.T) {
+	ca := newTestCert(t, "ca", nil)
+	server := newTestCert(t, "server", ca)
+	client := newTestCert(t, "client", ca)
+	other := newTestCert(t, "other", newTestCert(t, "other-ca", nil))
+
+	t.Run("server certificate", func(t *testing.T) {
+		serverConfig, err := ServerConfig(server.certFile, server.keyFile, "")
+		assert.NoError(t, err)
+
+		clientConfig, err := ClientConfig(ca.certFile, "", "")
+		assert.NoError(t, err)
+
+		assert.NoError(t, handshake(t, serverConfig, clientConfig))
+
+		// the server is not trusted without the CA
+		clientConfig, err = ClientConfig(other.certFile, "", "")
+		assert.NoError(t, err)
+
+		assert.Error(t, handshake(t, serverConfig, clientConfig))
+	})
+
+	t.Run("mutual TLS", func(t *testing.T) {
+		serverConfig, err := ServerConfig(server.certFile, server.keyFile, ca.certFile)
+		assert.NoError(t, err)
+
+		clientConfig, err := ClientConfig(ca.certFile, client.certFile, client.keyFile)
+		assert.NoError(t, err)
+
+		assert.NoError(t, handshake(t, serverConfig, clientConfig))
+
+		// the client must present a certificate signed by the client CA
+		clientConfig, err = ClientConfig(ca.certFile, "", "")
+		assert.NoError(t, err)
+
+		assert.Error(t, handshake(t, serverConfig, clientConfig))
+
+		clientConfig, err = ClientConfig(ca.certFile, other.certFile, other.keyFile)
+		assert.NoError(t, err)
+
+		assert.Error(t, handshake(t, serverConfig, clientConfig))
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := ServerConfig(server.certFile, "", "")
+		assert.ErrorIs(t, err, ErrMissingKeyPair)
+
+		_, err = ClientConfig(server.keyFile, "", "")
+		assert.ErrorIs(t, err, ErrInvalidCA)
+	})
+}
@@ -0,0 +1,142 @@
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/version"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Admin is the admin jsonrpc endpoint, used for managing the node.
+// It wraps the gRPC System service
+type Admin struct {
+	d *Dispatcher
+}
+
+type nodeInfo struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	P2PAddr string        `json:"p2pAddr"`
+	Network argUint64     `json:"network"`
+	Current nodeInfoBlock `json:"current"`
+}
+
+type nodeInfoBlock struct {
+	Number argUint64  `json:"number"`
+	Hash   types.Hash `json:"hash"`
+}
+
+type peerInfo struct {
+	ID        string   `json:"id"`
+	Protocols []string `json:"protocols"`
+	Addrs     []string `json:"addrs"`
+}
+
+// system returns the System service, once the call to the given method is authorized
+func (a *Admin) system(method string) (proto.SystemServer, error) {
+	if err := a.d.store.Authorize(method); err != nil {
+		return nil, err
+	}
+
+	return a.d.store.SystemService(), nil
+}
+
+// NodeInfo returns the information about the running node
+func (a *Admin) NodeInfo() (interface{}, error) {
+	system, err := a.system("/v1.System/GetStatus")
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := system.GetStatus(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	addrInfo, err := network.StringToAddrInfo(status.P2PAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodeInfo{
+		ID:      addrInfo.ID.String(),
+		Name:    fmt.Sprintf("polygon-edge [%s]", version.GetVersionJsonrpc()),
+		P2PAddr: status.P2PAddr,
+		Network: argUint64(status.Network),
+		Current: nodeInfoBlock{
+			Number: argUint64(status.Current.Number),
+			Hash:   types.StringToHash(status.Current.Hash),
+		},
+	}, nil
+}
+
+// Peers returns the peers the node is connected to
+func (a *Admin) Peers() (interface{}, error) {
+	system, err := a.system("/v1.System/PeersList")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := system.PeersList(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*peerInfo, 0, len(resp.Peers))
+	for _, p := range resp.Peers {
+		peers = append(peers, &peerInfo{
+			ID:        p.Id,
+			Protocols: p.Protocols,
+			Addrs:     p.Addrs,
+		})
+	}
+
+	return peers, nil
+}
+
+// AddPeer requests the node to connect to the peer with the given libp2p address.
+// If blocked is set, the call waits until the connection is established
+func (a *Admin) AddPeer(addr string, blocked *bool) (interface{}, error) {
+	req := &proto.PeersAddRequest{
+		Id:      addr,
+		Blocked: blocked != nil && *blocked,
+	}
+
+	system, err := a.system("/v1.System/PeersAdd")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := system.PeersAdd(context.Background(), req); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// RemovePeer disconnects the node from the peer with the given ID
+func (a *Admin) RemovePeer(id string) (interface{}, error) {
+	req := &proto.PeersRemoveRequest{
+		Id: id,
+	}
+
+	system, err := a.system("/v1.System/PeersRemove")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := system.PeersRemove(context.Background(), req); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// Datadir returns the data directory of the node
+func (a *Admin) Datadir() (interface{}, error) {
+	return a.d.store.DataDir(), nil
+}
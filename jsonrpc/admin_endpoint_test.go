@@ -0,0 +1,295 @@
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const testPeerID = "16Uiu2HAmJxxH1tScDX2rLGSU9exnuvZKNM9SoK3v315azp68DLPW"
+
+type mockSystemService struct {
+	proto.UnimplementedSystemServer
+
+	added   []*proto.PeersAddRequest
+	removed []string
+}
+
+func (m *mockSystemService) GetStatus(context.Context, *empty.Empty) (*proto.ServerStatus, error) {
+	return &proto.ServerStatus{
+		Network: 100,
+		Current: &proto.ServerStatus_Block{
+			Number: 10,
+			Hash:   hash1.String(),
+		},
+		P2PAddr: "/ip4/127.0.0.1/tcp/1478/p2p/" + testPeerID,
+	}, nil
+}
+
+func (m *mockSystemService) PeersList(context.Context, *empty.Empty) (*proto.PeersListResponse, error) {
+	return &proto.PeersListResponse{
+		Peers: []*proto.Peer{
+			{
+				Id:        testPeerID,
+				Protocols: []string{"/blocks/0.1"},
+				Addrs:     []string{"/ip4/127.0.0.1/tcp/1478"},
+			},
+		},
+	}, nil
+}
+
+func (m *mockSystemService) PeersAdd(_ context.Context, req *proto.PeersAddRequest) (*empty.Empty, error) {
+	m.added = append(m.added, req)
+
+	return &empty.Empty{}, nil
+}
+
+func (m *mockSystemService) PeersRemove(_ context.Context, req *proto.PeersRemoveRequest) (*empty.Empty, error) {
+	m.removed = append(m.removed, req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+type mockIbftOperator struct {
+	ibftOp.UnimplementedIbftOperatorServer
+
+	snapshotReq *ibftOp.SnapshotReq
+	proposed    []*ibftOp.Candidate
+}
+
+func (m *mockIbftOperator) GetSnapshot(_ context.Context, req *ibftOp.SnapshotReq) (*ibftOp.Snapshot, error) {
+	m.snapshotReq = req
+
+	return &ibftOp.Snapshot{
+		Number:     5,
+		Hash:       hash1.String(),
+		Validators: []*ibftOp.Snapshot_Validator{{Address: addr0.String()}},
+		Votes: []*ibftOp.Snapshot_Vote{
+			{Validator: addr0.String(), Proposed: addr1.String(), Auth: true},
+		},
+	}, nil
+}
+
+func (m *mockIbftOperator) Propose(_ context.Context, req *ibftOp.Candidate) (*empty.Empty, error) {
+	m.proposed = append(m.proposed, req)
+
+	return &empty.Empty{}, nil
+}
+
+func (m *mockIbftOperator) Candidates(context.Context, *empty.Empty) (*ibftOp.CandidatesResp, error) {
+	return &ibftOp.CandidatesResp{Candidates: m.proposed}, nil
+}
+
+func (m *mockIbftOperator) Status(context.Context, *empty.Empty) (*ibftOp.IbftStatusResp, error) {
+	return &ibftOp.IbftStatusResp{Key: addr0.String()}, nil
+}
+
+type mockAdminStore struct {
+	mockStoreTxn
+
+	system   *mockSystemService
+	operator *mockIbftOperator
+
+	// denied are the operator service methods the callers are not authorized to
+	denied map[string]bool
+}
+
+func newMockAdminStore() *mockAdminStore {
+	return &mockAdminStore{
+		system:   &mockSystemService{},
+		operator: &mockIbftOperator{},
+	}
+}
+
+func (m *mockAdminStore) SystemService() proto.SystemServer {
+	return m.system
+}
+
+func (m *mockAdminStore) IbftOperator() ibftOp.IbftOperatorServer {
+	return m.operator
+}
+
+func (m *mockAdminStore) Authorize(method string) error {
+	if m.denied[method] {
+		return fmt.Errorf("%s is not allowed", method)
+	}
+
+	return nil
+}
+
+func (m *mockAdminStore) DataDir() string {
+	return "/data"
+}
+
+func TestAdmin_Namespaces(t *testing.T) {
+	buildDispatcher := func(store blockchainInterface, params *dispatcherParams) *Dispatcher {
+		d := &Dispatcher{
+			logger: hclog.NewNullLogger(),
+			store:  store,
+			params: params,
+		}
+
+		d.registerEndpoints()
+
+		return d
+	}
+
+	hasMethod := func(d *Dispatcher, method string) bool {
+		_, _, err := d.getFnHandler(Request{Method: method})
+
+		return err == nil
+	}
+
+	// disabled by default
+	d := buildDispatcher(newMockAdminStore(), &dispatcherParams{})
+	assert.False(t, hasMethod(d, "admin_peers"))
+	assert.False(t, hasMethod(d, "ibft_status"))
+
+	d = buildDispatcher(newMockAdminStore(), &dispatcherParams{enableAdmin: true})
+	assert.True(t, hasMethod(d, "admin_peers"))
+	assert.True(t, hasMethod(d, "ibft_status"))
+
+	// the ibft namespace requires the IBFT consensus
+	d = buildDispatcher(&mockStoreTxn{}, &dispatcherParams{enableAdmin: true})
+	assert.True(t, hasMethod(d, "admin_peers"))
+	assert.False(t, hasMethod(d, "ibft_status"))
+}
+
+func TestAdmin_LoopbackListener(t *testing.T) {
+	assert.True(t, isLoopbackAddr(&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8545}))
+	assert.True(t, isLoopbackAddr(&net.TCPAddr{IP: net.ParseIP("::1"), Port: 8545}))
+	assert.False(t, isLoopbackAddr(&net.TCPAddr{IP: net.ParseIP("0.0.0.0"), Port: 8545}))
+	assert.False(t, isLoopbackAddr(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 8545}))
+	assert.False(t, isLoopbackAddr(&net.TCPAddr{Port: 8545}))
+}
+
+func TestAdmin_NodeInfo(t *testing.T) {
+	store := newMockAdminStore()
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Admin.NodeInfo()
+	assert.NoError(t, err)
+
+	info, ok := res.(*nodeInfo)
+	assert.True(t, ok)
+	assert.Equal(t, testPeerID, info.ID)
+	assert.Equal(t, argUint64(100), info.Network)
+	assert.Equal(t, argUint64(10), info.Current.Number)
+	assert.Equal(t, hash1, info.Current.Hash)
+
+	res, err = dispatcher.endpoints.Admin.Datadir()
+	assert.NoError(t, err)
+	assert.Equal(t, "/data", res)
+}
+
+func TestAdmin_Peers(t *testing.T) {
+	store := newMockAdminStore()
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Admin.Peers()
+	assert.NoError(t, err)
+
+	peers, ok := res.([]*peerInfo)
+	assert.True(t, ok)
+	assert.Len(t, peers, 1)
+	assert.Equal(t, testPeerID, peers[0].ID)
+
+	blocked := true
+	addr := "/ip4/127.0.0.1/tcp/1478/p2p/" + testPeerID
+
+	_, err = dispatcher.endpoints.Admin.AddPeer(addr, &blocked)
+	assert.NoError(t, err)
+
+	_, err = dispatcher.endpoints.Admin.AddPeer(addr, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*proto.PeersAddRequest{
+		{Id: addr, Blocked: true},
+		{Id: addr, Blocked: false},
+	}, store.system.added)
+
+	_, err = dispatcher.endpoints.Admin.RemovePeer(testPeerID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testPeerID}, store.system.removed)
+}
+
+func TestIbft_Snapshot(t *testing.T) {
+	store := newMockAdminStore()
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Ibft.GetSnapshot(nil)
+	assert.NoError(t, err)
+	assert.True(t, store.operator.snapshotReq.Latest)
+
+	snap, ok := res.(*ibftSnapshot)
+	assert.True(t, ok)
+	assert.Equal(t, argUint64(5), snap.Number)
+	assert.Equal(t, []types.Address{addr0}, snap.Validators)
+	assert.Equal(t, []*ibftSnapshotVote{{Validator: addr0, Proposed: addr1, Auth: true}}, snap.Votes)
+
+	number := BlockNumber(3)
+
+	_, err = dispatcher.endpoints.Ibft.GetSnapshot(&number)
+	assert.NoError(t, err)
+	assert.False(t, store.operator.snapshotReq.Latest)
+	assert.Equal(t, uint64(3), store.operator.snapshotReq.Number)
+
+	number = PendingBlockNumber
+
+	_, err = dispatcher.endpoints.Ibft.GetSnapshot(&number)
+	assert.Error(t, err)
+}
+
+func TestIbft_ProposeCandidates(t *testing.T) {
+	store := newMockAdminStore()
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	_, err := dispatcher.endpoints.Ibft.Propose(addr1, true)
+	assert.NoError(t, err)
+
+	res, err := dispatcher.endpoints.Ibft.Candidates()
+	assert.NoError(t, err)
+	assert.Equal(t, []*ibftCandidate{{Address: addr1, Auth: true}}, res)
+
+	res, err = dispatcher.endpoints.Ibft.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, &ibftStatus{ValidatorKey: addr0}, res)
+}
+
+func TestAdmin_Authorization(t *testing.T) {
+	store := newMockAdminStore()
+	store.denied = map[string]bool{
+		"/v1.System/PeersRemove":      true,
+		"/v1.IbftOperator/Propose":    true,
+		"/v1.IbftOperator/Candidates": true,
+	}
+
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	// the calls allowed by the policy are served
+	_, err := dispatcher.endpoints.Admin.Peers()
+	assert.NoError(t, err)
+
+	_, err = dispatcher.endpoints.Ibft.Status()
+	assert.NoError(t, err)
+
+	// the other ones never reach the operator services
+	_, err = dispatcher.endpoints.Admin.RemovePeer(testPeerID)
+	assert.Error(t, err)
+	assert.Empty(t, store.system.removed)
+
+	_, err = dispatcher.endpoints.Ibft.Propose(addr1, true)
+	assert.Error(t, err)
+	assert.Empty(t, store.operator.proposed)
+
+	_, err = dispatcher.endpoints.Ibft.Candidates()
+	assert.Error(t, err)
+}
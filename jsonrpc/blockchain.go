@@ -2,10 +2,13 @@ package jsonrpc
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/protocol"
+	serverProto "github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -26,6 +29,51 @@ type peersHelperInterface interface {
 	GetPeers() int
 }
 
+// accountsHelperInterface Wrapper for the node-managed accounts functions
+// They are implemented by the jsonRPCHub in server.go
+type accountsHelperInterface interface {
+	// Accounts returns the addresses of the accounts managed by the node
+	Accounts() []types.Address
+
+	// SignHash signs the hash using the key of the given account
+	SignHash(addr types.Address, hash []byte) ([]byte, error)
+
+	// SignTx signs the transaction using the key of the given account
+	SignTx(addr types.Address, tx *types.Transaction) (*types.Transaction, error)
+
+	// NewAccount creates a new account encrypted with the passphrase
+	NewAccount(passphrase string) (types.Address, error)
+
+	// UnlockAccount unlocks the account for the given duration (0 means indefinitely)
+	UnlockAccount(addr types.Address, passphrase string, duration time.Duration) error
+
+	// LockAccount locks the account
+	LockAccount(addr types.Address) error
+
+	// SignHashWithPassphrase signs the hash using the key of the given account, decrypted with the passphrase
+	SignHashWithPassphrase(addr types.Address, passphrase string, hash []byte) ([]byte, error)
+
+	// SignTxWithPassphrase signs the transaction using the key of the given account, decrypted with the passphrase
+	SignTxWithPassphrase(addr types.Address, passphrase string, tx *types.Transaction) (*types.Transaction, error)
+}
+
+// adminHelperInterface Wrapper for the node administration services
+// They are implemented by the jsonRPCHub in server.go
+type adminHelperInterface interface {
+	// SystemService returns the gRPC System service of the node
+	SystemService() serverProto.SystemServer
+
+	// IbftOperator returns the IBFT operator service, or nil if the node doesn't run IBFT
+	IbftOperator() ibftOp.IbftOperatorServer
+
+	// Authorize checks the call to the operator service method (/package.Service/Method)
+	// against the authorization policy of the gRPC operator services
+	Authorize(method string) error
+
+	// DataDir returns the data directory of the node
+	DataDir() string
+}
+
 // blockchain is the interface with the blockchain required
 // by the filter manager
 type blockchainInterface interface {
@@ -50,6 +98,12 @@ type blockchainInterface interface {
 	// AddTx adds a new transaction to the tx pool
 	AddTx(tx *types.Transaction) error
 
+	// AddPrivateTx adds a new transaction to the tx pool, forwarding it only to the validators
+	AddPrivateTx(tx *types.Transaction, maxBlockNumber uint64) error
+
+	// AddBundle adds a bundle of transactions included atomically by the block builder
+	AddBundle(txs []*types.Transaction, minBlockNumber, maxBlockNumber uint64) (types.Hash, error)
+
 	// GetTxs gets tx pool transactions currently pending for inclusion and currently queued for validation
 	GetTxs(inclQueued bool) (map[types.Address][]*types.Transaction, map[types.Address][]*types.Transaction)
 
@@ -62,20 +116,39 @@ type blockchainInterface interface {
 	// GetBlockByNumber returns a block using the provided number
 	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
 
-	// ApplyTxn applies a transaction object to the blockchain
-	ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	// ApplyTxn applies a transaction object to the blockchain,
+	// on top of the optional state and block environment overrides
+	ApplyTxn(
+		header *types.Header,
+		txn *types.Transaction,
+		stateOverride types.StateOverride,
+		blockOverride *types.BlockOverride,
+	) (*runtime.ExecutionResult, error)
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *protocol.Progression
 
+	// GetTD returns the total difficulty of the chain up to the block with the given hash
+	GetTD(hash types.Hash) (*big.Int, bool)
+
 	// GetNonce returns the next nonce for this address
 	GetNonce(addr types.Address) uint64
 
 	// GetCapacity returns the current and max capacity of the pool
 	GetCapacity() (uint64, uint64)
 
+	// GetPendingBlock builds the pending block on top of the current head,
+	// using the executable transactions from the tx pool
+	GetPendingBlock() (*types.Block, error)
+
+	// IsSealing returns a flag indicating if the node is sealing blocks
+	IsSealing() bool
+
 	stateHelperInterface
 	peersHelperInterface
+	accountsHelperInterface
+
+	adminHelperInterface
 }
 
 type nullBlockchainInterface struct {
@@ -113,6 +186,17 @@ func (b *nullBlockchainInterface) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+func (b *nullBlockchainInterface) AddPrivateTx(tx *types.Transaction, maxBlockNumber uint64) error {
+	return nil
+}
+
+func (b *nullBlockchainInterface) AddBundle(
+	txs []*types.Transaction,
+	minBlockNumber, maxBlockNumber uint64,
+) (types.Hash, error) {
+	return types.ZeroHash, nil
+}
+
 func (b *nullBlockchainInterface) GetTxs(inclQueued bool) (
 	map[types.Address][]*types.Transaction,
 	map[types.Address][]*types.Transaction,
@@ -139,6 +223,8 @@ func (b *nullBlockchainInterface) GetBlockByNumber(num uint64, full bool) (*type
 func (b *nullBlockchainInterface) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverride *types.BlockOverride,
 ) (*runtime.ExecutionResult, error) {
 	return nil, nil
 }
@@ -167,6 +253,74 @@ func (b *nullBlockchainInterface) GetSyncProgression() *protocol.Progression {
 	return nil
 }
 
+func (b *nullBlockchainInterface) GetTD(hash types.Hash) (*big.Int, bool) {
+	return nil, false
+}
+
 func (b *nullBlockchainInterface) GetPeers() int {
 	return 0
 }
+
+func (b *nullBlockchainInterface) GetPendingBlock() (*types.Block, error) {
+	return nil, nil
+}
+
+func (b *nullBlockchainInterface) IsSealing() bool {
+	return false
+}
+
+func (b *nullBlockchainInterface) Accounts() []types.Address {
+	return nil
+}
+
+func (b *nullBlockchainInterface) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *nullBlockchainInterface) SignTx(addr types.Address, tx *types.Transaction) (*types.Transaction, error) {
+	return nil, nil
+}
+
+func (b *nullBlockchainInterface) NewAccount(passphrase string) (types.Address, error) {
+	return types.ZeroAddress, nil
+}
+
+func (b *nullBlockchainInterface) UnlockAccount(addr types.Address, passphrase string, duration time.Duration) error {
+	return nil
+}
+
+func (b *nullBlockchainInterface) LockAccount(addr types.Address) error {
+	return nil
+}
+
+func (b *nullBlockchainInterface) SignHashWithPassphrase(
+	addr types.Address,
+	passphrase string,
+	hash []byte,
+) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *nullBlockchainInterface) SignTxWithPassphrase(
+	addr types.Address,
+	passphrase string,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	return nil, nil
+}
+
+func (b *nullBlockchainInterface) SystemService() serverProto.SystemServer {
+	return nil
+}
+
+func (b *nullBlockchainInterface) IbftOperator() ibftOp.IbftOperatorServer {
+	return nil
+}
+
+func (b *nullBlockchainInterface) Authorize(method string) error {
+	return nil
+}
+
+func (b *nullBlockchainInterface) DataDir() string {
+	return ""
+}
@@ -32,10 +32,24 @@ func (f *funcData) numParams() int {
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	Txpool *Txpool
+	Eth      *Eth
+	Web3     *Web3
+	Net      *Net
+	Txpool   *Txpool
+	Personal *Personal
+	Admin    *Admin
+	Ibft     *Ibft
+}
+
+// dispatcherParams are the chain parameters and the optional namespaces of the dispatcher
+type dispatcherParams struct {
+	chainID uint64
+
+	// enablePersonal registers the personal namespace, which exposes the node-managed accounts
+	enablePersonal bool
+
+	// enableAdmin registers the admin and ibft namespaces, which expose the node administration
+	enableAdmin bool
 }
 
 // Dispatcher handles jsonrpc requests
@@ -45,7 +59,7 @@ type Dispatcher struct {
 	serviceMap    map[string]*serviceData
 	endpoints     endpoints
 	filterManager *FilterManager
-	chainID       uint64
+	params        *dispatcherParams
 }
 
 // newTestDispatcher returns a dispatcher without the filter manager, used for testing
@@ -53,6 +67,10 @@ func newTestDispatcher(logger hclog.Logger, store blockchainInterface) *Dispatch
 	d := &Dispatcher{
 		logger: logger.Named("dispatcher"),
 		store:  store,
+		params: &dispatcherParams{
+			enablePersonal: true,
+			enableAdmin:    true,
+		},
 	}
 
 	d.registerEndpoints()
@@ -60,11 +78,11 @@ func newTestDispatcher(logger hclog.Logger, store blockchainInterface) *Dispatch
 	return d
 }
 
-func newDispatcher(logger hclog.Logger, store blockchainInterface, chainID uint64) *Dispatcher {
+func newDispatcher(logger hclog.Logger, store blockchainInterface, params *dispatcherParams) *Dispatcher {
 	d := &Dispatcher{
-		logger:  logger.Named("dispatcher"),
-		store:   store,
-		chainID: chainID,
+		logger: logger.Named("dispatcher"),
+		store:  store,
+		params: params,
 	}
 
 	d.registerEndpoints()
@@ -87,6 +105,22 @@ func (d *Dispatcher) registerEndpoints() {
 	d.registerService("net", d.endpoints.Net)
 	d.registerService("web3", d.endpoints.Web3)
 	d.registerService("txpool", d.endpoints.Txpool)
+
+	if d.params.enablePersonal {
+		d.endpoints.Personal = &Personal{d}
+		d.registerService("personal", d.endpoints.Personal)
+	}
+
+	if d.params.enableAdmin {
+		d.endpoints.Admin = &Admin{d}
+		d.registerService("admin", d.endpoints.Admin)
+
+		// The ibft namespace is available only if the node runs the IBFT consensus
+		if d.store != nil && d.store.IbftOperator() != nil {
+			d.endpoints.Ibft = &Ibft{d}
+			d.registerService("ibft", d.endpoints.Ibft)
+		}
+	}
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -496,7 +530,7 @@ func (d *Dispatcher) getNextNonce(address types.Address, number BlockNumber) (ui
 
 	acc, err := d.store.GetAccount(header.StateRoot, address)
 
-	if errors.As(err, &ErrStateNotFound) {
+	if errors.Is(err, ErrStateNotFound) {
 		// If the account doesn't exist / isn't initialized,
 		// return a nonce value of 0
 		return 0, nil
@@ -561,11 +595,18 @@ func (d *Dispatcher) decodeTxn(arg *txnArgs) (*types.Transaction, error) {
 		Value:    new(big.Int).SetBytes(*arg.Value),
 		Input:    input,
 		Nonce:    uint64(*arg.Nonce),
+		FeePayer: arg.FeePayer,
 	}
 	if arg.To != nil {
 		txn.To = arg.To
 	}
 
+	if arg.FeePayerV != nil && arg.FeePayerR != nil && arg.FeePayerS != nil {
+		txn.FeePayerV = (*big.Int)(arg.FeePayerV)
+		txn.FeePayerR = (*big.Int)(arg.FeePayerR)
+		txn.FeePayerS = (*big.Int)(arg.FeePayerS)
+	}
+
 	txn.ComputeHash()
 
 	return txn, nil
@@ -60,7 +60,7 @@ func expectBatchJSONResult(data []byte, v interface{}) error {
 func TestDispatcherWebsocket(t *testing.T) {
 	store := newMockStore()
 
-	s := newDispatcher(hclog.NewNullLogger(), store, 0)
+	s := newDispatcher(hclog.NewNullLogger(), store, &dispatcherParams{})
 	s.registerEndpoints()
 
 	mock := &mockWsConn{
@@ -95,7 +95,7 @@ func TestDispatcherWebsocket(t *testing.T) {
 func TestDispatcherWebsocketRequestFormats(t *testing.T) {
 	store := newMockStore()
 
-	s := newDispatcher(hclog.NewNullLogger(), store, 0)
+	s := newDispatcher(hclog.NewNullLogger(), store, &dispatcherParams{})
 	s.registerEndpoints()
 
 	mock := &mockWsConn{
@@ -200,7 +200,7 @@ func (m *mockService) Filter(f LogFilter) (interface{}, error) {
 func TestDispatcherFuncDecode(t *testing.T) {
 	srv := &mockService{msgCh: make(chan interface{}, 10)}
 
-	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	s.registerService("mock", srv)
 
 	handleReq := func(typ string, msg string) interface{} {
@@ -265,7 +265,7 @@ func TestDispatcherFuncDecode(t *testing.T) {
 }
 
 func TestDispatcherBatchRequest(t *testing.T) {
-	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	s.registerEndpoints()
 
 	// test with leading whitespace ("  \t\n\n\r")
@@ -290,6 +290,8 @@ func TestDispatcherBatchRequest(t *testing.T) {
 }
 
 func TestDecodeTxn(t *testing.T) {
+	feePayer := types.StringToAddress("3")
+
 	tests := []struct {
 		name     string
 		accounts map[types.Address]*state.Account
@@ -438,6 +440,34 @@ func TestDecodeTxn(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "should set the fee payer signature",
+			arg: &txnArgs{
+				From:      &addr1,
+				To:        &addr2,
+				Gas:       toArgUint64Ptr(21000),
+				GasPrice:  toArgBytesPtr(big.NewInt(10000).Bytes()),
+				Nonce:     toArgUint64Ptr(1),
+				FeePayer:  &feePayer,
+				FeePayerV: argBigPtr(big.NewInt(1)),
+				FeePayerR: argBigPtr(big.NewInt(2)),
+				FeePayerS: argBigPtr(big.NewInt(3)),
+			},
+			res: &types.Transaction{
+				From:      addr1,
+				To:        &addr2,
+				Gas:       21000,
+				GasPrice:  big.NewInt(10000),
+				Value:     new(big.Int).SetBytes([]byte{}),
+				Input:     []byte{},
+				Nonce:     1,
+				FeePayer:  &feePayer,
+				FeePayerV: big.NewInt(1),
+				FeePayerR: big.NewInt(2),
+				FeePayerS: big.NewInt(3),
+			},
+			err: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,12 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/fastrlp"
 	"math/big"
 )
 
+// ethProtocolVersion is the version of the ethereum protocol reported by the node
+const ethProtocolVersion = 65
+
 // Eth is the eth jsonrpc endpoint
 type Eth struct {
 	d *Dispatcher
@@ -18,7 +22,7 @@ type Eth struct {
 // ChainId returns the chain id of the client
 //nolint:stylecheck
 func (e *Eth) ChainId() (interface{}, error) {
-	return argUintPtr(e.d.chainID), nil
+	return argUintPtr(e.d.params.chainID), nil
 }
 
 func (e *Eth) getHeaderFromBlockNumberOrHash(bnh *BlockNumberOrHash) (*types.Header, error) {
@@ -78,19 +82,33 @@ func GetNumericBlockNumber(number BlockNumber, e *Eth) (uint64, error) {
 	}
 }
 
-// GetBlockByNumber returns information about a block by block number
-func (e *Eth) GetBlockByNumber(number BlockNumber, fullTx bool) (interface{}, error) {
+// getBlockByNumber returns the block with the given number, or the pending block
+// built on top of the current head. Returns nil if the block is not found
+func (e *Eth) getBlockByNumber(number BlockNumber) (*types.Block, error) {
+	if number == PendingBlockNumber {
+		return e.d.store.GetPendingBlock()
+	}
+
 	num, err := GetNumericBlockNumber(number, e)
 	if err != nil {
 		return nil, err
 	}
 
 	block, ok := e.d.store.GetBlockByNumber(num, true)
-
 	if !ok {
 		return nil, nil
 	}
 
+	return block, nil
+}
+
+// GetBlockByNumber returns information about a block by block number
+func (e *Eth) GetBlockByNumber(number BlockNumber, fullTx bool) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
 	return toBlock(block, fullTx), nil
 }
 
@@ -104,19 +122,194 @@ func (e *Eth) GetBlockByHash(hash types.Hash, fullTx bool) (interface{}, error)
 	return toBlock(block, fullTx), nil
 }
 
+// GetBlockTransactionCountByNumber returns the number of transactions in the block with the given number
 func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{}, error) {
-	num, err := GetNumericBlockNumber(number, e)
-	if err != nil {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
 		return nil, err
 	}
 
-	block, ok := e.d.store.GetBlockByNumber(num, true)
+	return argUintPtr(uint64(len(block.Transactions))), nil
+}
+
+// GetBlockTransactionCountByHash returns the number of transactions in the block with the given hash
+func (e *Eth) GetBlockTransactionCountByHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.d.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUintPtr(uint64(len(block.Transactions))), nil
+}
+
+// transactionAt returns the transaction at the given index of the block, or nil if the index is out of range
+func transactionAt(block *types.Block, index argUint64) interface{} {
+	if uint64(index) >= uint64(len(block.Transactions)) {
+		return nil
+	}
+
+	idx := int(index)
+
+	return toTransaction(
+		block.Transactions[idx],
+		argUintPtr(block.Number()),
+		argHashPtr(block.Hash()),
+		&idx,
+	)
+}
+
+// GetTransactionByBlockHashAndIndex returns the transaction at the given index of the block with the given hash
+func (e *Eth) GetTransactionByBlockHashAndIndex(hash types.Hash, index argUint64) (interface{}, error) {
+	block, ok := e.d.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return transactionAt(block, index), nil
+}
+
+// GetTransactionByBlockNumberAndIndex returns the transaction at the given index of the block with the given number
+func (e *Eth) GetTransactionByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return transactionAt(block, index), nil
+}
+
+// GetUncleCountByBlockHash returns the number of uncles in the block with the given hash
+func (e *Eth) GetUncleCountByBlockHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.d.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUintPtr(uint64(len(block.Uncles))), nil
+}
+
+// GetUncleCountByBlockNumber returns the number of uncles in the block with the given number
+func (e *Eth) GetUncleCountByBlockNumber(number BlockNumber) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return argUintPtr(uint64(len(block.Uncles))), nil
+}
+
+// uncleAt returns the uncle at the given index of the block, or nil if the index is out of range
+func uncleAt(block *types.Block, index argUint64) interface{} {
+	if uint64(index) >= uint64(len(block.Uncles)) {
+		return nil
+	}
+
+	// Uncles are returned without their transactions, as per the spec
+	return toBlock(&types.Block{Header: block.Uncles[index]}, false)
+}
 
+// GetUncleByBlockHashAndIndex returns the uncle at the given index of the block with the given hash
+func (e *Eth) GetUncleByBlockHashAndIndex(hash types.Hash, index argUint64) (interface{}, error) {
+	block, ok := e.d.store.GetBlockByHash(hash, true)
 	if !ok {
 		return nil, nil
 	}
 
-	return len(block.Transactions), nil
+	return uncleAt(block, index), nil
+}
+
+// GetUncleByBlockNumberAndIndex returns the uncle at the given index of the block with the given number
+func (e *Eth) GetUncleByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return uncleAt(block, index), nil
+}
+
+// ProtocolVersion returns the current ethereum protocol version
+func (e *Eth) ProtocolVersion() (interface{}, error) {
+	return hex.EncodeUint64(ethProtocolVersion), nil
+}
+
+// Mining returns true if the node is actively sealing new blocks
+func (e *Eth) Mining() (interface{}, error) {
+	return e.d.store.IsSealing(), nil
+}
+
+// Hashrate returns the number of hashes per second the node is mining with.
+// Blocks are not sealed using proof of work, so it's always zero
+func (e *Eth) Hashrate() (interface{}, error) {
+	return argUintPtr(0), nil
+}
+
+// Accounts returns the addresses of the accounts managed by the node
+func (e *Eth) Accounts() (interface{}, error) {
+	accounts := e.d.store.Accounts()
+	if accounts == nil {
+		accounts = []types.Address{}
+	}
+
+	return accounts, nil
+}
+
+// isNodeAccount returns a flag indicating if the account is managed by the node
+func (e *Eth) isNodeAccount(address types.Address) bool {
+	for _, account := range e.d.store.Accounts() {
+		if account == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sign calculates an Ethereum specific signature of the data,
+// using the key of the given node-managed account
+func (e *Eth) Sign(address types.Address, data argBytes) (interface{}, error) {
+	signature, err := e.d.store.SignHash(address, textHash(data))
+	if err != nil {
+		return nil, err
+	}
+
+	// The recovery id is returned in the legacy [27, 28] range
+	signature[64] += 27
+
+	return argBytesPtr(signature), nil
+}
+
+// SignTransaction signs the transaction using the key of the sender node-managed account,
+// without submitting it to the tx pool
+func (e *Eth) SignTransaction(arg *txnArgs) (interface{}, error) {
+	if arg.From == nil {
+		return nil, fmt.Errorf("from not specified")
+	}
+
+	if arg.Gas == nil {
+		return nil, fmt.Errorf("gas not specified")
+	}
+
+	if arg.GasPrice == nil {
+		return nil, fmt.Errorf("gasPrice not specified")
+	}
+
+	transaction, err := e.d.decodeTxn(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := e.d.store.SignTx(transaction.From, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx.ComputeHash()
+
+	return &signTransactionResult{
+		Raw: signedTx.MarshalRLP(),
+		Tx:  toPendingTransaction(signedTx),
+	}, nil
 }
 
 // BlockNumber returns current block number
@@ -147,13 +340,84 @@ func (e *Eth) SendRawTransaction(input string) (interface{}, error) {
 	return tx.Hash.String(), nil
 }
 
+// SendPrivateTransaction sends a raw transaction only to the validators, keeping it
+// out of the public gossip until it is included or its max block number is reached
+func (e *Eth) SendPrivateTransaction(arg *privateTxnArgs) (interface{}, error) {
+	if arg.Tx == nil {
+		return nil, errors.New("missing value for required argument tx")
+	}
+
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(*arg.Tx); err != nil {
+		return nil, err
+	}
+
+	tx.ComputeHash()
+
+	var maxBlockNumber uint64
+	if arg.MaxBlockNumber != nil {
+		maxBlockNumber = uint64(*arg.MaxBlockNumber)
+	}
+
+	if err := e.d.store.AddPrivateTx(tx, maxBlockNumber); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// SendBundle sends raw transactions which are included atomically and in order,
+// in a block of the target range built by the node, or not included at all.
+// The bundles are only accepted by sealing nodes
+func (e *Eth) SendBundle(arg *bundleArgs) (interface{}, error) {
+	if len(arg.Txs) == 0 {
+		return nil, errors.New("missing value for required argument txs")
+	}
+
+	txs := make([]*types.Transaction, len(arg.Txs))
+
+	for i, raw := range arg.Txs {
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, err
+		}
+
+		txs[i] = tx
+	}
+
+	var minBlockNumber, maxBlockNumber uint64
+	if arg.MinBlockNumber != nil {
+		minBlockNumber = uint64(*arg.MinBlockNumber)
+	}
+
+	if arg.MaxBlockNumber != nil {
+		maxBlockNumber = uint64(*arg.MaxBlockNumber)
+	}
+
+	hash, err := e.d.store.AddBundle(txs, minBlockNumber, maxBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundleResult{BundleHash: hash}, nil
+}
+
 // SendTransaction creates new message call transaction or a contract creation, if the data field contains code.
+// Transactions from node-managed accounts are signed with the unlocked account key
 func (e *Eth) SendTransaction(arg *txnArgs) (interface{}, error) {
 	transaction, err := e.d.decodeTxn(arg)
 	if err != nil {
 		return nil, err
 	}
 
+	if e.isNodeAccount(transaction.From) {
+		if transaction, err = e.d.store.SignTx(transaction.From, transaction); err != nil {
+			return nil, err
+		}
+
+		transaction.ComputeHash()
+	}
+
 	if err := e.d.store.AddTx(transaction); err != nil {
 		return nil, err
 	}
@@ -310,6 +574,7 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		ContractAddress:   raw.ContractAddress,
 		FromAddr:          txn.From,
 		ToAddr:            txn.To,
+		FeePayer:          txn.FeePayer,
 		Logs:              logs,
 	}
 
@@ -340,7 +605,7 @@ func (e *Eth) GetStorageAt(
 	// Get the storage for the passed in location
 	result, err := e.d.store.GetStorage(header.StateRoot, address, index)
 	if err != nil {
-		if errors.As(err, &ErrStateNotFound) {
+		if errors.Is(err, ErrStateNotFound) {
 			return argBytesPtr(types.ZeroHash[:]), nil
 		}
 
@@ -371,10 +636,13 @@ func (e *Eth) GasPrice() (interface{}, error) {
 	return avgGasPrice, nil
 }
 
-// Call executes a smart contract call using the transaction object data
+// Call executes a smart contract call using the transaction object data.
+// The optional state and block overrides are applied only for the duration of the call
 func (e *Eth) Call(
 	arg *txnArgs,
 	filter BlockNumberOrHash,
+	stateOverrides *stateOverride,
+	blockOverrides *blockOverride,
 ) (interface{}, error) {
 	var (
 		header *types.Header
@@ -396,13 +664,24 @@ func (e *Eth) Call(
 	if err != nil {
 		return nil, err
 	}
+	blockOverride := blockOverrides.toBlockOverride()
+
 	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
 	if transaction.Gas == 0 {
 		transaction.Gas = header.GasLimit
+
+		if blockOverride != nil && blockOverride.GasLimit != nil {
+			transaction.Gas = *blockOverride.GasLimit
+		}
 	}
 
 	// The return value of the execution is saved in the transition (returnValue field)
-	result, err := e.d.store.ApplyTxn(header, transaction)
+	result, err := e.d.store.ApplyTxn(
+		header,
+		transaction,
+		stateOverrides.toStateOverride(),
+		blockOverride,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -414,10 +693,13 @@ func (e *Eth) Call(
 	return argBytesPtr(result.ReturnValue), nil
 }
 
-// EstimateGas estimates the gas needed to execute a transaction
+// EstimateGas estimates the gas needed to execute a transaction.
+// The optional state and block overrides are applied to every execution of the estimation
 func (e *Eth) EstimateGas(
 	arg *txnArgs,
 	rawNum *BlockNumber,
+	stateOverrides *stateOverride,
+	blockOverrides *blockOverride,
 ) (interface{}, error) {
 	transaction, err := e.d.decodeTxn(arg)
 	if err != nil {
@@ -435,6 +717,11 @@ func (e *Eth) EstimateGas(
 		return nil, err
 	}
 
+	var (
+		stateOverride = stateOverrides.toStateOverride()
+		blockOverride = blockOverrides.toBlockOverride()
+	)
+
 	forksInTime := e.d.store.GetForksInTime(uint64(number))
 
 	var standardGas uint64
@@ -456,6 +743,10 @@ func (e *Eth) EstimateGas(
 	} else {
 		// If not, use the referenced block number
 		highEnd = header.GasLimit
+
+		if blockOverride != nil && blockOverride.GasLimit != nil {
+			highEnd = *blockOverride.GasLimit
+		}
 	}
 
 	gasPriceInt := new(big.Int).Set(transaction.GasPrice)
@@ -469,7 +760,7 @@ func (e *Eth) EstimateGas(
 		accountBalance := big.NewInt(0)
 		acc, err := e.d.store.GetAccount(header.StateRoot, transaction.From)
 
-		if err != nil && !errors.As(err, &ErrStateNotFound) {
+		if err != nil && !errors.Is(err, ErrStateNotFound) {
 			// An unrelated error occurred, return it
 			return nil, err
 		} else if err == nil {
@@ -478,6 +769,11 @@ func (e *Eth) EstimateGas(
 			accountBalance = acc.Balance
 		}
 
+		// The balance override takes precedence over the state
+		if override, ok := stateOverride[transaction.From]; ok && override.Balance != nil {
+			accountBalance = override.Balance
+		}
+
 		available := new(big.Int).Set(accountBalance)
 
 		if transaction.Value != nil {
@@ -509,7 +805,7 @@ func (e *Eth) EstimateGas(
 		txn := transaction.Copy()
 		txn.Gas = gas
 
-		result, err := e.d.store.ApplyTxn(header, txn)
+		result, err := e.d.store.ApplyTxn(header, txn, stateOverride, blockOverride)
 
 		if err != nil {
 			return true, err
@@ -666,7 +962,7 @@ func (e *Eth) GetBalance(address types.Address, filter BlockNumberOrHash) (inter
 
 	// Extract the account balance
 	acc, err := e.d.store.GetAccount(header.StateRoot, address)
-	if errors.As(err, &ErrStateNotFound) {
+	if errors.Is(err, ErrStateNotFound) {
 		// Account not found, return an empty account
 		return argUintPtr(0), nil
 	} else if err != nil {
@@ -732,7 +1028,7 @@ func (e *Eth) GetCode(address types.Address, filter BlockNumberOrHash) (interfac
 	emptySlice := []byte{}
 	acc, err := e.d.store.GetAccount(header.StateRoot, address)
 
-	if errors.As(err, &ErrStateNotFound) {
+	if errors.Is(err, ErrStateNotFound) {
 		// If the account doesn't exist / is not initialized yet,
 		// return the default value
 		return "0x", nil
@@ -777,3 +1073,11 @@ func (e *Eth) Unsubscribe(id string) (bool, error) {
 
 	return ok, nil
 }
+
+// textHash calculates the hash of the data prefixed with the Ethereum signed message header,
+// so the signature can't be used to sign a transaction
+func textHash(data []byte) []byte {
+	msg := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(data), data)
+
+	return keccak.Keccak256(nil, []byte(msg))
+}
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"fmt"
 	"github.com/umbracle/fastrlp"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -270,7 +272,7 @@ func TestEth_Block_GetBlockTransactionCountByNumber(t *testing.T) {
 
 		if c.isNotNil {
 			assert.NotNil(t, res, "expected to return block, but got nil")
-			assert.Equal(t, res, 1)
+			assert.Equal(t, argUintPtr(1), res)
 		} else {
 			assert.Nil(t, res, "expected to return nil, but got data")
 		}
@@ -283,6 +285,153 @@ func TestEth_Block_GetBlockTransactionCountByNumber(t *testing.T) {
 	}
 }
 
+func TestEth_Block_GetBlockTransactionCountByHash(t *testing.T) {
+	store := &mockBlockStore2{}
+	store.add(&types.Block{
+		Header: &types.Header{
+			Hash: hash1,
+		},
+		Transactions: []*types.Transaction{{From: addr0}, {From: addr0}},
+	})
+
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Eth.GetBlockTransactionCountByHash(hash1)
+	assert.NoError(t, err)
+	assert.Equal(t, argUintPtr(2), res)
+
+	res, err = dispatcher.endpoints.Eth.GetBlockTransactionCountByHash(hash2)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestEth_Block_GetTransactionByBlockAndIndex(t *testing.T) {
+	store := &mockBlockStore2{}
+
+	for i := 0; i < 3; i++ {
+		tx := &types.Transaction{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(0),
+			Value:    big.NewInt(0),
+			V:        big.NewInt(0),
+			R:        big.NewInt(0),
+			S:        big.NewInt(0),
+		}
+		tx.ComputeHash()
+
+		store.add(&types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{tx},
+		})
+	}
+
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Eth.GetTransactionByBlockHashAndIndex(types.StringToHash("1"), 0)
+	assert.NoError(t, err)
+
+	txn, ok := res.(*transaction)
+	assert.True(t, ok)
+	assert.Equal(t, argUint64(1), txn.Nonce)
+	assert.Equal(t, argUintPtr(1), txn.BlockNumber)
+	assert.Equal(t, argUintPtr(0), txn.TxIndex)
+
+	res, err = dispatcher.endpoints.Eth.GetTransactionByBlockNumberAndIndex(LatestBlockNumber, 0)
+	assert.NoError(t, err)
+
+	txn, ok = res.(*transaction)
+	assert.True(t, ok)
+	assert.Equal(t, argUint64(2), txn.Nonce)
+
+	// out of range index
+	res, err = dispatcher.endpoints.Eth.GetTransactionByBlockNumberAndIndex(BlockNumber(1), 1)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// unknown block
+	res, err = dispatcher.endpoints.Eth.GetTransactionByBlockHashAndIndex(types.StringToHash("5"), 0)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestEth_Block_Uncles(t *testing.T) {
+	store := &mockBlockStore2{}
+	store.add(&types.Block{
+		Header: &types.Header{
+			Hash: hash1,
+		},
+		Uncles: []*types.Header{
+			{
+				Number: 1,
+				Hash:   hash2,
+			},
+		},
+	})
+
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Eth.GetUncleCountByBlockHash(hash1)
+	assert.NoError(t, err)
+	assert.Equal(t, argUintPtr(1), res)
+
+	res, err = dispatcher.endpoints.Eth.GetUncleCountByBlockNumber(LatestBlockNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, argUintPtr(1), res)
+
+	res, err = dispatcher.endpoints.Eth.GetUncleByBlockHashAndIndex(hash1, 0)
+	assert.NoError(t, err)
+
+	uncle, ok := res.(*block)
+	assert.True(t, ok)
+	assert.Equal(t, hash2, uncle.Hash)
+
+	res, err = dispatcher.endpoints.Eth.GetUncleByBlockNumberAndIndex(LatestBlockNumber, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+type mockPendingBlockStore struct {
+	mockBlockStore2
+	pending *types.Block
+}
+
+func (m *mockPendingBlockStore) GetPendingBlock() (*types.Block, error) {
+	return m.pending, nil
+}
+
+func TestEth_Block_PendingBlock(t *testing.T) {
+	store := &mockPendingBlockStore{
+		pending: &types.Block{
+			Header: &types.Header{
+				Number: 11,
+			},
+			Transactions: []*types.Transaction{{From: addr0}},
+		},
+	}
+	store.add(&types.Block{
+		Header: &types.Header{
+			Number: 10,
+		},
+	})
+
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Eth.GetBlockByNumber(PendingBlockNumber, false)
+	assert.NoError(t, err)
+
+	pending, ok := res.(*block)
+	assert.True(t, ok)
+	assert.Equal(t, argUint64(11), pending.Number)
+	assert.Len(t, pending.Transactions, 1)
+
+	res, err = dispatcher.endpoints.Eth.GetBlockTransactionCountByNumber(PendingBlockNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, argUintPtr(1), res)
+}
+
 func TestEth_Block_GetLogs(t *testing.T) {
 	blockHash := types.StringToHash("1")
 
@@ -1004,8 +1153,11 @@ func TestEth_State_GetStorageAt(t *testing.T) {
 
 type mockStoreTxn struct {
 	nullBlockchainInterface
-	accounts map[types.Address]*mockAccount2
-	txn      *types.Transaction
+	accounts       map[types.Address]*mockAccount2
+	txn            *types.Transaction
+	maxBlockNumber uint64
+	bundle         []*types.Transaction
+	minBlockNumber uint64
 }
 
 func (m *mockStoreTxn) GetForksInTime(blockNumber uint64) chain.ForksInTime {
@@ -1018,6 +1170,24 @@ func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+func (m *mockStoreTxn) AddPrivateTx(tx *types.Transaction, maxBlockNumber uint64) error {
+	m.txn = tx
+	m.maxBlockNumber = maxBlockNumber
+
+	return nil
+}
+
+func (m *mockStoreTxn) AddBundle(
+	txs []*types.Transaction,
+	minBlockNumber, maxBlockNumber uint64,
+) (types.Hash, error) {
+	m.bundle = txs
+	m.minBlockNumber = minBlockNumber
+	m.maxBlockNumber = maxBlockNumber
+
+	return types.StringToHash("1"), nil
+}
+
 func (m *mockStoreTxn) GetNonce(addr types.Address) uint64 {
 	return 1
 }
@@ -1069,6 +1239,66 @@ func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
 	}
 }
 
+func TestEth_TxnPool_SendPrivateTransaction(t *testing.T) {
+	store := &mockStoreTxn{}
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	txn := &types.Transaction{
+		From: addr0,
+		V:    big.NewInt(1),
+	}
+	txn.ComputeHash()
+
+	data := argBytes(txn.MarshalRLP())
+
+	hash, err := dispatcher.endpoints.Eth.SendPrivateTransaction(&privateTxnArgs{
+		Tx:             &data,
+		MaxBlockNumber: argUintPtr(10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, txn.Hash.String(), hash)
+	assert.Equal(t, uint64(10), store.maxBlockNumber)
+
+	_, err = dispatcher.endpoints.Eth.SendPrivateTransaction(&privateTxnArgs{})
+	assert.Error(t, err)
+}
+
+func TestEth_TxnPool_SendBundle(t *testing.T) {
+	store := &mockStoreTxn{}
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	txs := []*types.Transaction{
+		{From: addr0, Nonce: 0, V: big.NewInt(1)},
+		{From: addr0, Nonce: 1, V: big.NewInt(1)},
+	}
+
+	raw := make([]argBytes, len(txs))
+	for i, txn := range txs {
+		txn.ComputeHash()
+		raw[i] = txn.MarshalRLP()
+	}
+
+	res, err := dispatcher.endpoints.Eth.SendBundle(&bundleArgs{
+		Txs:            raw,
+		MinBlockNumber: argUintPtr(5),
+		MaxBlockNumber: argUintPtr(10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &bundleResult{BundleHash: types.StringToHash("1")}, res)
+	assert.Equal(t, uint64(5), store.minBlockNumber)
+	assert.Equal(t, uint64(10), store.maxBlockNumber)
+
+	// the order of the transactions is kept
+	assert.Len(t, store.bundle, len(txs))
+
+	for i, txn := range txs {
+		assert.Equal(t, txn.Hash, store.bundle[i].Hash)
+	}
+
+	_, err = dispatcher.endpoints.Eth.SendBundle(&bundleArgs{})
+	assert.Error(t, err)
+}
+
 func TestEth_TxnPool_SendTransaction(t *testing.T) {
 	store := &mockStoreTxn{}
 	store.AddAccount(addr0)
@@ -1084,3 +1314,117 @@ func TestEth_TxnPool_SendTransaction(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, store.txn.Hash, types.ZeroHash)
 }
+
+type mockSigningStore struct {
+	mockStoreTxn
+	key *ecdsa.PrivateKey
+}
+
+func newMockSigningStore(t *testing.T) *mockSigningStore {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	return &mockSigningStore{key: key}
+}
+
+func (m *mockSigningStore) address() types.Address {
+	return crypto.PubKeyToAddress(&m.key.PublicKey)
+}
+
+func (m *mockSigningStore) Accounts() []types.Address {
+	return []types.Address{m.address()}
+}
+
+func (m *mockSigningStore) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	if addr != m.address() {
+		return nil, fmt.Errorf("unknown account")
+	}
+
+	return crypto.Sign(m.key, hash)
+}
+
+func (m *mockSigningStore) SignTx(addr types.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if addr != m.address() {
+		return nil, fmt.Errorf("unknown account")
+	}
+
+	return crypto.NewEIP155Signer(100).SignTx(tx, m.key)
+}
+
+func TestEth_Accounts(t *testing.T) {
+	store := newMockSigningStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	res, err := dispatcher.endpoints.Eth.Accounts()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{store.address()}, res)
+
+	// the node has no accounts
+	dispatcher = newTestDispatcher(hclog.NewNullLogger(), &mockStoreTxn{})
+
+	res, err = dispatcher.endpoints.Eth.Accounts()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{}, res)
+}
+
+func TestEth_Sign(t *testing.T) {
+	store := newMockSigningStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	data := argBytes("hello world")
+
+	res, err := dispatcher.endpoints.Eth.Sign(store.address(), data)
+	assert.NoError(t, err)
+
+	signature := []byte(*res.(*argBytes))
+	assert.Len(t, signature, 65)
+	assert.True(t, signature[64] == 27 || signature[64] == 28)
+
+	// the signer should be recoverable from the prefixed hash
+	signature[64] -= 27
+	pub, err := crypto.SigToPub(textHash(data), signature)
+	assert.NoError(t, err)
+	assert.Equal(t, store.address(), crypto.PubKeyToAddress(pub))
+
+	// unknown account
+	_, err = dispatcher.endpoints.Eth.Sign(addr0, data)
+	assert.Error(t, err)
+}
+
+func TestEth_SignTransaction(t *testing.T) {
+	store := newMockSigningStore(t)
+	store.AddAccount(store.address())
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	arg := &txnArgs{
+		From:     argAddrPtr(store.address()),
+		To:       argAddrPtr(addr0),
+		Nonce:    argUintPtr(0),
+		Gas:      argUintPtr(21000),
+		GasPrice: argBytesPtr([]byte{0x1}),
+	}
+
+	res, err := dispatcher.endpoints.Eth.SignTransaction(arg)
+	assert.NoError(t, err)
+
+	result, ok := res.(*signTransactionResult)
+	assert.True(t, ok)
+
+	tx := &types.Transaction{}
+	assert.NoError(t, tx.UnmarshalRLP(result.Raw))
+
+	sender, err := crypto.NewEIP155Signer(100).Sender(tx)
+	assert.NoError(t, err)
+	assert.Equal(t, store.address(), sender)
+	assert.Equal(t, result.Tx.Hash, tx.Hash)
+
+	// the transaction is not submitted to the pool
+	assert.Nil(t, store.txn)
+
+	// the gas has to be specified
+	arg.Gas = nil
+	_, err = dispatcher.endpoints.Eth.SignTransaction(arg)
+	assert.Error(t, err)
+}
@@ -1,7 +1,6 @@
 package jsonrpc
 
 import (
-	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -231,7 +230,12 @@ func (m *mockStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	panic("implement me")
 }
 
-func (m *mockStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+func (m *mockStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverride *types.BlockOverride,
+) (*runtime.ExecutionResult, error) {
 	panic("implement me")
 }
 
@@ -240,7 +244,7 @@ func (m *mockStore) GetAccount(root types.Hash, addr types.Address) (*state.Acco
 		return acc, nil
 	}
 
-	return nil, errors.New("given root and slot not found in storage")
+	return nil, ErrStateNotFound
 }
 
 func (m *mockStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
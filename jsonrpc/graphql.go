@@ -0,0 +1,195 @@
+package jsonrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DefaultGraphQLMaxDepth is the default maximum nesting depth of a GraphQL query
+	DefaultGraphQLMaxDepth = 12
+
+	// DefaultGraphQLMaxComplexity is the default maximum complexity of a GraphQL query
+	DefaultGraphQLMaxComplexity = 10000
+)
+
+// GraphQL is the EIP-1767 GraphQL http server
+type GraphQL struct {
+	logger hclog.Logger
+	config *GraphQLConfig
+	schema *graphql.Schema
+}
+
+type GraphQLConfig struct {
+	Store   blockchainInterface
+	Addr    *net.TCPAddr
+	ChainID uint64
+
+	// TLSConfig serves HTTPS, if set
+	TLSConfig *tls.Config
+
+	// MaxDepth is the maximum nesting depth of a query
+	MaxDepth uint64
+
+	// MaxComplexity is the maximum number of objects (blocks, transactions, logs, accounts)
+	// a query can resolve, with the calls and gas estimations weighing more
+	MaxComplexity uint64
+}
+
+// graphQLRequest is the body of a GraphQL POST request
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// NewGraphQL returns the GraphQL http server
+func NewGraphQL(logger hclog.Logger, config *GraphQLConfig) (*GraphQL, error) {
+	srv, err := newGraphQL(logger, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.setupHTTP(); err != nil {
+		return nil, err
+	}
+
+	return srv, nil
+}
+
+// newGraphQL returns the GraphQL server without starting the http listener
+func newGraphQL(logger hclog.Logger, config *GraphQLConfig) (*GraphQL, error) {
+	if config.MaxDepth == 0 {
+		config.MaxDepth = DefaultGraphQLMaxDepth
+	}
+
+	if config.MaxComplexity == 0 {
+		config.MaxComplexity = DefaultGraphQLMaxComplexity
+	}
+
+	// the resolvers use a dispatcher without the filter manager,
+	// as the GraphQL server has no subscriptions
+	d := &Dispatcher{
+		logger: logger.Named("dispatcher"),
+		store:  config.Store,
+		params: &dispatcherParams{
+			chainID: config.ChainID,
+		},
+	}
+
+	schema, err := graphql.ParseSchema(
+		graphQLSchema,
+		newGraphQLResolver(d),
+		graphql.MaxDepth(int(config.MaxDepth)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphQL{
+		logger: logger.Named("graphql"),
+		config: config,
+		schema: schema,
+	}, nil
+}
+
+func (g *GraphQL) setupHTTP() error {
+	g.logger.Info("http server started", "addr", g.config.Addr.String())
+
+	lis, err := net.Listen("tcp", g.config.Addr.String())
+	if err != nil {
+		return err
+	}
+
+	if g.config.TLSConfig != nil {
+		lis = tls.NewListener(lis, g.config.TLSConfig)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", g.handle)
+
+	srv := http.Server{
+		Handler: mux,
+	}
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			g.logger.Error("closed http connection", "err", err)
+		}
+	}()
+
+	return nil
+}
+
+func (g *GraphQL) handle(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	w.Header().Set(
+		"Access-Control-Allow-Headers",
+		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
+	)
+
+	if req.Method == "OPTIONS" {
+		return
+	}
+
+	var request graphQLRequest
+
+	switch req.Method {
+	case "GET":
+		query := req.URL.Query()
+
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+		}
+	case "POST":
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+	default:
+		http.Error(w, "method "+req.Method+" not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	// log request
+	g.logger.Debug("handle", "query", request.Query)
+
+	resp := g.exec(req.Context(), &request)
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	//nolint
+	w.Write(data)
+}
+
+// exec executes the request within the complexity limit
+func (g *GraphQL) exec(ctx context.Context, request *graphQLRequest) *graphql.Response {
+	return g.schema.Exec(
+		withComplexityLimit(ctx, g.config.MaxComplexity),
+		request.Query,
+		request.OperationName,
+		request.Variables,
+	)
+}
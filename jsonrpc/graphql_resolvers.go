@@ -0,0 +1,1029 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// The complexity costs of the GraphQL fields that access the chain.
+// Every resolved object costs gqlObjectCost, while the calls are charged
+// more, as they execute the transaction on top of the state
+const (
+	gqlObjectCost      = 1
+	gqlCallCost        = 10
+	gqlEstimateGasCost = 50
+)
+
+var (
+	errGraphQLComplexityLimit = errors.New("query complexity limit exceeded")
+	errGraphQLNumberAndHash   = errors.New("only one of number or hash must be specified")
+)
+
+type gqlComplexityKey struct{}
+
+// gqlComplexityBudget is the complexity left for resolving a single query.
+// The resolvers run in parallel, so the budget is updated atomically
+type gqlComplexityBudget struct {
+	remaining int64
+}
+
+// withComplexityLimit returns a context limiting the complexity of the query resolved with it
+func withComplexityLimit(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, gqlComplexityKey{}, &gqlComplexityBudget{remaining: int64(limit)})
+}
+
+// chargeComplexity charges the cost against the complexity budget of the query.
+// An error is returned if the budget is exhausted
+func chargeComplexity(ctx context.Context, cost uint64) error {
+	budget, ok := ctx.Value(gqlComplexityKey{}).(*gqlComplexityBudget)
+	if !ok {
+		return nil
+	}
+
+	if atomic.AddInt64(&budget.remaining, -int64(cost)) < 0 {
+		return errGraphQLComplexityLimit
+	}
+
+	return nil
+}
+
+// graphQLResolver is the root resolver of the GraphQL queries and mutations
+type graphQLResolver struct {
+	d   *Dispatcher
+	eth *Eth
+}
+
+func newGraphQLResolver(d *Dispatcher) *graphQLResolver {
+	return &graphQLResolver{
+		d:   d,
+		eth: &Eth{d},
+	}
+}
+
+func (r *graphQLResolver) newBlock(block *types.Block) *gqlBlock {
+	return &gqlBlock{r: r, block: block}
+}
+
+func (r *graphQLResolver) newAccount(address types.Address, number BlockNumber) *gqlAccount {
+	return &gqlAccount{r: r, address: address, number: number}
+}
+
+// blockByNumber returns the block with the given number, or nil if it's not found
+func (r *graphQLResolver) blockByNumber(ctx context.Context, number uint64) (*gqlBlock, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	block, ok := r.d.store.GetBlockByNumber(number, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return r.newBlock(block), nil
+}
+
+// blockByHash returns the block with the given hash, or nil if it's not found
+func (r *graphQLResolver) blockByHash(ctx context.Context, hash types.Hash) (*gqlBlock, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	block, ok := r.d.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return r.newBlock(block), nil
+}
+
+// blockRange resolves the optional range bounds against the current head
+func (r *graphQLResolver) blockRange(from, to *gqlLong) (uint64, uint64, error) {
+	head := r.d.store.Header().Number
+
+	start, end := head, head
+	if from != nil {
+		start = uint64(*from)
+	}
+
+	if to != nil && uint64(*to) < head {
+		end = uint64(*to)
+	}
+
+	if end < start {
+		return 0, 0, fmt.Errorf("incorrect range")
+	}
+
+	return start, end, nil
+}
+
+// call executes the call on top of the state of the given header
+func (r *graphQLResolver) call(ctx context.Context, header *types.Header, data gqlCallData) (*gqlCallResult, error) {
+	if err := chargeComplexity(ctx, gqlCallCost); err != nil {
+		return nil, err
+	}
+
+	transaction, err := r.d.decodeTxn(data.toTxnArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit, use the block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	result, err := r.d.store.ApplyTxn(header, transaction, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	status := gqlLong(1)
+	if result.Failed() {
+		status = 0
+	}
+
+	return &gqlCallResult{
+		data:    result.ReturnValue,
+		gasUsed: gqlLong(result.GasUsed),
+		status:  status,
+	}, nil
+}
+
+// estimateGas estimates the gas needed for the call on top of the state of the given block
+func (r *graphQLResolver) estimateGas(ctx context.Context, number BlockNumber, data gqlCallData) (gqlLong, error) {
+	if err := chargeComplexity(ctx, gqlEstimateGasCost); err != nil {
+		return 0, err
+	}
+
+	res, err := r.eth.EstimateGas(data.toTxnArgs(), &number, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	estimate, ok := res.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected gas estimate %v", res)
+	}
+
+	gas, err := strconv.ParseUint(strings.TrimPrefix(estimate, "0x"), 16, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(gas), nil
+}
+
+// Block fetches a block by number or by hash, or the latest block if neither is given
+func (r *graphQLResolver) Block(ctx context.Context, args struct {
+	Number *gqlLong
+	Hash   *gqlBytes32
+}) (*gqlBlock, error) {
+	switch {
+	case args.Number != nil && args.Hash != nil:
+		return nil, errGraphQLNumberAndHash
+	case args.Hash != nil:
+		return r.blockByHash(ctx, types.Hash(*args.Hash))
+	case args.Number != nil:
+		return r.blockByNumber(ctx, uint64(*args.Number))
+	default:
+		return r.blockByNumber(ctx, r.d.store.Header().Number)
+	}
+}
+
+// Blocks returns the blocks between two numbers, inclusive
+func (r *graphQLResolver) Blocks(ctx context.Context, args struct {
+	From gqlLong
+	To   *gqlLong
+}) ([]*gqlBlock, error) {
+	from, to, err := r.blockRange(&args.From, args.To)
+	if err != nil {
+		return nil, err
+	}
+
+	// the whole range is charged upfront, so large ranges are rejected without fetching the blocks
+	if err := chargeComplexity(ctx, (to-from+1)*gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*gqlBlock, 0, to-from+1)
+
+	for i := from; i <= to; i++ {
+		block, ok := r.d.store.GetBlockByNumber(i, true)
+		if !ok {
+			break
+		}
+
+		blocks = append(blocks, r.newBlock(block))
+	}
+
+	return blocks, nil
+}
+
+// Pending returns the current pending state
+func (r *graphQLResolver) Pending() *gqlPending {
+	return &gqlPending{r: r}
+}
+
+// Transaction returns a sealed or pending transaction by its hash
+func (r *graphQLResolver) Transaction(ctx context.Context, args struct{ Hash gqlBytes32 }) (*gqlTransaction, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	hash := types.Hash(args.Hash)
+
+	if blockHash, ok := r.d.store.ReadTxLookup(hash); ok {
+		if block, ok := r.d.store.GetBlockByHash(blockHash, true); ok {
+			for idx, txn := range block.Transactions {
+				if txn.Hash == hash {
+					return &gqlTransaction{r: r, tx: txn, block: r.newBlock(block), index: idx}, nil
+				}
+			}
+		}
+	}
+
+	if txn, ok := r.d.store.GetPendingTx(hash); ok {
+		return &gqlTransaction{r: r, tx: txn}, nil
+	}
+
+	return nil, nil
+}
+
+// Logs returns the log entries matching the filter
+func (r *graphQLResolver) Logs(ctx context.Context, args struct{ Filter gqlFilterCriteria }) ([]*gqlLog, error) {
+	from, to, err := r.blockRange(args.Filter.FromBlock, args.Filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := chargeComplexity(ctx, (to-from+1)*gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	filter := newGqlLogFilter(args.Filter.Addresses, args.Filter.Topics)
+	result := make([]*gqlLog, 0)
+
+	for i := from; i <= to; i++ {
+		block, ok := r.d.store.GetBlockByNumber(i, true)
+		if !ok {
+			break
+		}
+
+		if len(block.Transactions) == 0 {
+			continue
+		}
+
+		logs, err := r.newBlock(block).logs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, logs...)
+	}
+
+	return result, nil
+}
+
+// GasPrice returns the average gas price
+func (r *graphQLResolver) GasPrice() gqlBigInt {
+	return newGqlBigInt(r.d.store.GetAvgGasPrice())
+}
+
+// Syncing returns the sync progression, or nil if the node is not syncing
+func (r *graphQLResolver) Syncing() *gqlSyncState {
+	progression := r.d.store.GetSyncProgression()
+	if progression == nil {
+		return nil
+	}
+
+	return &gqlSyncState{
+		startingBlock: gqlLong(progression.StartingBlock),
+		currentBlock:  gqlLong(progression.CurrentBlock),
+		highestBlock:  gqlLong(progression.HighestBlock),
+	}
+}
+
+// ChainID returns the chain ID
+func (r *graphQLResolver) ChainID() gqlBigInt {
+	return newGqlBigInt(new(big.Int).SetUint64(r.d.params.chainID))
+}
+
+// SendRawTransaction adds the RLP encoded transaction to the tx pool
+func (r *graphQLResolver) SendRawTransaction(args struct{ Data gqlBytes }) (gqlBytes32, error) {
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(args.Data); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	tx.ComputeHash()
+
+	if err := r.d.store.AddTx(tx); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(tx.Hash), nil
+}
+
+// gqlCallData is the data of a local call
+type gqlCallData struct {
+	From     *gqlAddress
+	To       *gqlAddress
+	Gas      *gqlLong
+	GasPrice *gqlBigInt
+	Value    *gqlBigInt
+	Data     *gqlBytes
+}
+
+func (c *gqlCallData) toTxnArgs() *txnArgs {
+	arg := &txnArgs{}
+
+	if c.From != nil {
+		from := types.Address(*c.From)
+		arg.From = &from
+	}
+
+	if c.To != nil {
+		to := types.Address(*c.To)
+		arg.To = &to
+	}
+
+	if c.Gas != nil {
+		arg.Gas = argUintPtr(uint64(*c.Gas))
+	}
+
+	if c.GasPrice != nil {
+		arg.GasPrice = argBytesPtr(c.GasPrice.toBig().Bytes())
+	}
+
+	if c.Value != nil {
+		arg.Value = argBytesPtr(c.Value.toBig().Bytes())
+	}
+
+	if c.Data != nil {
+		arg.Data = argBytesPtr(*c.Data)
+	}
+
+	return arg
+}
+
+// gqlBlockFilterCriteria is the log filter applied to a single block
+type gqlBlockFilterCriteria struct {
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+// gqlFilterCriteria is the log filter applied to a range of blocks
+type gqlFilterCriteria struct {
+	FromBlock *gqlLong
+	ToBlock   *gqlLong
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+func newGqlLogFilter(addresses *[]gqlAddress, topics *[][]gqlBytes32) *LogFilter {
+	filter := &LogFilter{}
+
+	if addresses != nil {
+		for _, addr := range *addresses {
+			filter.Addresses = append(filter.Addresses, types.Address(addr))
+		}
+	}
+
+	if topics != nil {
+		for _, set := range *topics {
+			hashes := make([]types.Hash, 0, len(set))
+			for _, topic := range set {
+				hashes = append(hashes, types.Hash(topic))
+			}
+
+			filter.Topics = append(filter.Topics, hashes)
+		}
+	}
+
+	return filter
+}
+
+// gqlBlock resolves a block. The receipts are loaded on the first access
+type gqlBlock struct {
+	r     *graphQLResolver
+	block *types.Block
+
+	receiptsOnce sync.Once
+	receipts     []*types.Receipt
+	receiptsErr  error
+}
+
+func (b *gqlBlock) getReceipts() ([]*types.Receipt, error) {
+	b.receiptsOnce.Do(func() {
+		b.receipts, b.receiptsErr = b.r.d.store.GetReceiptsByHash(b.block.Hash())
+	})
+
+	return b.receipts, b.receiptsErr
+}
+
+// logs returns the logs of the block matching the filter
+func (b *gqlBlock) logs(ctx context.Context, filter *LogFilter) ([]*gqlLog, error) {
+	receipts, err := b.getReceipts()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*gqlLog, 0)
+	index := 0
+
+	for txIndex, receipt := range receipts {
+		if txIndex >= len(b.block.Transactions) {
+			break
+		}
+
+		txn := &gqlTransaction{r: b.r, tx: b.block.Transactions[txIndex], block: b, index: txIndex}
+
+		for _, log := range receipt.Logs {
+			if filter.Match(log) {
+				if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+					return nil, err
+				}
+
+				result = append(result, &gqlLog{r: b.r, tx: txn, log: log, index: int32(index)})
+			}
+
+			index++
+		}
+	}
+
+	return result, nil
+}
+
+func (b *gqlBlock) Number() gqlLong {
+	return gqlLong(b.block.Number())
+}
+
+func (b *gqlBlock) Hash() gqlBytes32 {
+	return gqlBytes32(b.block.Hash())
+}
+
+func (b *gqlBlock) Parent(ctx context.Context) (*gqlBlock, error) {
+	if b.block.Number() == 0 {
+		return nil, nil
+	}
+
+	return b.r.blockByHash(ctx, b.block.ParentHash())
+}
+
+func (b *gqlBlock) Nonce() gqlBytes {
+	return b.block.Header.Nonce[:]
+}
+
+func (b *gqlBlock) TransactionsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.TxRoot)
+}
+
+func (b *gqlBlock) TransactionCount() *int32 {
+	count := int32(len(b.block.Transactions))
+
+	return &count
+}
+
+func (b *gqlBlock) StateRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.StateRoot)
+}
+
+func (b *gqlBlock) ReceiptsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.ReceiptsRoot)
+}
+
+// Miner returns the account of the block miner, by default at the state of this block
+func (b *gqlBlock) Miner(ctx context.Context, args struct{ Block *gqlLong }) (*gqlAccount, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	number := BlockNumber(b.block.Number())
+	if args.Block != nil {
+		number = BlockNumber(*args.Block)
+	}
+
+	return b.r.newAccount(b.block.Header.Miner, number), nil
+}
+
+func (b *gqlBlock) ExtraData() gqlBytes {
+	return b.block.Header.ExtraData
+}
+
+func (b *gqlBlock) GasLimit() gqlLong {
+	return gqlLong(b.block.Header.GasLimit)
+}
+
+func (b *gqlBlock) GasUsed() gqlLong {
+	return gqlLong(b.block.Header.GasUsed)
+}
+
+func (b *gqlBlock) Timestamp() gqlLong {
+	return gqlLong(b.block.Header.Timestamp)
+}
+
+func (b *gqlBlock) LogsBloom() gqlBytes {
+	return b.block.Header.LogsBloom[:]
+}
+
+func (b *gqlBlock) MixHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.MixHash)
+}
+
+func (b *gqlBlock) Difficulty() gqlBigInt {
+	return newGqlBigInt(new(big.Int).SetUint64(b.block.Header.Difficulty))
+}
+
+func (b *gqlBlock) TotalDifficulty() (gqlBigInt, error) {
+	td, ok := b.r.d.store.GetTD(b.block.Hash())
+	if !ok {
+		return gqlBigInt{}, fmt.Errorf("total difficulty of block %s not found", b.block.Hash())
+	}
+
+	return newGqlBigInt(td), nil
+}
+
+// OmmerCount returns the number of uncles, which are never present in the supported consensus engines
+func (b *gqlBlock) OmmerCount() *int32 {
+	count := int32(0)
+
+	return &count
+}
+
+func (b *gqlBlock) Ommers() *[]*gqlBlock {
+	ommers := []*gqlBlock{}
+
+	return &ommers
+}
+
+func (b *gqlBlock) OmmerAt(args struct{ Index int32 }) *gqlBlock {
+	return nil
+}
+
+func (b *gqlBlock) OmmerHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Sha3Uncles)
+}
+
+func (b *gqlBlock) Transactions(ctx context.Context) (*[]*gqlTransaction, error) {
+	if err := chargeComplexity(ctx, uint64(len(b.block.Transactions))*gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	txns := make([]*gqlTransaction, 0, len(b.block.Transactions))
+	for idx, txn := range b.block.Transactions {
+		txns = append(txns, &gqlTransaction{r: b.r, tx: txn, block: b, index: idx})
+	}
+
+	return &txns, nil
+}
+
+func (b *gqlBlock) TransactionAt(ctx context.Context, args struct{ Index int32 }) (*gqlTransaction, error) {
+	if args.Index < 0 || int(args.Index) >= len(b.block.Transactions) {
+		return nil, nil
+	}
+
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	index := int(args.Index)
+
+	return &gqlTransaction{r: b.r, tx: b.block.Transactions[index], block: b, index: index}, nil
+}
+
+func (b *gqlBlock) Logs(ctx context.Context, args struct{ Filter gqlBlockFilterCriteria }) ([]*gqlLog, error) {
+	return b.logs(ctx, newGqlLogFilter(args.Filter.Addresses, args.Filter.Topics))
+}
+
+func (b *gqlBlock) Account(ctx context.Context, args struct{ Address gqlAddress }) (*gqlAccount, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return b.r.newAccount(types.Address(args.Address), BlockNumber(b.block.Number())), nil
+}
+
+func (b *gqlBlock) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	return b.r.call(ctx, b.block.Header, args.Data)
+}
+
+func (b *gqlBlock) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (gqlLong, error) {
+	return b.r.estimateGas(ctx, BlockNumber(b.block.Number()), args.Data)
+}
+
+// gqlTransaction resolves a transaction. Pending transactions have no block
+type gqlTransaction struct {
+	r     *graphQLResolver
+	tx    *types.Transaction
+	block *gqlBlock
+	index int
+}
+
+// getReceipt returns the receipt of the transaction, or nil if it's pending
+func (t *gqlTransaction) getReceipt() (*types.Receipt, error) {
+	if t.block == nil {
+		return nil, nil
+	}
+
+	receipts, err := t.block.getReceipts()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.index >= len(receipts) {
+		return nil, nil
+	}
+
+	return receipts[t.index], nil
+}
+
+func (t *gqlTransaction) Hash() gqlBytes32 {
+	return gqlBytes32(t.tx.Hash)
+}
+
+func (t *gqlTransaction) Nonce() gqlLong {
+	return gqlLong(t.tx.Nonce)
+}
+
+func (t *gqlTransaction) Index() *int32 {
+	if t.block == nil {
+		return nil
+	}
+
+	index := int32(t.index)
+
+	return &index
+}
+
+func (t *gqlTransaction) From(ctx context.Context, args struct{ Block *gqlLong }) (*gqlAccount, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return t.r.newAccount(t.tx.From, blockNumberOrLatest(args.Block)), nil
+}
+
+func (t *gqlTransaction) To(ctx context.Context, args struct{ Block *gqlLong }) (*gqlAccount, error) {
+	if t.tx.To == nil {
+		return nil, nil
+	}
+
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return t.r.newAccount(*t.tx.To, blockNumberOrLatest(args.Block)), nil
+}
+
+func (t *gqlTransaction) Value() gqlBigInt {
+	return newGqlBigInt(t.tx.Value)
+}
+
+func (t *gqlTransaction) GasPrice() gqlBigInt {
+	return newGqlBigInt(t.tx.GasPrice)
+}
+
+func (t *gqlTransaction) Gas() gqlLong {
+	return gqlLong(t.tx.Gas)
+}
+
+func (t *gqlTransaction) InputData() gqlBytes {
+	return t.tx.Input
+}
+
+func (t *gqlTransaction) Block() *gqlBlock {
+	return t.block
+}
+
+func (t *gqlTransaction) Status() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.Status == nil {
+		return nil, err
+	}
+
+	status := gqlLong(*receipt.Status)
+
+	return &status, nil
+}
+
+func (t *gqlTransaction) GasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	gasUsed := gqlLong(receipt.GasUsed)
+
+	return &gasUsed, nil
+}
+
+func (t *gqlTransaction) CumulativeGasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	gasUsed := gqlLong(receipt.CumulativeGasUsed)
+
+	return &gasUsed, nil
+}
+
+func (t *gqlTransaction) CreatedContract(ctx context.Context, args struct{ Block *gqlLong }) (*gqlAccount, error) {
+	if t.tx.To != nil {
+		return nil, nil
+	}
+
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return t.r.newAccount(receipt.ContractAddress, blockNumberOrLatest(args.Block)), nil
+}
+
+func (t *gqlTransaction) Logs(ctx context.Context) (*[]*gqlLog, error) {
+	if t.block == nil {
+		return nil, nil
+	}
+
+	receipts, err := t.block.getReceipts()
+	if err != nil || t.index >= len(receipts) {
+		return nil, err
+	}
+
+	// the log index is relative to the block, so the logs of the previous transactions are skipped
+	index := 0
+	for _, receipt := range receipts[:t.index] {
+		index += len(receipt.Logs)
+	}
+
+	logs := make([]*gqlLog, 0, len(receipts[t.index].Logs))
+
+	for _, log := range receipts[t.index].Logs {
+		if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &gqlLog{r: t.r, tx: t, log: log, index: int32(index)})
+		index++
+	}
+
+	return &logs, nil
+}
+
+func (t *gqlTransaction) R() gqlBigInt {
+	return newGqlBigInt(t.tx.R)
+}
+
+func (t *gqlTransaction) S() gqlBigInt {
+	return newGqlBigInt(t.tx.S)
+}
+
+func (t *gqlTransaction) V() gqlBigInt {
+	return newGqlBigInt(t.tx.V)
+}
+
+// gqlLog resolves a log entry
+type gqlLog struct {
+	r     *graphQLResolver
+	tx    *gqlTransaction
+	log   *types.Log
+	index int32
+}
+
+func (l *gqlLog) Index() int32 {
+	return l.index
+}
+
+func (l *gqlLog) Account(ctx context.Context, args struct{ Block *gqlLong }) (*gqlAccount, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return l.r.newAccount(l.log.Address, blockNumberOrLatest(args.Block)), nil
+}
+
+func (l *gqlLog) Topics() []gqlBytes32 {
+	topics := make([]gqlBytes32, 0, len(l.log.Topics))
+	for _, topic := range l.log.Topics {
+		topics = append(topics, gqlBytes32(topic))
+	}
+
+	return topics
+}
+
+func (l *gqlLog) Data() gqlBytes {
+	return l.log.Data
+}
+
+func (l *gqlLog) Transaction() *gqlTransaction {
+	return l.tx
+}
+
+// gqlAccount resolves an account at the state of the given block.
+// The pending accounts use the latest state, and the nonce of the tx pool
+type gqlAccount struct {
+	r       *graphQLResolver
+	address types.Address
+	number  BlockNumber
+}
+
+func (a *gqlAccount) getHeader() (*types.Header, error) {
+	if a.number == PendingBlockNumber {
+		return a.r.d.store.Header(), nil
+	}
+
+	return a.r.d.getBlockHeaderImpl(a.number)
+}
+
+func (a *gqlAccount) Address() gqlAddress {
+	return gqlAddress(a.address)
+}
+
+func (a *gqlAccount) Balance() (gqlBigInt, error) {
+	header, err := a.getHeader()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	acc, err := a.r.d.store.GetAccount(header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBigInt{}, nil
+	} else if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return newGqlBigInt(acc.Balance), nil
+}
+
+func (a *gqlAccount) TransactionCount() (gqlLong, error) {
+	nonce, err := a.r.d.getNextNonce(a.address, a.number)
+	if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(nonce), nil
+}
+
+func (a *gqlAccount) Code() (gqlBytes, error) {
+	header, err := a.getHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := a.r.d.store.GetAccount(header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBytes{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	code, err := a.r.d.store.GetCode(types.BytesToHash(acc.CodeHash))
+	if err != nil {
+		// regular accounts have no code stored under their code hash
+		return gqlBytes{}, nil
+	}
+
+	return code, nil
+}
+
+func (a *gqlAccount) Storage(args struct{ Slot gqlBytes32 }) (gqlBytes32, error) {
+	header, err := a.getHeader()
+	if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	result, err := a.r.d.store.GetStorage(header.StateRoot, a.address, types.Hash(args.Slot))
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBytes32{}, nil
+	} else if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	// Parse the RLP value
+	p := &fastrlp.Parser{}
+
+	v, err := p.Parse(result)
+	if err != nil {
+		return gqlBytes32{}, nil
+	}
+
+	data, err := v.Bytes()
+	if err != nil {
+		return gqlBytes32{}, nil
+	}
+
+	return gqlBytes32(types.BytesToHash(data)), nil
+}
+
+// gqlCallResult is the result of a local call
+type gqlCallResult struct {
+	data    gqlBytes
+	gasUsed gqlLong
+	status  gqlLong
+}
+
+func (c *gqlCallResult) Data() gqlBytes {
+	return c.data
+}
+
+func (c *gqlCallResult) GasUsed() gqlLong {
+	return c.gasUsed
+}
+
+func (c *gqlCallResult) Status() gqlLong {
+	return c.status
+}
+
+// gqlSyncState is the sync progression of the node
+type gqlSyncState struct {
+	startingBlock gqlLong
+	currentBlock  gqlLong
+	highestBlock  gqlLong
+}
+
+func (s *gqlSyncState) StartingBlock() gqlLong {
+	return s.startingBlock
+}
+
+func (s *gqlSyncState) CurrentBlock() gqlLong {
+	return s.currentBlock
+}
+
+func (s *gqlSyncState) HighestBlock() gqlLong {
+	return s.highestBlock
+}
+
+// gqlPending resolves the pending state, made of the executable transactions of the tx pool
+type gqlPending struct {
+	r *graphQLResolver
+}
+
+// pendingTxs returns the executable transactions of the tx pool, ordered by sender and nonce
+func (p *gqlPending) pendingTxs() []*types.Transaction {
+	pending, _ := p.r.d.store.GetTxs(false)
+
+	txs := make([]*types.Transaction, 0)
+	for _, accountTxs := range pending {
+		txs = append(txs, accountTxs...)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].From != txs[j].From {
+			return strings.Compare(txs[i].From.String(), txs[j].From.String()) < 0
+		}
+
+		return txs[i].Nonce < txs[j].Nonce
+	})
+
+	return txs
+}
+
+func (p *gqlPending) TransactionCount() int32 {
+	return int32(len(p.pendingTxs()))
+}
+
+func (p *gqlPending) Transactions(ctx context.Context) (*[]*gqlTransaction, error) {
+	pending := p.pendingTxs()
+
+	if err := chargeComplexity(ctx, uint64(len(pending))*gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	txns := make([]*gqlTransaction, 0, len(pending))
+	for _, txn := range pending {
+		txns = append(txns, &gqlTransaction{r: p.r, tx: txn})
+	}
+
+	return &txns, nil
+}
+
+func (p *gqlPending) Account(ctx context.Context, args struct{ Address gqlAddress }) (*gqlAccount, error) {
+	if err := chargeComplexity(ctx, gqlObjectCost); err != nil {
+		return nil, err
+	}
+
+	return p.r.newAccount(types.Address(args.Address), PendingBlockNumber), nil
+}
+
+func (p *gqlPending) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	return p.r.call(ctx, p.r.d.store.Header(), args.Data)
+}
+
+func (p *gqlPending) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (gqlLong, error) {
+	return p.r.estimateGas(ctx, LatestBlockNumber, args.Data)
+}
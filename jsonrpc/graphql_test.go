@@ -0,0 +1,425 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphQLStore struct {
+	nullBlockchainInterface
+
+	blocks   []*types.Block
+	receipts map[types.Hash][]*types.Receipt
+	accounts map[types.Address]*state.Account
+	txn      *types.Transaction
+}
+
+// newMockGraphQLStore returns a store with a chain of blocks, where
+// every block except the genesis holds one transaction emitting a log
+func newMockGraphQLStore(numBlocks int) *mockGraphQLStore {
+	m := &mockGraphQLStore{
+		receipts: map[types.Hash][]*types.Receipt{},
+		accounts: map[types.Address]*state.Account{},
+	}
+
+	parent := types.ZeroHash
+
+	for i := 0; i < numBlocks; i++ {
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     uint64(i),
+				ParentHash: parent,
+				Miner:      addr1,
+				GasLimit:   1000000,
+				Difficulty: 1,
+			},
+		}
+
+		if i > 0 {
+			txn := &types.Transaction{
+				Nonce:    uint64(i - 1),
+				From:     addr0,
+				To:       &addr1,
+				Value:    big.NewInt(int64(i)),
+				GasPrice: big.NewInt(1),
+				Gas:      21000,
+				V:        big.NewInt(27),
+				R:        big.NewInt(1),
+				S:        big.NewInt(2),
+			}
+			txn.ComputeHash()
+
+			block.Transactions = []*types.Transaction{txn}
+		}
+
+		block.Header.ComputeHash()
+		parent = block.Hash()
+
+		if i > 0 {
+			status := types.ReceiptSuccess
+			m.receipts[block.Hash()] = []*types.Receipt{
+				{
+					Status:            &status,
+					GasUsed:           21000,
+					CumulativeGasUsed: 21000,
+					Logs: []*types.Log{
+						{
+							Address: addr1,
+							Topics:  []types.Hash{hash1},
+							Data:    []byte{byte(i)},
+						},
+					},
+				},
+			}
+		}
+
+		m.blocks = append(m.blocks, block)
+	}
+
+	return m
+}
+
+func (m *mockGraphQLStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
+	return chain.AllForksEnabled.At(blockNumber)
+}
+
+func (m *mockGraphQLStore) Header() *types.Header {
+	return m.blocks[len(m.blocks)-1].Header
+}
+
+func (m *mockGraphQLStore) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	if number >= uint64(len(m.blocks)) {
+		return nil, false
+	}
+
+	return m.blocks[number].Header, true
+}
+
+func (m *mockGraphQLStore) GetBlockByNumber(number uint64, full bool) (*types.Block, bool) {
+	if number >= uint64(len(m.blocks)) {
+		return nil, false
+	}
+
+	return m.blocks[number], true
+}
+
+func (m *mockGraphQLStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	for _, b := range m.blocks {
+		if b.Hash() == hash {
+			return b, true
+		}
+	}
+
+	return nil, false
+}
+
+func (m *mockGraphQLStore) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
+	for _, b := range m.blocks {
+		for _, txn := range b.Transactions {
+			if txn.Hash == hash {
+				return b.Hash(), true
+			}
+		}
+	}
+
+	return types.ZeroHash, false
+}
+
+func (m *mockGraphQLStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return m.receipts[hash], nil
+}
+
+func (m *mockGraphQLStore) GetTD(hash types.Hash) (*big.Int, bool) {
+	block, ok := m.GetBlockByHash(hash, false)
+	if !ok {
+		return nil, false
+	}
+
+	return new(big.Int).SetUint64(block.Number() + 1), true
+}
+
+func (m *mockGraphQLStore) GetAccount(root types.Hash, addr types.Address) (*state.Account, error) {
+	acc, ok := m.accounts[addr]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return acc, nil
+}
+
+func (m *mockGraphQLStore) AddTx(tx *types.Transaction) error {
+	m.txn = tx
+
+	return nil
+}
+
+func (m *mockGraphQLStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	_ types.StateOverride,
+	_ *types.BlockOverride,
+) (*runtime.ExecutionResult, error) {
+	return &runtime.ExecutionResult{
+		ReturnValue: []byte{0x1, 0x2},
+		GasUsed:     100,
+	}, nil
+}
+
+func newTestGraphQL(t *testing.T, store blockchainInterface, config *GraphQLConfig) *GraphQL {
+	t.Helper()
+
+	if config == nil {
+		config = &GraphQLConfig{}
+	}
+
+	config.Store = store
+	config.ChainID = testChainID
+
+	srv, err := newGraphQL(hclog.NewNullLogger(), config)
+	assert.NoError(t, err)
+
+	return srv
+}
+
+// execGraphQL executes the query and decodes the response data
+func execGraphQL(t *testing.T, srv *GraphQL, query string, variables map[string]interface{}) (interface{}, error) {
+	t.Helper()
+
+	resp := srv.exec(context.Background(), &graphQLRequest{Query: query, Variables: variables})
+	if len(resp.Errors) != 0 {
+		return nil, resp.Errors[0]
+	}
+
+	var data interface{}
+	assert.NoError(t, json.Unmarshal(resp.Data, &data))
+
+	return data, nil
+}
+
+func TestGraphQL_Block(t *testing.T) {
+	store := newMockGraphQLStore(5)
+	srv := newTestGraphQL(t, store, nil)
+
+	data, err := execGraphQL(t, srv, `{
+		block(number: 2) {
+			number
+			hash
+			parent { number }
+			miner { address }
+			gasLimit
+			difficulty
+			totalDifficulty
+			transactionCount
+			transactions { nonce value from { address } to { address } status gasUsed index }
+		}
+	}`, nil)
+	assert.NoError(t, err)
+
+	block := store.blocks[2]
+	assert.Equal(t, map[string]interface{}{
+		"block": map[string]interface{}{
+			"number":           float64(2),
+			"hash":             block.Hash().String(),
+			"parent":           map[string]interface{}{"number": float64(1)},
+			"miner":            map[string]interface{}{"address": addr1.String()},
+			"gasLimit":         float64(1000000),
+			"difficulty":       "0x1",
+			"totalDifficulty":  "0x3",
+			"transactionCount": float64(1),
+			"transactions": []interface{}{
+				map[string]interface{}{
+					"nonce":   float64(1),
+					"value":   "0x2",
+					"from":    map[string]interface{}{"address": addr0.String()},
+					"to":      map[string]interface{}{"address": addr1.String()},
+					"status":  float64(1),
+					"gasUsed": float64(21000),
+					"index":   float64(0),
+				},
+			},
+		},
+	}, data)
+
+	// the latest block is returned by default, and the hash can be used instead of the number
+	data, err = execGraphQL(t, srv, `query($hash: Bytes32) { latest: block { number } byHash: block(hash: $hash) { number } }`,
+		map[string]interface{}{"hash": block.Hash().String()},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"latest": map[string]interface{}{"number": float64(4)},
+		"byHash": map[string]interface{}{"number": float64(2)},
+	}, data)
+
+	// the number and the hash can't be used together
+	_, err = execGraphQL(t, srv, `query($hash: Bytes32) { block(number: 1, hash: $hash) { number } }`,
+		map[string]interface{}{"hash": block.Hash().String()},
+	)
+	assert.Error(t, err)
+}
+
+func TestGraphQL_BlocksAndLogs(t *testing.T) {
+	store := newMockGraphQLStore(5)
+	srv := newTestGraphQL(t, store, nil)
+
+	data, err := execGraphQL(t, srv, `{ blocks(from: "0x1", to: 3) { number } }`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"blocks": []interface{}{
+			map[string]interface{}{"number": float64(1)},
+			map[string]interface{}{"number": float64(2)},
+			map[string]interface{}{"number": float64(3)},
+		},
+	}, data)
+
+	data, err = execGraphQL(t, srv, `query($topic: Bytes32!) {
+		logs(filter: { fromBlock: 3, topics: [[$topic]] }) { index data transaction { nonce } }
+	}`, map[string]interface{}{"topic": hash1.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"logs": []interface{}{
+			map[string]interface{}{"index": float64(0), "data": "0x03", "transaction": map[string]interface{}{"nonce": float64(2)}},
+			map[string]interface{}{"index": float64(0), "data": "0x04", "transaction": map[string]interface{}{"nonce": float64(3)}},
+		},
+	}, data)
+
+	// logs not matching the address filter are skipped
+	data, err = execGraphQL(t, srv, `query($addr: Address!) { block(number: 1) { logs(filter: { addresses: [$addr] }) { data } } }`,
+		map[string]interface{}{"addr": addr0.String()},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"block": map[string]interface{}{"logs": []interface{}{}},
+	}, data)
+}
+
+func TestGraphQL_Account(t *testing.T) {
+	store := newMockGraphQLStore(2)
+	store.accounts[addr0] = &state.Account{Balance: big.NewInt(100), Nonce: 5}
+
+	srv := newTestGraphQL(t, store, nil)
+
+	data, err := execGraphQL(t, srv, `query($addr: Address!, $empty: Address!) {
+		block { account(address: $addr) { balance transactionCount code } }
+		empty: block { account(address: $empty) { balance transactionCount } }
+	}`, map[string]interface{}{"addr": addr0.String(), "empty": addr2.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"block": map[string]interface{}{
+			"account": map[string]interface{}{"balance": "0x64", "transactionCount": float64(5), "code": "0x"},
+		},
+		"empty": map[string]interface{}{
+			"account": map[string]interface{}{"balance": "0x0", "transactionCount": float64(0)},
+		},
+	}, data)
+}
+
+func TestGraphQL_Call(t *testing.T) {
+	store := newMockGraphQLStore(2)
+	srv := newTestGraphQL(t, store, nil)
+
+	data, err := execGraphQL(t, srv, `query($to: Address!) { block { call(data: { to: $to, value: "100" }) { data gasUsed status } } }`,
+		map[string]interface{}{"to": addr1.String()},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"block": map[string]interface{}{
+			"call": map[string]interface{}{"data": "0x0102", "gasUsed": float64(100), "status": float64(1)},
+		},
+	}, data)
+}
+
+func TestGraphQL_SendRawTransaction(t *testing.T) {
+	store := newMockGraphQLStore(1)
+	srv := newTestGraphQL(t, store, nil)
+
+	txn := &types.Transaction{
+		From:     addr0,
+		To:       &addr1,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(1),
+		V:        big.NewInt(1),
+	}
+	txn.ComputeHash()
+
+	data, err := execGraphQL(t, srv, `mutation($data: Bytes!) { sendRawTransaction(data: $data) }`,
+		map[string]interface{}{"data": hex.EncodeToHex(txn.MarshalRLP())},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"sendRawTransaction": txn.Hash.String()}, data)
+	assert.Equal(t, txn.Hash, store.txn.Hash)
+}
+
+func TestGraphQL_Limits(t *testing.T) {
+	store := newMockGraphQLStore(10)
+
+	t.Run("depth", func(t *testing.T) {
+		srv := newTestGraphQL(t, store, &GraphQLConfig{MaxDepth: 3})
+
+		_, err := execGraphQL(t, srv, `{ block { parent { number } } }`, nil)
+		assert.NoError(t, err)
+
+		_, err = execGraphQL(t, srv, `{ block { parent { parent { parent { number } } } } }`, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("complexity", func(t *testing.T) {
+		srv := newTestGraphQL(t, store, &GraphQLConfig{MaxComplexity: 5})
+
+		_, err := execGraphQL(t, srv, `{ blocks(from: 0, to: 4) { number } }`, nil)
+		assert.NoError(t, err)
+
+		// the range is charged upfront
+		_, err = execGraphQL(t, srv, `{ blocks(from: 0, to: 5) { number } }`, nil)
+		assert.ErrorIs(t, err, errGraphQLComplexityLimit)
+
+		// the nested objects are charged as well
+		_, err = execGraphQL(t, srv, `{ blocks(from: 0, to: 2) { transactions { from { address } } } }`, nil)
+		assert.ErrorIs(t, err, errGraphQLComplexityLimit)
+
+		// the budget is per query
+		_, err = execGraphQL(t, srv, `{ blocks(from: 0, to: 4) { number } }`, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func TestGraphQL_Scalars(t *testing.T) {
+	var l gqlLong
+
+	assert.NoError(t, l.UnmarshalGraphQL("0x10"))
+	assert.Equal(t, gqlLong(16), l)
+
+	assert.NoError(t, l.UnmarshalGraphQL("10"))
+	assert.Equal(t, gqlLong(10), l)
+
+	assert.NoError(t, l.UnmarshalGraphQL(float64(7)))
+	assert.Equal(t, gqlLong(7), l)
+
+	assert.Error(t, l.UnmarshalGraphQL(int32(-1)))
+	assert.Error(t, l.UnmarshalGraphQL(float64(1.5)))
+
+	var b gqlBigInt
+
+	assert.NoError(t, b.UnmarshalGraphQL("0xff"))
+	assert.Equal(t, int64(255), b.toBig().Int64())
+
+	assert.NoError(t, b.UnmarshalGraphQL("1000000000000000000000"))
+	assert.Equal(t, "1000000000000000000000", b.toBig().String())
+
+	assert.Error(t, b.UnmarshalGraphQL("0xzz"))
+
+	var bytes gqlBytes
+
+	assert.NoError(t, bytes.UnmarshalGraphQL("0x0102"))
+	assert.Equal(t, gqlBytes{0x1, 0x2}, bytes)
+	assert.Error(t, bytes.UnmarshalGraphQL(int32(1)))
+}
@@ -0,0 +1,203 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// The scalar types of the EIP-1767 GraphQL schema
+
+// gqlBytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal
+type gqlBytes32 types.Hash
+
+func (gqlBytes32) ImplementsGraphQLType(name string) bool {
+	return name == "Bytes32"
+}
+
+func (b *gqlBytes32) UnmarshalGraphQL(input interface{}) error {
+	str, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Bytes32", input)
+	}
+
+	return (*types.Hash)(b).UnmarshalText([]byte(str))
+}
+
+func (b gqlBytes32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Hash(b).String())
+}
+
+// gqlAddress is a 20 byte Ethereum address, represented as 0x-prefixed hexadecimal
+type gqlAddress types.Address
+
+func (gqlAddress) ImplementsGraphQLType(name string) bool {
+	return name == "Address"
+}
+
+func (a *gqlAddress) UnmarshalGraphQL(input interface{}) error {
+	str, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Address", input)
+	}
+
+	return (*types.Address)(a).UnmarshalText([]byte(str))
+}
+
+func (a gqlAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Address(a).String())
+}
+
+// gqlBytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal
+type gqlBytes []byte
+
+func (gqlBytes) ImplementsGraphQLType(name string) bool {
+	return name == "Bytes"
+}
+
+func (b *gqlBytes) UnmarshalGraphQL(input interface{}) error {
+	str, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Bytes", input)
+	}
+
+	buf, err := hex.DecodeHex(str)
+	if err != nil {
+		return err
+	}
+
+	*b = buf
+
+	return nil
+}
+
+func (b gqlBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToHex(b))
+}
+
+// gqlBigInt is a large integer, represented as 0x-prefixed hexadecimal.
+// Input is accepted as either hexadecimal or decimal
+type gqlBigInt big.Int
+
+func newGqlBigInt(b *big.Int) gqlBigInt {
+	if b == nil {
+		return gqlBigInt{}
+	}
+
+	return gqlBigInt(*b)
+}
+
+func (gqlBigInt) ImplementsGraphQLType(name string) bool {
+	return name == "BigInt"
+}
+
+func (b *gqlBigInt) UnmarshalGraphQL(input interface{}) error {
+	var value *big.Int
+
+	switch input := input.(type) {
+	case string:
+		var ok bool
+
+		if strings.HasPrefix(input, "0x") {
+			value, ok = new(big.Int).SetString(strings.TrimPrefix(input, "0x"), 16)
+		} else {
+			value, ok = new(big.Int).SetString(input, 10)
+		}
+
+		if !ok {
+			return fmt.Errorf("invalid BigInt %s", input)
+		}
+	case int32:
+		value = big.NewInt(int64(input))
+	case float64:
+		if input != float64(int64(input)) {
+			return fmt.Errorf("invalid value %v for BigInt", input)
+		}
+
+		value = big.NewInt(int64(input))
+	default:
+		return fmt.Errorf("unexpected type %T for BigInt", input)
+	}
+
+	*b = gqlBigInt(*value)
+
+	return nil
+}
+
+func (b gqlBigInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + (*big.Int)(&b).Text(16))
+}
+
+func (b *gqlBigInt) toBig() *big.Int {
+	return (*big.Int)(b)
+}
+
+// gqlLong is a 64 bit unsigned integer, represented as a number.
+// Input is accepted as either a number, hexadecimal or decimal string
+type gqlLong uint64
+
+func (gqlLong) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *gqlLong) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		var (
+			value uint64
+			err   error
+		)
+
+		if strings.HasPrefix(input, "0x") {
+			value, err = strconv.ParseUint(strings.TrimPrefix(input, "0x"), 16, 64)
+		} else {
+			value, err = strconv.ParseUint(input, 10, 64)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		*l = gqlLong(value)
+	case int32:
+		if input < 0 {
+			return fmt.Errorf("negative value %d for Long", input)
+		}
+
+		*l = gqlLong(input)
+	case int64:
+		if input < 0 {
+			return fmt.Errorf("negative value %d for Long", input)
+		}
+
+		*l = gqlLong(input)
+	case float64:
+		if input < 0 || input != float64(uint64(input)) {
+			return fmt.Errorf("invalid value %v for Long", input)
+		}
+
+		*l = gqlLong(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+
+	return nil
+}
+
+func (l gqlLong) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint64(l))
+}
+
+// blockNumberOrLatest converts the optional block number argument into a BlockNumber
+func blockNumberOrLatest(number *gqlLong) BlockNumber {
+	if number == nil {
+		return LatestBlockNumber
+	}
+
+	return BlockNumber(*number)
+}
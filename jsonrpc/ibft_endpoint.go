@@ -0,0 +1,156 @@
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Ibft is the ibft jsonrpc endpoint, used for managing the validator set.
+// It wraps the gRPC IBFT operator service
+type Ibft struct {
+	d *Dispatcher
+}
+
+type ibftSnapshot struct {
+	Number     argUint64           `json:"number"`
+	Hash       types.Hash          `json:"hash"`
+	Validators []types.Address     `json:"validators"`
+	Votes      []*ibftSnapshotVote `json:"votes"`
+}
+
+type ibftSnapshotVote struct {
+	Validator types.Address `json:"validator"`
+	Proposed  types.Address `json:"proposed"`
+	Auth      bool          `json:"auth"`
+}
+
+type ibftCandidate struct {
+	Address types.Address `json:"address"`
+	Auth    bool          `json:"auth"`
+}
+
+type ibftStatus struct {
+	ValidatorKey types.Address `json:"validatorKey"`
+}
+
+// operator returns the IBFT operator service, once the call to the given method is authorized
+func (i *Ibft) operator(method string) (ibftOp.IbftOperatorServer, error) {
+	if err := i.d.store.Authorize(method); err != nil {
+		return nil, err
+	}
+
+	return i.d.store.IbftOperator(), nil
+}
+
+// GetSnapshot returns the IBFT snapshot at the given block number, or the latest one
+func (i *Ibft) GetSnapshot(number *BlockNumber) (interface{}, error) {
+	req := &ibftOp.SnapshotReq{
+		Latest: true,
+	}
+
+	if number != nil && *number != LatestBlockNumber {
+		if *number == PendingBlockNumber {
+			return nil, fmt.Errorf("the snapshot of the pending block is not available")
+		}
+
+		req.Latest = false
+
+		if *number != EarliestBlockNumber {
+			req.Number = uint64(*number)
+		}
+	}
+
+	operator, err := i.operator("/v1.IbftOperator/GetSnapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := operator.GetSnapshot(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ibftSnapshot{
+		Number:     argUint64(snap.Number),
+		Hash:       types.StringToHash(snap.Hash),
+		Validators: make([]types.Address, 0, len(snap.Validators)),
+		Votes:      make([]*ibftSnapshotVote, 0, len(snap.Votes)),
+	}
+
+	for _, validator := range snap.Validators {
+		res.Validators = append(res.Validators, types.StringToAddress(validator.Address))
+	}
+
+	for _, vote := range snap.Votes {
+		res.Votes = append(res.Votes, &ibftSnapshotVote{
+			Validator: types.StringToAddress(vote.Validator),
+			Proposed:  types.StringToAddress(vote.Proposed),
+			Auth:      vote.Auth,
+		})
+	}
+
+	return res, nil
+}
+
+// Candidates returns the validator candidates proposed by the node
+func (i *Ibft) Candidates() (interface{}, error) {
+	operator, err := i.operator("/v1.IbftOperator/Candidates")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := operator.Candidates(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*ibftCandidate, 0, len(resp.Candidates))
+	for _, candidate := range resp.Candidates {
+		candidates = append(candidates, &ibftCandidate{
+			Address: types.StringToAddress(candidate.Address),
+			Auth:    candidate.Auth,
+		})
+	}
+
+	return candidates, nil
+}
+
+// Propose proposes a new candidate to be added to (auth) or removed from the validator set
+func (i *Ibft) Propose(address types.Address, auth bool) (interface{}, error) {
+	req := &ibftOp.Candidate{
+		Address: address.String(),
+		Auth:    auth,
+	}
+
+	operator, err := i.operator("/v1.IbftOperator/Propose")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := operator.Propose(context.Background(), req); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// Status returns the status of the IBFT client
+func (i *Ibft) Status() (interface{}, error) {
+	operator, err := i.operator("/v1.IbftOperator/Status")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := operator.Status(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibftStatus{
+		ValidatorKey: types.StringToAddress(resp.Key),
+	}, nil
+}
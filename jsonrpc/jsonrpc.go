@@ -1,10 +1,12 @@
 package jsonrpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -37,6 +39,11 @@ type JSONRPC struct {
 	logger     hclog.Logger
 	config     *Config
 	dispatcher dispatcherImpl
+
+	// restricted rejects the browser requests from origins not allowed
+	// and the requests through a DNS rebinding, as the node accounts or operations are exposed
+	restricted     bool
+	allowedOrigins map[string]struct{}
 }
 
 type dispatcherImpl interface {
@@ -48,14 +55,53 @@ type Config struct {
 	Store   blockchainInterface
 	Addr    *net.TCPAddr
 	ChainID uint64
+
+	// TLSConfig serves HTTPS and WSS, if set
+	TLSConfig *tls.Config
+
+	// EnablePersonal exposes the personal namespace for managing the node accounts.
+	// It is never exposed on listeners that are not bound to a loopback address
+	EnablePersonal bool
+
+	// EnableAdmin exposes the admin and ibft namespaces for managing the node.
+	// They are never exposed on listeners that are not bound to a loopback address
+	EnableAdmin bool
+
+	// AllowedOrigins are the origins (scheme://host:port) of the browser requests
+	// allowed once the personal or admin namespaces are exposed. The other cross-origin requests are rejected
+	AllowedOrigins []string
 }
 
 // NewJSONRPC returns the JsonRPC http server
 func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
+	enablePersonal, enableAdmin := config.EnablePersonal, config.EnableAdmin
+	if (enablePersonal || enableAdmin) && !isLoopbackAddr(config.Addr) {
+		logger.Named("jsonrpc").Warn(
+			"personal and admin namespaces are disabled, as the listener is not bound to a loopback address",
+			"addr", config.Addr.String(),
+		)
+
+		enablePersonal, enableAdmin = false, false
+	}
+
 	srv := &JSONRPC{
-		logger:     logger.Named("jsonrpc"),
-		config:     config,
-		dispatcher: newDispatcher(logger, config.Store, config.ChainID),
+		logger: logger.Named("jsonrpc"),
+		config: config,
+		dispatcher: newDispatcher(
+			logger,
+			config.Store,
+			&dispatcherParams{
+				chainID:        config.ChainID,
+				enablePersonal: enablePersonal,
+				enableAdmin:    enableAdmin,
+			},
+		),
+		restricted:     enablePersonal || enableAdmin,
+		allowedOrigins: make(map[string]struct{}, len(config.AllowedOrigins)),
+	}
+
+	for _, origin := range config.AllowedOrigins {
+		srv.allowedOrigins[strings.ToLower(origin)] = struct{}{}
 	}
 
 	// start http server
@@ -66,6 +112,58 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 	return srv, nil
 }
 
+// isLoopbackAddr returns a flag indicating if the address is reachable only from the local host
+func isLoopbackAddr(addr *net.TCPAddr) bool {
+	return addr != nil && addr.IP != nil && addr.IP.IsLoopback()
+}
+
+// isLoopbackHost returns a flag indicating if the host (host[:port]) of a request names the local host.
+// Any other name resolving to a loopback address is a DNS rebinding
+func isLoopbackHost(host string) bool {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// isAllowedOrigin returns a flag indicating if the requests from the given origin are served.
+// The requests without an origin are not sent by a browser script
+func (j *JSONRPC) isAllowedOrigin(origin string) bool {
+	if !j.restricted || origin == "" {
+		return true
+	}
+
+	_, ok := j.allowedOrigins[strings.ToLower(origin)]
+
+	return ok
+}
+
+// checkRequest returns an error if the request must be rejected,
+// as it was sent by a browser script from an origin not allowed or through a DNS rebinding
+func (j *JSONRPC) checkRequest(req *http.Request) error {
+	if !j.restricted {
+		return nil
+	}
+
+	if !isLoopbackHost(req.Host) {
+		return fmt.Errorf("host %s not allowed", req.Host)
+	}
+
+	if origin := req.Header.Get("Origin"); !j.isAllowedOrigin(origin) {
+		return fmt.Errorf("origin %s not allowed", origin)
+	}
+
+	return nil
+}
+
 func (j *JSONRPC) setupHTTP() error {
 	j.logger.Info("http server started", "addr", j.config.Addr.String())
 
@@ -74,6 +172,10 @@ func (j *JSONRPC) setupHTTP() error {
 		return err
 	}
 
+	if j.config.TLSConfig != nil {
+		lis = tls.NewListener(lis, j.config.TLSConfig)
+	}
+
 	mux := http.DefaultServeMux
 	mux.HandleFunc("/", j.handle)
 	mux.HandleFunc("/ws", j.handleWs)
@@ -133,11 +235,20 @@ func isSupportedWSType(messageType int) bool {
 }
 
 func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
-	// CORS rule - Allow requests from anywhere
-	wsUpgrader.CheckOrigin = func(r *http.Request) bool { return true }
+	if err := j.checkRequest(req); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	// CORS rule - Allow requests from anywhere, unless restricted
+	upgrader := wsUpgrader
+	upgrader.CheckOrigin = func(r *http.Request) bool {
+		return j.isAllowedOrigin(r.Header.Get("Origin"))
+	}
 
 	// Upgrade the connection to a WS one
-	ws, err := wsUpgrader.Upgrade(w, req, nil)
+	ws, err := upgrader.Upgrade(w, req, nil)
 	if err != nil {
 		j.logger.Error(fmt.Sprintf("Unable to upgrade to a WS connection, %s", err.Error()))
 
@@ -196,8 +307,22 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 }
 
 func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
+	if err := j.checkRequest(req); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if !j.restricted {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else if origin := req.Header.Get("Origin"); origin != "" {
+		// the allowed origins only, checked above
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Vary", "Origin")
+	}
+
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set(
 		"Access-Control-Allow-Headers",
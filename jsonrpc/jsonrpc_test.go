@@ -1,11 +1,16 @@
 package jsonrpc
 
 import (
-	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestHTTPServer(t *testing.T) {
@@ -26,3 +31,88 @@ func TestHTTPServer(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+type mockDispatcher struct{}
+
+func (mockDispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
+	return reqBody, nil
+}
+
+func (mockDispatcher) Handle(reqBody []byte) ([]byte, error) {
+	return reqBody, nil
+}
+
+func TestHTTPServer_Restricted(t *testing.T) {
+	newServer := func(restricted bool) *JSONRPC {
+		return &JSONRPC{
+			logger:     hclog.NewNullLogger(),
+			config:     &Config{},
+			dispatcher: mockDispatcher{},
+			restricted: restricted,
+			allowedOrigins: map[string]struct{}{
+				"http://localhost:3000": {},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		restricted bool
+		host       string
+		origin     string
+		status     int
+		allowed    string
+	}{
+		{"not restricted, any origin", false, "node.example.com:8545", "https://evil.example.com", http.StatusOK, "*"},
+		{"no origin", true, "127.0.0.1:8545", "", http.StatusOK, ""},
+		{"allowed origin", true, "localhost:8545", "http://localhost:3000", http.StatusOK, "http://localhost:3000"},
+		{"ipv6 loopback host", true, "[::1]:8545", "", http.StatusOK, ""},
+		{"cross origin", true, "127.0.0.1:8545", "https://evil.example.com", http.StatusForbidden, ""},
+		{"dns rebinding", true, "evil.example.com:8545", "http://evil.example.com:8545", http.StatusForbidden, ""},
+		{"dns rebinding without origin", true, "evil.example.com:8545", "", http.StatusForbidden, ""},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"personal_listAccounts"}`))
+			req.Host = testCase.host
+
+			if testCase.origin != "" {
+				req.Header.Set("Origin", testCase.origin)
+			}
+
+			rec := httptest.NewRecorder()
+			newServer(testCase.restricted).handle(rec, req)
+
+			assert.Equal(t, testCase.status, rec.Code)
+			assert.Equal(t, testCase.allowed, rec.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
+
+func TestWSServer_Restricted(t *testing.T) {
+	j := &JSONRPC{
+		logger:         hclog.NewNullLogger(),
+		config:         &Config{},
+		dispatcher:     mockDispatcher{},
+		restricted:     true,
+		allowedOrigins: map[string]struct{}{},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(j.handleWs))
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	// the browser scripts from other origins are rejected
+	_, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": []string{"https://evil.example.com"}})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// the local clients are served
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.Close())
+}
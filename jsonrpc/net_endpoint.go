@@ -9,7 +9,7 @@ type Net struct {
 
 // Version returns the current network id
 func (n *Net) Version() (interface{}, error) {
-	return strconv.FormatUint(n.d.chainID, 10), nil
+	return strconv.FormatUint(n.d.params.chainID, 10), nil
 }
 
 // Listening returns true if client is actively listening for network connections
@@ -0,0 +1,132 @@
+package jsonrpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// defaultUnlockDuration is the duration in seconds for which an account is unlocked,
+// if the duration is not specified
+const defaultUnlockDuration = 300
+
+// maxUnlockDuration is the maximum duration in seconds for which an account can be unlocked
+const maxUnlockDuration = 24 * 60 * 60
+
+// Personal is the personal jsonrpc endpoint, used for managing the node accounts
+type Personal struct {
+	d *Dispatcher
+}
+
+// NewAccount creates a new account, encrypted with the passphrase
+func (p *Personal) NewAccount(passphrase string) (interface{}, error) {
+	address, err := p.d.store.NewAccount(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// ListAccounts returns the addresses of the accounts managed by the node
+func (p *Personal) ListAccounts() (interface{}, error) {
+	accounts := p.d.store.Accounts()
+	if accounts == nil {
+		accounts = []types.Address{}
+	}
+
+	return accounts, nil
+}
+
+// UnlockAccount unlocks the account for the duration in seconds.
+// If the duration is omitted, the default duration is used. Accounts are never
+// unlocked indefinitely, the duration is bounded by maxUnlockDuration
+func (p *Personal) UnlockAccount(address types.Address, passphrase string, duration *uint64) (interface{}, error) {
+	seconds := uint64(defaultUnlockDuration)
+	if duration != nil {
+		seconds = *duration
+	}
+
+	if seconds == 0 || seconds > maxUnlockDuration {
+		return nil, fmt.Errorf("unlock duration must be between 1 and %d seconds", maxUnlockDuration)
+	}
+
+	if err := p.d.store.UnlockAccount(address, passphrase, time.Duration(seconds)*time.Second); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// LockAccount locks the account
+func (p *Personal) LockAccount(address types.Address) (interface{}, error) {
+	if err := p.d.store.LockAccount(address); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// SendTransaction signs the transaction with the key of the sender account,
+// decrypted with the passphrase, and submits it to the tx pool
+func (p *Personal) SendTransaction(arg *txnArgs, passphrase string) (interface{}, error) {
+	if arg.From == nil {
+		return nil, fmt.Errorf("from not specified")
+	}
+
+	transaction, err := p.d.decodeTxn(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := p.d.store.SignTxWithPassphrase(transaction.From, passphrase, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx.ComputeHash()
+
+	if err := p.d.store.AddTx(signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx.Hash.String(), nil
+}
+
+// Sign calculates an Ethereum specific signature of the data
+// with the key of the account, decrypted with the passphrase
+func (p *Personal) Sign(data argBytes, address types.Address, passphrase string) (interface{}, error) {
+	signature, err := p.d.store.SignHashWithPassphrase(address, passphrase, textHash(data))
+	if err != nil {
+		return nil, err
+	}
+
+	// The recovery id is returned in the legacy [27, 28] range
+	signature[64] += 27
+
+	return argBytesPtr(signature), nil
+}
+
+// EcRecover returns the address of the account that created the signature of the data
+func (p *Personal) EcRecover(data argBytes, signature argBytes) (interface{}, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("signature must be 65 bytes long")
+	}
+
+	if signature[64] != 27 && signature[64] != 28 {
+		return nil, fmt.Errorf("invalid signature recovery id, expected 27 or 28")
+	}
+
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	sig[64] -= 27
+
+	pub, err := crypto.SigToPub(textHash(data), sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.PubKeyToAddress(pub), nil
+}
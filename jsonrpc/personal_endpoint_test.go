@@ -0,0 +1,212 @@
+package jsonrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/accounts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+const testChainID = 100
+
+type mockKeystoreStore struct {
+	mockStoreTxn
+	keystore *accounts.Keystore
+}
+
+func newMockKeystoreStore(t *testing.T) *mockKeystoreStore {
+	t.Helper()
+
+	keystore, err := accounts.NewKeystore(t.TempDir(), accounts.LightScryptN, accounts.LightScryptP)
+	assert.NoError(t, err)
+
+	return &mockKeystoreStore{keystore: keystore}
+}
+
+func (m *mockKeystoreStore) Accounts() []types.Address {
+	return m.keystore.Accounts()
+}
+
+func (m *mockKeystoreStore) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	return m.keystore.SignHash(addr, hash)
+}
+
+func (m *mockKeystoreStore) SignTx(addr types.Address, tx *types.Transaction) (*types.Transaction, error) {
+	return m.keystore.SignTx(addr, tx, crypto.NewEIP155Signer(testChainID))
+}
+
+func (m *mockKeystoreStore) NewAccount(passphrase string) (types.Address, error) {
+	return m.keystore.NewAccount(passphrase)
+}
+
+func (m *mockKeystoreStore) UnlockAccount(addr types.Address, passphrase string, duration time.Duration) error {
+	return m.keystore.Unlock(addr, passphrase, duration)
+}
+
+func (m *mockKeystoreStore) LockAccount(addr types.Address) error {
+	return m.keystore.Lock(addr)
+}
+
+func (m *mockKeystoreStore) SignHashWithPassphrase(
+	addr types.Address,
+	passphrase string,
+	hash []byte,
+) ([]byte, error) {
+	return m.keystore.SignHashWithPassphrase(addr, passphrase, hash)
+}
+
+func (m *mockKeystoreStore) SignTxWithPassphrase(
+	addr types.Address,
+	passphrase string,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	return m.keystore.SignTxWithPassphrase(addr, passphrase, tx, crypto.NewEIP155Signer(testChainID))
+}
+
+func TestPersonal_DisabledByDefault(t *testing.T) {
+	d := newDispatcher(hclog.NewNullLogger(), nil, &dispatcherParams{})
+
+	_, _, err := d.getFnHandler(Request{Method: "personal_listAccounts"})
+	assert.Error(t, err)
+
+	d = newDispatcher(hclog.NewNullLogger(), nil, &dispatcherParams{enablePersonal: true})
+
+	_, _, err = d.getFnHandler(Request{Method: "personal_listAccounts"})
+	assert.Nil(t, err)
+}
+
+func TestPersonal_NewAccountUnlock(t *testing.T) {
+	store := newMockKeystoreStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+	personal := dispatcher.endpoints.Personal
+
+	res, err := personal.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	address, ok := res.(types.Address)
+	assert.True(t, ok)
+
+	res, err = personal.ListAccounts()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{address}, res)
+
+	// wrong passphrase
+	_, err = personal.UnlockAccount(address, "wrong", nil)
+	assert.ErrorIs(t, err, accounts.ErrDecrypt)
+
+	// the account cannot be unlocked indefinitely
+	for _, duration := range []uint64{0, maxUnlockDuration + 1} {
+		duration := duration
+
+		_, err = personal.UnlockAccount(address, "passphrase", &duration)
+		assert.Error(t, err)
+		assert.False(t, store.keystore.IsUnlocked(address))
+	}
+
+	// the default duration is used
+	res, err = personal.UnlockAccount(address, "passphrase", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.True(t, store.keystore.IsUnlocked(address))
+
+	res, err = personal.LockAccount(address)
+	assert.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.False(t, store.keystore.IsUnlocked(address))
+}
+
+func TestPersonal_SignEcRecover(t *testing.T) {
+	store := newMockKeystoreStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+	personal := dispatcher.endpoints.Personal
+
+	address, err := store.keystore.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	data := argBytes("hello world")
+
+	_, err = personal.Sign(data, address, "wrong")
+	assert.ErrorIs(t, err, accounts.ErrDecrypt)
+
+	res, err := personal.Sign(data, address, "passphrase")
+	assert.NoError(t, err)
+
+	signature := *res.(*argBytes)
+
+	res, err = personal.EcRecover(data, signature)
+	assert.NoError(t, err)
+	assert.Equal(t, address, res)
+
+	// the signature is not altered by the recovery
+	assert.True(t, signature[64] == 27 || signature[64] == 28)
+
+	_, err = personal.EcRecover(data, signature[:64])
+	assert.Error(t, err)
+}
+
+func TestPersonal_SendTransaction(t *testing.T) {
+	store := newMockKeystoreStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+	personal := dispatcher.endpoints.Personal
+
+	address, err := store.keystore.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	arg := &txnArgs{
+		From:     argAddrPtr(address),
+		To:       argAddrPtr(addr0),
+		Nonce:    argUintPtr(0),
+		Gas:      argUintPtr(21000),
+		GasPrice: argBytesPtr([]byte{0x1}),
+	}
+
+	_, err = personal.SendTransaction(arg, "wrong")
+	assert.ErrorIs(t, err, accounts.ErrDecrypt)
+
+	res, err := personal.SendTransaction(arg, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, store.txn.Hash.String(), res)
+
+	sender, err := crypto.NewEIP155Signer(testChainID).Sender(store.txn)
+	assert.NoError(t, err)
+	assert.Equal(t, address, sender)
+
+	// the account is not left unlocked
+	assert.False(t, store.keystore.IsUnlocked(address))
+}
+
+func TestEth_SendTransaction_UnlockedAccount(t *testing.T) {
+	store := newMockKeystoreStore(t)
+	dispatcher := newTestDispatcher(hclog.NewNullLogger(), store)
+
+	address, err := store.keystore.NewAccount("passphrase")
+	assert.NoError(t, err)
+
+	newArg := func() *txnArgs {
+		return &txnArgs{
+			From:     argAddrPtr(address),
+			To:       argAddrPtr(addr0),
+			Nonce:    argUintPtr(0),
+			Gas:      argUintPtr(21000),
+			GasPrice: argBytesPtr([]byte{0x1}),
+		}
+	}
+
+	// node-managed accounts need to be unlocked
+	_, err = dispatcher.endpoints.Eth.SendTransaction(newArg())
+	assert.ErrorIs(t, err, accounts.ErrLocked)
+
+	assert.NoError(t, store.keystore.Unlock(address, "passphrase", 0))
+
+	res, err := dispatcher.endpoints.Eth.SendTransaction(newArg())
+	assert.NoError(t, err)
+	assert.Equal(t, store.txn.Hash.String(), res)
+
+	sender, err := crypto.NewEIP155Signer(testChainID).Sender(store.txn)
+	assert.NoError(t, err)
+	assert.Equal(t, address, sender)
+}
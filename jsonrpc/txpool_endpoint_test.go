@@ -8,7 +8,7 @@ import (
 )
 
 func TestContentEndpoint(t *testing.T) {
-	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	s.registerEndpoints()
 
 	resp, err := s.Handle([]byte(`{
@@ -25,7 +25,7 @@ func TestContentEndpoint(t *testing.T) {
 }
 
 func TestInspectEndpoint(t *testing.T) {
-	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	s.registerEndpoints()
 
 	resp, err := s.Handle([]byte(`{
@@ -44,7 +44,7 @@ func TestInspectEndpoint(t *testing.T) {
 }
 
 func TestStatusEndpoint(t *testing.T) {
-	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	s := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	s.registerEndpoints()
 
 	resp, err := s.Handle([]byte(`{
@@ -29,6 +29,10 @@ type transaction struct {
 	BlockHash   *types.Hash    `json:"blockHash"`
 	BlockNumber *argUint64     `json:"blockNumber"`
 	TxIndex     *argUint64     `json:"transactionIndex"`
+	FeePayer    *types.Address `json:"feePayer,omitempty"`
+	FeePayerV   *argBig        `json:"feePayerV,omitempty"`
+	FeePayerR   *argBig        `json:"feePayerR,omitempty"`
+	FeePayerS   *argBig        `json:"feePayerS,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -78,6 +82,16 @@ func toTransaction(
 		res.TxIndex = argUintPtr(uint64(*txIndex))
 	}
 
+	if t.IsFeeDelegated() {
+		res.FeePayer = t.FeePayer
+
+		if t.FeePayerV != nil && t.FeePayerR != nil && t.FeePayerS != nil {
+			res.FeePayerV = argBigPtr(t.FeePayerV)
+			res.FeePayerR = argBigPtr(t.FeePayerR)
+			res.FeePayerS = argBigPtr(t.FeePayerS)
+		}
+	}
+
 	return res
 }
 
@@ -169,6 +183,7 @@ type receipt struct {
 	ContractAddress   types.Address  `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	FeePayer          *types.Address `json:"feePayer,omitempty"`
 }
 
 type Log struct {
@@ -302,6 +317,37 @@ type txnArgs struct {
 	Input    *argBytes
 	Data     *argBytes
 	Nonce    *argUint64
+
+	// the fee payer signs fee delegated transactions
+	// before they can be simulated
+	FeePayer  *types.Address
+	FeePayerV *argBig
+	FeePayerR *argBig
+	FeePayerS *argBig
+}
+
+// privateTxnArgs are the arguments of eth_sendPrivateTransaction
+type privateTxnArgs struct {
+	Tx             *argBytes  `json:"tx"`
+	MaxBlockNumber *argUint64 `json:"maxBlockNumber"`
+}
+
+// bundleArgs are the arguments of eth_sendBundle
+type bundleArgs struct {
+	Txs            []argBytes `json:"txs"`
+	MinBlockNumber *argUint64 `json:"minBlockNumber"`
+	MaxBlockNumber *argUint64 `json:"maxBlockNumber"`
+}
+
+// bundleResult is the result of eth_sendBundle
+type bundleResult struct {
+	BundleHash types.Hash `json:"bundleHash"`
+}
+
+// signTransactionResult is the result of the transaction signing rpc endpoints
+type signTransactionResult struct {
+	Raw argBytes     `json:"raw"`
+	Tx  *transaction `json:"tx"`
 }
 
 type progression struct {
@@ -309,3 +355,70 @@ type progression struct {
 	CurrentBlock  string `json:"currentBlock"`
 	HighestBlock  string `json:"highestBlock"`
 }
+
+// overrideAccount is the account override argument for the rpc endpoints
+type overrideAccount struct {
+	Nonce     *argUint64                `json:"nonce"`
+	Code      *argBytes                 `json:"code"`
+	Balance   *argBig                   `json:"balance"`
+	State     map[types.Hash]types.Hash `json:"state"`
+	StateDiff map[types.Hash]types.Hash `json:"stateDiff"`
+}
+
+// stateOverride is the set of account overrides for the simulation rpc endpoints
+type stateOverride map[types.Address]overrideAccount
+
+// toStateOverride converts the rpc argument into the executor state override
+func (s *stateOverride) toStateOverride() types.StateOverride {
+	if s == nil {
+		return nil
+	}
+
+	result := make(types.StateOverride, len(*s))
+
+	for addr, arg := range *s {
+		account := types.OverrideAccount{
+			State:     arg.State,
+			StateDiff: arg.StateDiff,
+		}
+
+		if arg.Nonce != nil {
+			nonce := uint64(*arg.Nonce)
+			account.Nonce = &nonce
+		}
+
+		if arg.Code != nil {
+			account.Code = *arg.Code
+		}
+
+		if arg.Balance != nil {
+			account.Balance = new(big.Int).Set((*big.Int)(arg.Balance))
+		}
+
+		result[addr] = account
+	}
+
+	return result
+}
+
+// blockOverride is the block environment override argument for the simulation rpc endpoints
+type blockOverride struct {
+	Number   *argUint64     `json:"number"`
+	Time     *argUint64     `json:"time"`
+	GasLimit *argUint64     `json:"gasLimit"`
+	Coinbase *types.Address `json:"coinbase"`
+}
+
+// toBlockOverride converts the rpc argument into the executor block override
+func (b *blockOverride) toBlockOverride() *types.BlockOverride {
+	if b == nil {
+		return nil
+	}
+
+	return &types.BlockOverride{
+		Number:    (*uint64)(b.Number),
+		Timestamp: (*uint64)(b.Time),
+		GasLimit:  (*uint64)(b.GasLimit),
+		Coinbase:  b.Coinbase,
+	}
+}
@@ -125,3 +125,91 @@ func TestToTransaction_Returns_V_R_S_ValuesWithoutLeading0(t *testing.T) {
 	assert.Equal(t, hexWithoutLeading0, string(jsonR))
 	assert.Equal(t, hexWithoutLeading0, string(jsonS))
 }
+
+func TestToTransaction_FeeDelegated(t *testing.T) {
+	feePayer := types.StringToAddress("2")
+
+	txn := types.Transaction{
+		GasPrice: big.NewInt(0),
+		Value:    big.NewInt(0),
+		V:        big.NewInt(1),
+		R:        big.NewInt(2),
+		S:        big.NewInt(3),
+	}
+
+	// the fee payer fields are omitted for regular transactions
+	raw, err := json.Marshal(toTransaction(&txn, nil, nil, nil))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "feePayer")
+
+	txn.FeePayer = &feePayer
+	txn.FeePayerV = big.NewInt(4)
+	txn.FeePayerR = big.NewInt(5)
+	txn.FeePayerS = big.NewInt(6)
+
+	raw, err = json.Marshal(toTransaction(&txn, nil, nil, nil))
+	assert.NoError(t, err)
+
+	var res map[string]interface{}
+	assert.NoError(t, json.Unmarshal(raw, &res))
+
+	assert.Equal(t, feePayer.String(), res["feePayer"])
+	assert.Equal(t, "0x4", res["feePayerV"])
+	assert.Equal(t, "0x5", res["feePayerR"])
+	assert.Equal(t, "0x6", res["feePayerS"])
+}
+
+func TestDecode_StateOverride(t *testing.T) {
+	var (
+		addr  = types.StringToAddress("1")
+		slot  = types.StringToHash("2")
+		value = types.StringToHash("3")
+	)
+
+	data := `{
+		"` + addr.String() + `": {
+			"nonce": "0x5",
+			"balance": "0x64",
+			"code": "0x6001",
+			"stateDiff": {
+				"` + slot.String() + `": "` + value.String() + `"
+			}
+		}
+	}`
+
+	override := &stateOverride{}
+	assert.NoError(t, json.Unmarshal([]byte(data), override))
+
+	res := override.toStateOverride()
+	assert.Len(t, res, 1)
+
+	account := res[addr]
+	assert.Equal(t, uint64(5), *account.Nonce)
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, []byte{0x60, 0x01}, account.Code)
+	assert.Nil(t, account.State)
+	assert.Equal(t, map[types.Hash]types.Hash{slot: value}, account.StateDiff)
+}
+
+func TestDecode_BlockOverride(t *testing.T) {
+	coinbase := types.StringToAddress("1")
+
+	data := `{
+		"number": "0x10",
+		"time": "0x20",
+		"coinbase": "` + coinbase.String() + `"
+	}`
+
+	override := &blockOverride{}
+	assert.NoError(t, json.Unmarshal([]byte(data), override))
+
+	res := override.toBlockOverride()
+	assert.Equal(t, uint64(16), *res.Number)
+	assert.Equal(t, uint64(32), *res.Timestamp)
+	assert.Nil(t, res.GasLimit)
+	assert.Equal(t, coinbase, *res.Coinbase)
+
+	// a missing override is passed down as nil
+	var empty *blockOverride
+	assert.Nil(t, empty.toBlockOverride())
+}
@@ -0,0 +1,304 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Sources of the allowed peers
+const (
+	// AllowSourceConfig is the source of the peers allowed by the config file
+	AllowSourceConfig = "config"
+
+	// AllowSourceRuntime is the source of the peers allowed at runtime
+	AllowSourceRuntime = "runtime"
+
+	// AllowSourceRegistry is the source of the peers held by the on-chain node registry
+	AllowSourceRegistry = "registry"
+
+	// AllowSourceBootnode is the source of the bootnodes of the chain, which are always
+	// allowed so that a node syncing from genesis can reach the nodes registered later
+	AllowSourceBootnode = "bootnode"
+)
+
+// allowedPeersFile is the name of the file the peers allowed at runtime are kept in
+const allowedPeersFile = "allowed_peers.json"
+
+var (
+	ErrAllowlistDisabled = errors.New("the peer allowlist is disabled")
+	ErrPeerNotAllowed    = errors.New("peer is not allowed at runtime")
+)
+
+// AllowedPeer is a peer which connections are accepted when the allowlist is enabled
+type AllowedPeer struct {
+	ID     peer.ID
+	Source string
+}
+
+// allowlist keeps the peers allowed to connect to the node, merged from the config,
+// the runtime updates (persisted to the given path, unless empty), the node registry
+// and the bootnodes
+type allowlist struct {
+	lock    sync.RWMutex
+	enabled bool
+	path    string
+
+	config    map[peer.ID]struct{}
+	runtime   map[peer.ID]struct{}
+	registry  map[peer.ID]struct{}
+	bootnodes map[peer.ID]struct{}
+}
+
+func newAllowlist(enabled bool, path string, config []peer.ID) *allowlist {
+	a := &allowlist{
+		enabled:   enabled,
+		path:      path,
+		config:    make(map[peer.ID]struct{}, len(config)),
+		runtime:   map[peer.ID]struct{}{},
+		registry:  map[peer.ID]struct{}{},
+		bootnodes: map[peer.ID]struct{}{},
+	}
+
+	for _, id := range config {
+		a.config[id] = struct{}{}
+	}
+
+	return a
+}
+
+// load reads the peers allowed at runtime from the disk
+func (a *allowlist) load() error {
+	if a.path == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(a.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var ids []peer.ID
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for _, id := range ids {
+		a.runtime[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// save writes the peers allowed at runtime to the disk. [not thread-safe]
+func (a *allowlist) save() error {
+	if a.path == "" {
+		return nil
+	}
+
+	ids := make([]peer.ID, 0, len(a.runtime))
+	for id := range a.runtime {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(a.path+".new", data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(a.path+".new", a.path)
+}
+
+// allow allows the peer at runtime. [thread-safe]
+func (a *allowlist) allow(id peer.ID) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if !a.enabled {
+		return ErrAllowlistDisabled
+	}
+
+	a.runtime[id] = struct{}{}
+
+	return a.save()
+}
+
+// disallow removes the peer allowed at runtime. [thread-safe]
+func (a *allowlist) disallow(id peer.ID) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if !a.enabled {
+		return ErrAllowlistDisabled
+	}
+
+	if _, ok := a.runtime[id]; !ok {
+		return ErrPeerNotAllowed
+	}
+
+	delete(a.runtime, id)
+
+	return a.save()
+}
+
+// setRegistry replaces the peers held by the node registry. [thread-safe]
+func (a *allowlist) setRegistry(ids []peer.ID) {
+	registry := make(map[peer.ID]struct{}, len(ids))
+	for _, id := range ids {
+		registry[id] = struct{}{}
+	}
+
+	a.lock.Lock()
+	a.registry = registry
+	a.lock.Unlock()
+}
+
+// setBootnodes replaces the allowed bootnodes. [thread-safe]
+func (a *allowlist) setBootnodes(ids []peer.ID) {
+	bootnodes := make(map[peer.ID]struct{}, len(ids))
+	for _, id := range ids {
+		bootnodes[id] = struct{}{}
+	}
+
+	a.lock.Lock()
+	a.bootnodes = bootnodes
+	a.lock.Unlock()
+}
+
+// isAllowed returns true if the peer is allowed, or if the allowlist is disabled. [thread-safe]
+func (a *allowlist) isAllowed(id peer.ID) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if !a.enabled {
+		return true
+	}
+
+	for _, set := range []map[peer.ID]struct{}{a.config, a.runtime, a.registry, a.bootnodes} {
+		if _, ok := set[id]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// list returns the allowed peers, with the peers held
+// by several sources listed once per source. [thread-safe]
+func (a *allowlist) list() []*AllowedPeer {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	allowed := make([]*AllowedPeer, 0, len(a.config)+len(a.runtime)+len(a.registry)+len(a.bootnodes))
+
+	for source, set := range map[string]map[peer.ID]struct{}{
+		AllowSourceConfig:   a.config,
+		AllowSourceRuntime:  a.runtime,
+		AllowSourceRegistry: a.registry,
+		AllowSourceBootnode: a.bootnodes,
+	} {
+		for id := range set {
+			allowed = append(allowed, &AllowedPeer{
+				ID:     id,
+				Source: source,
+			})
+		}
+	}
+
+	sort.Slice(allowed, func(i, j int) bool {
+		if allowed[i].ID != allowed[j].ID {
+			return allowed[i].ID < allowed[j].ID
+		}
+
+		return allowed[i].Source < allowed[j].Source
+	})
+
+	return allowed
+}
+
+// allowedPeersPath returns the path of the allowed peers file in the data dir
+func allowedPeersPath(dataDir string) string {
+	if dataDir == "" {
+		return ""
+	}
+
+	return filepath.Join(dataDir, allowedPeersFile)
+}
+
+// AllowlistEnabled returns true if only the allowed peers can connect to the node
+func (s *Server) AllowlistEnabled() bool {
+	return s.allowlist.enabled
+}
+
+// IsAllowed returns true if the peer is allowed to connect to the node
+func (s *Server) IsAllowed(id peer.ID) bool {
+	return id == s.host.ID() || s.allowlist.isAllowed(id)
+}
+
+// AllowedPeers returns the peers allowed to connect to the node
+func (s *Server) AllowedPeers() []*AllowedPeer {
+	return s.allowlist.list()
+}
+
+// AllowPeer allows the peer to connect to the node
+func (s *Server) AllowPeer(id peer.ID) error {
+	if err := s.allowlist.allow(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer allowed", "id", id)
+
+	return nil
+}
+
+// DisallowPeer removes the peer allowed at runtime, disconnecting
+// from it unless it is still allowed by another source
+func (s *Server) DisallowPeer(id peer.ID) error {
+	if err := s.allowlist.disallow(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer disallowed", "id", id)
+
+	s.disconnectDisallowed()
+
+	return nil
+}
+
+// SetRegistryPeers replaces the peers allowed by the on-chain node registry,
+// disconnecting from the peers which are no longer allowed
+func (s *Server) SetRegistryPeers(ids []peer.ID) {
+	s.allowlist.setRegistry(ids)
+
+	s.logger.Debug("registry peers updated", "count", len(ids))
+
+	s.disconnectDisallowed()
+}
+
+// disconnectDisallowed disconnects from the connected peers which are not allowed anymore
+func (s *Server) disconnectDisallowed() {
+	for _, p := range s.Peers() {
+		if !s.IsAllowed(p.Info.ID) {
+			s.Disconnect(p.Info.ID, "not allowed")
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowlist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), allowedPeersFile)
+	a, b, c := newTestPeerID(t), newTestPeerID(t), newTestPeerID(t)
+
+	// all the peers are allowed when disabled
+	disabled := newAllowlist(false, path, nil)
+	assert.True(t, disabled.isAllowed(a))
+	assert.ErrorIs(t, disabled.allow(a), ErrAllowlistDisabled)
+
+	l := newAllowlist(true, path, []peer.ID{a})
+	assert.NoError(t, l.load())
+
+	assert.True(t, l.isAllowed(a))
+	assert.False(t, l.isAllowed(b))
+	assert.False(t, l.isAllowed(c))
+
+	assert.NoError(t, l.allow(b))
+	l.setRegistry([]peer.ID{c})
+
+	assert.True(t, l.isAllowed(b))
+	assert.True(t, l.isAllowed(c))
+
+	// only the peers allowed at runtime can be disallowed
+	assert.ErrorIs(t, l.disallow(a), ErrPeerNotAllowed)
+	assert.ErrorIs(t, l.disallow(c), ErrPeerNotAllowed)
+
+	// the peers allowed at runtime are persisted
+	loaded := newAllowlist(true, path, nil)
+	assert.NoError(t, loaded.load())
+
+	allowed := loaded.list()
+	assert.Len(t, allowed, 1)
+	assert.Equal(t, b, allowed[0].ID)
+	assert.Equal(t, AllowSourceRuntime, allowed[0].Source)
+
+	assert.NoError(t, loaded.disallow(b))
+	assert.False(t, loaded.isAllowed(b))
+
+	reloaded := newAllowlist(true, path, nil)
+	assert.NoError(t, reloaded.load())
+	assert.Empty(t, reloaded.list())
+
+	// the peers are listed once per source
+	assert.NoError(t, l.disallow(b))
+	l.setRegistry([]peer.ID{a})
+
+	allowed = l.list()
+	assert.Len(t, allowed, 2)
+	assert.Equal(t, a, allowed[0].ID)
+	assert.Equal(t, AllowSourceConfig, allowed[0].Source)
+	assert.Equal(t, AllowSourceRegistry, allowed[1].Source)
+
+	// the bootnodes are allowed
+	assert.False(t, l.isAllowed(c))
+	l.setBootnodes([]peer.ID{c})
+	assert.True(t, l.isAllowed(c))
+}
+
+func TestServer_Allowlist(t *testing.T) {
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {
+			ConfigCallback: func(c *Config) {
+				c.Allowlist = true
+			},
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	id := servers[1].AddrInfo().ID
+
+	// the peers missing from the allowlist can't connect, nor be dialed
+	assert.Error(t, JoinAndWait(servers[1], servers[0], 5*time.Second, 5*time.Second))
+	assert.Error(t, JoinAndWait(servers[0], servers[1], 5*time.Second, 5*time.Second))
+	assert.False(t, servers[0].hasPeer(id))
+
+	// unless allowed
+	assert.NoError(t, servers[0].AllowPeer(id))
+	assert.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	// the peer is disconnected once disallowed
+	assert.NoError(t, servers[0].DisallowPeer(id))
+
+	disconnectCtx, cancelFn := context.WithTimeout(context.Background(), DefaultLeaveTimeout)
+	defer cancelFn()
+
+	_, disconnectErr := WaitUntilPeerDisconnectsFrom(disconnectCtx, servers[0], id)
+	assert.NoError(t, disconnectErr)
+
+	// the peers of the node registry are allowed too
+	servers[0].SetRegistryPeers([]peer.ID{id})
+	assert.True(t, servers[0].IsAllowed(id))
+
+	servers[0].SetRegistryPeers(nil)
+	assert.False(t, servers[0].IsAllowed(id))
+	assert.True(t, servers[0].IsAllowed(servers[0].AddrInfo().ID))
+}
@@ -2,9 +2,22 @@ package network
 
 import (
 	"container/heap"
+	"crypto/rand"
+	"math/big"
 	"sync"
+	"time"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// dialBackoffBase is the back-off of an address after its first failed dial,
+	// doubled on every following failure up to dialBackoffMax
+	dialBackoffBase = 5 * time.Second
+	dialBackoffMax  = 10 * time.Minute
+
+	// dialBackoffJitter is the maximum jitter added to a back-off, in percent
+	dialBackoffJitter = 20
 )
 
 // dialQueue is a queue where we store all the possible peer targets that
@@ -13,15 +26,30 @@ type dialQueue struct {
 	heap     dialQueueImpl
 	lock     sync.Mutex
 	items    map[peer.ID]*dialTask
+	backoffs map[dialBackoffKey]*dialBackoff
 	updateCh chan struct{}
 	closeCh  chan struct{}
 }
 
+// dialBackoffKey identifies an address of a peer. The peers dialed
+// without any address are backed off with an empty address
+type dialBackoffKey struct {
+	id   peer.ID
+	addr string
+}
+
+// dialBackoff is the back-off of an address which dials failed
+type dialBackoff struct {
+	failures uint
+	until    time.Time
+}
+
 // newDialQueue creates a new DialQueue
 func newDialQueue() *dialQueue {
 	return &dialQueue{
 		heap:     dialQueueImpl{},
 		items:    map[peer.ID]*dialTask{},
+		backoffs: map[dialBackoffKey]*dialBackoff{},
 		updateCh: make(chan struct{}),
 		closeCh:  make(chan struct{}),
 	}
@@ -78,10 +106,18 @@ func (d *dialQueue) del(peer peer.ID) {
 	}
 }
 
-func (d *dialQueue) add(addr *peer.AddrInfo, priority uint64) {
+// add adds the peer to the queue with its addresses which are not backed off after
+// failed dials, unless all of them are. The dials requested by the operator are never backed off
+func (d *dialQueue) add(addr *peer.AddrInfo, priority uint64) bool {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
+	if priority != PriorityRequestedDial {
+		if addr = d.dialableAddrs(addr); addr == nil {
+			return false
+		}
+	}
+
 	task := &dialTask{
 		addr:     addr,
 		priority: priority,
@@ -93,6 +129,104 @@ func (d *dialQueue) add(addr *peer.AddrInfo, priority uint64) {
 	case d.updateCh <- struct{}{}:
 	default:
 	}
+
+	return true
+}
+
+// dialableAddrs returns the peer with only its addresses which are not backed off,
+// or nil if all of them are. [not thread-safe]
+func (d *dialQueue) dialableAddrs(addr *peer.AddrInfo) *peer.AddrInfo {
+	now := time.Now()
+	isBackedOff := func(key dialBackoffKey) bool {
+		b, ok := d.backoffs[key]
+
+		return ok && now.Before(b.until)
+	}
+
+	if len(addr.Addrs) == 0 {
+		if isBackedOff(dialBackoffKey{id: addr.ID}) {
+			return nil
+		}
+
+		return addr
+	}
+
+	dialable := &peer.AddrInfo{ID: addr.ID}
+
+	for _, a := range addr.Addrs {
+		if !isBackedOff(dialBackoffKey{id: addr.ID, addr: a.String()}) {
+			dialable.Addrs = append(dialable.Addrs, a)
+		}
+	}
+
+	if len(dialable.Addrs) == 0 {
+		return nil
+	}
+
+	return dialable
+}
+
+// failed backs off the dialed addresses of the peer, exponentially to
+// the number of consecutive failures, returning the longest back-off
+func (d *dialQueue) failed(addr *peer.AddrInfo) time.Duration {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	var longest time.Duration
+
+	for _, key := range backoffKeys(addr) {
+		b, ok := d.backoffs[key]
+		if !ok {
+			b = &dialBackoff{}
+			d.backoffs[key] = b
+		}
+
+		// the failures stop being counted once the back-off reaches
+		// its maximum, so that the shift never overflows
+		backoff := dialBackoffBase << b.failures
+		if backoff >= dialBackoffMax {
+			backoff = dialBackoffMax
+		} else {
+			b.failures++
+		}
+
+		// the jitter spreads the redials of the addresses failing together
+		if jitter, err := rand.Int(rand.Reader, big.NewInt(int64(backoff)*dialBackoffJitter/100+1)); err == nil {
+			backoff += time.Duration(jitter.Int64())
+		}
+
+		b.until = time.Now().Add(backoff)
+
+		if backoff > longest {
+			longest = backoff
+		}
+	}
+
+	return longest
+}
+
+// succeeded resets the back-off of the dialed addresses of the peer after a successful dial
+func (d *dialQueue) succeeded(addr *peer.AddrInfo) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, key := range backoffKeys(addr) {
+		delete(d.backoffs, key)
+	}
+}
+
+// backoffKeys returns the keys of the back-offs of the addresses of the peer
+func backoffKeys(addr *peer.AddrInfo) []dialBackoffKey {
+	if len(addr.Addrs) == 0 {
+		return []dialBackoffKey{{id: addr.ID}}
+	}
+
+	keys := make([]dialBackoffKey, 0, len(addr.Addrs))
+	for _, a := range addr.Addrs {
+		keys = append(keys, dialBackoffKey{id: addr.ID, addr: a.String()})
+	}
+
+	return keys
 }
 
 type dialTask struct {
@@ -4,7 +4,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -167,3 +168,57 @@ func TestDel(t *testing.T) {
 		})
 	}
 }
+
+func TestDialQueue_Backoff(t *testing.T) {
+	q := newDialQueue()
+	info := &peer.AddrInfo{
+		ID: peer.ID("a"),
+	}
+
+	// the back-off grows exponentially, with a jitter
+	first := q.failed(info)
+	assert.GreaterOrEqual(t, int64(first), int64(dialBackoffBase))
+	assert.LessOrEqual(t, int64(first), int64(dialBackoffBase)*(100+dialBackoffJitter)/100)
+
+	second := q.failed(info)
+	assert.GreaterOrEqual(t, int64(second), int64(2*dialBackoffBase))
+
+	// and stops growing at the maximum, far past the failures overflowing the shift
+	for i := 0; i < 100; i++ {
+		backoff := q.failed(info)
+		assert.Greater(t, int64(backoff), int64(0))
+		assert.LessOrEqual(t, int64(backoff), int64(dialBackoffMax)*(100+dialBackoffJitter)/100)
+	}
+
+	assert.GreaterOrEqual(t, int64(q.failed(info)), int64(dialBackoffMax))
+
+	// the backed off peer is not queued, unless requested by the operator
+	assert.False(t, q.add(info, PriorityRandomDial))
+	assert.Equal(t, 0, q.heap.Len())
+
+	assert.True(t, q.add(info, PriorityRequestedDial))
+	assert.Equal(t, 1, q.heap.Len())
+
+	// the back-off is reset once dialed
+	q.succeeded(info)
+	assert.True(t, q.add(info, PriorityRandomDial))
+}
+
+func TestDialQueue_BackoffPerAddress(t *testing.T) {
+	q := newDialQueue()
+
+	addr0 := multiaddr.StringCast("/ip4/127.0.0.1/tcp/1478")
+	addr1 := multiaddr.StringCast("/ip4/127.0.0.2/tcp/1478")
+
+	q.failed(&peer.AddrInfo{ID: peer.ID("a"), Addrs: []multiaddr.Multiaddr{addr0}})
+
+	// the failed address is backed off
+	assert.False(t, q.add(&peer.AddrInfo{ID: peer.ID("a"), Addrs: []multiaddr.Multiaddr{addr0}}, PriorityRandomDial))
+
+	// but not the other addresses of the peer, nor the same address of other peers
+	assert.True(t, q.add(&peer.AddrInfo{ID: peer.ID("a"), Addrs: []multiaddr.Multiaddr{addr0, addr1}}, PriorityRandomDial))
+	assert.Equal(t, []multiaddr.Multiaddr{addr1}, q.pop().addr.Addrs)
+
+	assert.True(t, q.add(&peer.AddrInfo{ID: peer.ID("b"), Addrs: []multiaddr.Multiaddr{addr0}}, PriorityRandomDial))
+	assert.Equal(t, peer.ID("b"), q.pop().addr.ID)
+}
@@ -8,11 +8,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
 	"github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/network/proto"
-	"github.com/libp2p/go-libp2p-core/peer"
-	peerstore "github.com/libp2p/go-libp2p-core/peerstore"
 	kb "github.com/libp2p/go-libp2p-kbucket"
+	"github.com/libp2p/go-libp2p/core/peer"
+	peerstore "github.com/libp2p/go-libp2p/core/peerstore"
 	rawGrpc "google.golang.org/grpc"
 )
 
@@ -20,6 +21,14 @@ var discProto = "/disc/0.1"
 
 const defaultBucketSize = 20
 
+const (
+	// dnsResolveTimeout is the timeout of the resolution of a DNS tree
+	dnsResolveTimeout = 30 * time.Second
+)
+
+// dnsRefreshInterval is the interval the bootnodes of the DNS trees are resolved again at
+var dnsRefreshInterval = 30 * time.Minute
+
 type referencePeer struct {
 	id     peer.ID
 	stream interface{}
@@ -90,13 +99,28 @@ type discovery struct {
 	notifyCh chan struct{}
 	closeCh  chan struct{}
 
-	bootnodes []*peer.AddrInfo
+	bootnodesLock sync.RWMutex
+	bootnodes     []*peer.AddrInfo
+
+	// bootnodes listed as multiaddrs, and the ones resolved from the DNS trees by URL
+	staticBootnodes []*peer.AddrInfo
+	dnsBootnodes    map[string][]*peer.AddrInfo
+	dnsClient       *dnsdisc.Client
 }
 
-func (d *discovery) setup(bootnodes []*peer.AddrInfo) error {
+func (d *discovery) setup(bootnodes []*peer.AddrInfo, dnsTrees []string) error {
 	d.notifyCh = make(chan struct{}, 5)
 	d.peers = &referencePeers{}
-	d.bootnodes = bootnodes
+	d.staticBootnodes = bootnodes
+	d.dnsBootnodes = make(map[string][]*peer.AddrInfo, len(dnsTrees))
+	d.dnsClient = dnsdisc.NewClient(d.srv.config.DNSResolver, d.srv.logger)
+
+	for _, url := range dnsTrees {
+		d.dnsBootnodes[url] = nil
+	}
+
+	// the DNS trees are resolved before the table is set up with the bootnodes
+	d.resolveDNSBootnodes()
 
 	keyID := kb.ConvertPeerID(d.srv.host.ID())
 
@@ -133,6 +157,11 @@ func (d *discovery) setup(bootnodes []*peer.AddrInfo) error {
 		peerID := evnt.PeerID
 		switch evnt.Type {
 		case PeerConnected:
+			if d.srv.isPrivate(peerID) {
+				// the private peers are never advertised, nor queried
+				return
+			}
+
 			// add peer to the routing table and to our local peer
 			_, err := d.routingTable.TryAddPeer(peerID, false, false)
 			if err != nil {
@@ -154,6 +183,10 @@ func (d *discovery) setup(bootnodes []*peer.AddrInfo) error {
 
 	go d.setupTable()
 
+	if len(dnsTrees) != 0 {
+		go d.refreshDNSBootnodes()
+	}
+
 	return nil
 }
 
@@ -171,13 +204,121 @@ func (d *discovery) addToTable(node *peer.AddrInfo) error {
 }
 
 func (d *discovery) setupTable() {
-	for _, node := range d.bootnodes {
+	for _, node := range d.getBootnodes() {
 		if err := d.addToTable(node); err != nil {
 			d.srv.logger.Error("Failed to add new peer to routing table", "peer", node.ID, "err", err)
 		}
 	}
 }
 
+// getBootnodes returns the bootnodes, including the ones resolved from the DNS trees
+func (d *discovery) getBootnodes() []*peer.AddrInfo {
+	d.bootnodesLock.RLock()
+	defer d.bootnodesLock.RUnlock()
+
+	return d.bootnodes
+}
+
+// randomBootnode returns a random bootnode, or nil if there are none
+func (d *discovery) randomBootnode() *peer.AddrInfo {
+	bootnodes := d.getBootnodes()
+	if len(bootnodes) == 0 {
+		return nil
+	}
+
+	randNum, _ := rand.Int(rand.Reader, big.NewInt(int64(len(bootnodes))))
+
+	return bootnodes[randNum.Int64()]
+}
+
+// resolveDNSBootnodes resolves the nodes of the DNS trees, merging them with the static bootnodes.
+// The nodes of a tree which fails to resolve are kept from its previous resolution.
+// It returns the bootnodes which were not known before
+func (d *discovery) resolveDNSBootnodes() []*peer.AddrInfo {
+	for url := range d.dnsBootnodes {
+		ctx, cancelFn := context.WithTimeout(context.Background(), dnsResolveTimeout)
+		addrs, err := d.dnsClient.Resolve(ctx, url)
+
+		cancelFn()
+
+		if err != nil {
+			d.srv.logger.Error("failed to resolve DNS tree", "url", url, "err", err)
+
+			continue
+		}
+
+		nodes := make([]*peer.AddrInfo, 0, len(addrs))
+
+		for _, addr := range addrs {
+			node, err := StringToAddrInfo(addr)
+			if err != nil {
+				d.srv.logger.Error("invalid node in DNS tree", "url", url, "addr", addr, "err", err)
+
+				continue
+			}
+
+			nodes = append(nodes, node)
+		}
+
+		d.srv.logger.Debug("DNS tree resolved", "url", url, "nodes", len(nodes))
+		d.dnsBootnodes[url] = nodes
+	}
+
+	d.bootnodesLock.Lock()
+	defer d.bootnodesLock.Unlock()
+
+	known := make(map[peer.ID]struct{}, len(d.bootnodes))
+	for _, node := range d.bootnodes {
+		known[node.ID] = struct{}{}
+	}
+
+	bootnodes := append([]*peer.AddrInfo{}, d.staticBootnodes...)
+	seen := make(map[peer.ID]struct{}, len(bootnodes))
+
+	for _, node := range bootnodes {
+		seen[node.ID] = struct{}{}
+	}
+
+	added := []*peer.AddrInfo{}
+
+	for _, nodes := range d.dnsBootnodes {
+		for _, node := range nodes {
+			if _, ok := seen[node.ID]; ok || node.ID == d.srv.host.ID() {
+				continue
+			}
+
+			seen[node.ID] = struct{}{}
+			bootnodes = append(bootnodes, node)
+
+			if _, ok := known[node.ID]; !ok {
+				added = append(added, node)
+			}
+		}
+	}
+
+	d.bootnodes = bootnodes
+
+	return added
+}
+
+// refreshDNSBootnodes periodically resolves the DNS trees again,
+// adding the new bootnodes to the routing table
+func (d *discovery) refreshDNSBootnodes() {
+	for {
+		select {
+		case <-time.After(dnsRefreshInterval):
+		case <-d.srv.closeCh:
+			return
+		}
+
+		for _, node := range d.resolveDNSBootnodes() {
+			if err := d.addToTable(node); err != nil {
+				d.srv.logger.Error("Failed to add new peer to routing table", "peer", node.ID, "err", err)
+			}
+		}
+	}
+}
+
 func (d *discovery) attemptToFindPeers(peerID peer.ID) error {
 	d.srv.logger.Debug("Querying a peer for near peers", "peer", peerID)
 	nodes, err := d.findPeersCall(peerID)
@@ -189,6 +330,11 @@ func (d *discovery) attemptToFindPeers(peerID peer.ID) error {
 	d.srv.logger.Debug("Found new near peers", "peer", len(nodes))
 
 	for _, node := range nodes {
+		if !d.srv.IsAllowed(node.ID) {
+			// the peer connections would be refused
+			continue
+		}
+
 		if err := d.addToTable(node); err != nil {
 			return err
 		}
@@ -287,8 +433,8 @@ func (d *discovery) FindPeers(
 	filtered := []string{}
 
 	for _, id := range closer {
-		// do not include himself
-		if id != from {
+		// do not include himself, nor the peers which are not allowed or private
+		if id != from && d.srv.IsAllowed(id) && !d.srv.isPrivate(id) {
 			info := d.srv.host.Peerstore().PeerInfo(id)
 			filtered = append(filtered, AddrInfoToString(&info))
 		}
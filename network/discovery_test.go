@@ -2,9 +2,14 @@ package network
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -243,3 +248,91 @@ func TestDiscovery_FullNetwork(t *testing.T) {
 		assert.Len(t, server.host.Peerstore().Peers(), 3)
 	}
 }
+
+// stubResolver serves the TXT records of the DNS trees from memory
+type stubResolver struct {
+	lock    sync.Mutex
+	records map[string]string
+}
+
+func (s *stubResolver) publish(t *testing.T, key *ecdsa.PrivateKey, domain string, seq uint64, nodes []*Server) string {
+	t.Helper()
+
+	addrs := make([]string, len(nodes))
+	for i, node := range nodes {
+		addrs[i] = AddrInfoToString(node.AddrInfo())
+	}
+
+	tree, err := dnsdisc.MakeTree(seq, addrs, nil)
+	assert.NoError(t, err)
+
+	url, err := tree.Sign(key, domain)
+	assert.NoError(t, err)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.records = tree.Records(domain)
+
+	return url
+}
+
+func (s *stubResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[name]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", name)
+	}
+
+	return []string{record}, nil
+}
+
+func TestDiscovery_DNSBootnodes(t *testing.T) {
+	defaultInterval := dnsRefreshInterval
+	dnsRefreshInterval = time.Second
+
+	t.Cleanup(func() {
+		dnsRefreshInterval = defaultInterval
+	})
+
+	bootnodes, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	resolver := &stubResolver{}
+	url := resolver.publish(t, key, "nodes.example.org", 1, bootnodes[:1])
+
+	server, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.DNSResolver = resolver
+			c.Chain.Bootnodes = []string{url}
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create server, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, append(bootnodes, server))
+	})
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelFn()
+
+	// the bootnodes of the DNS tree are dialed on start
+	_, err = WaitUntilPeerConnectsTo(ctx, server, bootnodes[0].AddrInfo().ID)
+	assert.NoError(t, err)
+
+	// and the tree is refreshed periodically
+	resolver.publish(t, key, "nodes.example.org", 2, bootnodes)
+
+	_, err = WaitUntilPeerConnectsTo(ctx, server, bootnodes[1].AddrInfo().ID)
+	assert.NoError(t, err)
+	assert.Len(t, server.discovery.getBootnodes(), 2)
+}
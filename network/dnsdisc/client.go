@@ -0,0 +1,196 @@
+package dnsdisc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// maxLinkDepth is the maximum depth of the links followed from a tree
+const maxLinkDepth = 8
+
+var ErrStaleRoot = errors.New("DNS tree root is older than the one already resolved")
+
+// Resolver looks up the TXT records of a DNS name, such as net.Resolver
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Client resolves the nodes of the DNS trees. The records are content addressed,
+// so the ones of the last resolution are cached and only the roots are queried again
+type Client struct {
+	logger   hclog.Logger
+	resolver Resolver
+
+	lock    sync.Mutex
+	entries map[string]entry  // records of the last resolution, by hash
+	seqs    map[string]uint64 // sequence number of the roots, by domain
+}
+
+// NewClient creates a client of the resolver, the system resolver if nil
+func NewClient(resolver Resolver, logger hclog.Logger) *Client {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return &Client{
+		logger:   logger.Named("dnsdisc"),
+		resolver: resolver,
+		entries:  map[string]entry{},
+		seqs:     map[string]uint64{},
+	}
+}
+
+// resolution is the state of a single resolution
+type resolution struct {
+	ctx     context.Context
+	entries map[string]entry
+	visited map[string]struct{}
+	nodes   []string
+}
+
+// Resolve returns the multiaddrs of the nodes of the tree at the URL,
+// including the nodes of the trees it links to
+func (c *Client) Resolve(ctx context.Context, url string) ([]string, error) {
+	link, err := parseLink(url)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	r := &resolution{
+		ctx:     ctx,
+		entries: map[string]entry{},
+		visited: map[string]struct{}{},
+	}
+
+	if err := c.resolveTree(r, link, 0); err != nil {
+		return nil, err
+	}
+
+	c.entries = r.entries
+
+	return r.nodes, nil
+}
+
+// resolveTree verifies the root of the tree, and collects the nodes of the tree and of the linked trees.
+// The linked trees failing to resolve are skipped
+func (c *Client) resolveTree(r *resolution, link *linkEntry, depth int) error {
+	if _, ok := r.visited[link.domain]; ok || depth > maxLinkDepth {
+		return nil
+	}
+
+	r.visited[link.domain] = struct{}{}
+
+	records, err := c.resolver.LookupTXT(r.ctx, link.domain)
+	if err != nil {
+		return err
+	}
+
+	var root *rootEntry
+
+	for _, record := range records {
+		if root, err = parseRoot(record); err == nil {
+			break
+		}
+	}
+
+	if root == nil {
+		return fmt.Errorf("%w: no root at %s", ErrInvalidRecord, link.domain)
+	}
+
+	if !root.verify(link.pubkey) {
+		return fmt.Errorf("%w: root at %s", ErrInvalidSignature, link.domain)
+	}
+
+	if root.seq < c.seqs[link.domain] {
+		return fmt.Errorf("%w: %s", ErrStaleRoot, link.domain)
+	}
+
+	c.seqs[link.domain] = root.seq
+
+	if err := c.resolveEntry(r, link.domain, root.eroot, false, depth); err != nil {
+		return err
+	}
+
+	return c.resolveEntry(r, link.domain, root.lroot, true, depth)
+}
+
+// resolveEntry walks the subtree of the entry with the hash, which holds
+// either links (under the lroot) or nodes (under the eroot) only
+func (c *Client) resolveEntry(r *resolution, domain, hash string, links bool, depth int) error {
+	e, err := c.lookupEntry(r, domain, hash)
+	if err != nil {
+		return err
+	}
+
+	switch e := e.(type) {
+	case *branchEntry:
+		for _, child := range e.children {
+			if err := c.resolveEntry(r, domain, child, links, depth); err != nil {
+				return err
+			}
+		}
+	case *nodeEntry:
+		if links {
+			return fmt.Errorf("%w: node in the links subtree at %s", ErrInvalidRecord, domain)
+		}
+
+		r.nodes = append(r.nodes, e.addr)
+	case *linkEntry:
+		if !links {
+			return fmt.Errorf("%w: link in the nodes subtree at %s", ErrInvalidRecord, domain)
+		}
+
+		if err := c.resolveTree(r, e, depth+1); err != nil {
+			// the resolution was canceled
+			if r.ctx.Err() != nil {
+				return err
+			}
+
+			c.logger.Warn("failed to resolve linked DNS tree", "domain", e.domain, "from", domain, "err", err)
+		}
+	default:
+		return fmt.Errorf("%w: unexpected root in the tree at %s", ErrInvalidRecord, domain)
+	}
+
+	return nil
+}
+
+// lookupEntry returns the entry with the hash, from the cache or from the DNS
+func (c *Client) lookupEntry(r *resolution, domain, hash string) (entry, error) {
+	if e, ok := c.entries[hash]; ok {
+		r.entries[hash] = e
+
+		return e, nil
+	}
+
+	records, err := c.resolver.LookupTXT(r.ctx, hash+"."+domain)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		// the hash authenticates the record, as the root is signed
+		if hashText(record) != hash {
+			continue
+		}
+
+		e, err := parseEntry(record)
+		if err != nil {
+			return nil, err
+		}
+
+		r.entries[hash] = e
+
+		return e, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s.%s", ErrHashMismatch, hash, domain)
+}
@@ -0,0 +1,240 @@
+package dnsdisc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/go-hclog"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubResolver serves the TXT records from memory, counting the lookups
+type stubResolver struct {
+	records map[string]string
+	lookups int
+}
+
+func newStubResolver() *stubResolver {
+	return &stubResolver{records: map[string]string{}}
+}
+
+func (s *stubResolver) publish(records map[string]string) {
+	for name, record := range records {
+		s.records[name] = record
+	}
+}
+
+func (s *stubResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	s.lookups++
+
+	record, ok := s.records[name]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", name)
+	}
+
+	return []string{record}, nil
+}
+
+func newTestNodes(t *testing.T, n int) []string {
+	t.Helper()
+
+	nodes := make([]string, n)
+
+	for i := 0; i < n; i++ {
+		key, _, err := libp2pCrypto.GenerateKeyPair(libp2pCrypto.Secp256k1, 256)
+		assert.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		assert.NoError(t, err)
+
+		nodes[i] = fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", 10000+i, id)
+	}
+
+	return nodes
+}
+
+// newTestTree publishes the signed tree of the nodes and links at the domain, returning its URL
+func newTestTree(t *testing.T, resolver *stubResolver, domain string, seq uint64, nodes, links []string) string {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	tree, err := MakeTree(seq, nodes, links)
+	assert.NoError(t, err)
+
+	url, err := tree.Sign(key, domain)
+	assert.NoError(t, err)
+
+	resolver.publish(tree.Records(domain))
+
+	return url
+}
+
+func TestClient_Resolve(t *testing.T) {
+	resolver := newStubResolver()
+
+	// the nodes don't fit in a single branch
+	nodes := newTestNodes(t, 3*maxChildren)
+	url := newTestTree(t, resolver, "nodes.example.org", 1, nodes, nil)
+
+	client := NewClient(resolver, hclog.NewNullLogger())
+
+	resolved, err := client.Resolve(context.Background(), url)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, nodes, resolved)
+
+	// the unchanged records are cached
+	lookups := resolver.lookups
+
+	resolved, err = client.Resolve(context.Background(), url)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, nodes, resolved)
+	assert.Equal(t, lookups+1, resolver.lookups)
+}
+
+func TestClient_ResolveLinks(t *testing.T) {
+	resolver := newStubResolver()
+	nodes := newTestNodes(t, 4)
+
+	linked := newTestTree(t, resolver, "linked.example.org", 1, nodes[2:], nil)
+	url := newTestTree(t, resolver, "nodes.example.org", 1, nodes[:2], []string{linked})
+
+	resolved, err := NewClient(resolver, hclog.NewNullLogger()).Resolve(context.Background(), url)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, nodes, resolved)
+}
+
+func TestClient_ResolveFailedLinks(t *testing.T) {
+	resolver := newStubResolver()
+	nodes := newTestNodes(t, 4)
+
+	// the records of the unreachable tree are not served
+	unreachable := newTestTree(t, newStubResolver(), "unreachable.example.org", 1, nodes[3:], nil)
+	linked := newTestTree(t, resolver, "linked.example.org", 1, nodes[2:3], nil)
+	url := newTestTree(t, resolver, "nodes.example.org", 1, nodes[:2], []string{unreachable, linked})
+
+	// the failed links are skipped
+	resolved, err := NewClient(resolver, hclog.NewNullLogger()).Resolve(context.Background(), url)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, nodes[:3], resolved)
+}
+
+func TestClient_ResolveInvalid(t *testing.T) {
+	domain := "nodes.example.org"
+	nodes := newTestNodes(t, 2)
+
+	t.Run("tampered record", func(t *testing.T) {
+		resolver := newStubResolver()
+		url := newTestTree(t, resolver, domain, 1, nodes, nil)
+
+		for name, record := range resolver.records {
+			if name != domain && record[:len(nodePrefix)] == nodePrefix {
+				resolver.records[name] = nodePrefix + newTestNodes(t, 1)[0]
+			}
+		}
+
+		_, err := NewClient(resolver, hclog.NewNullLogger()).Resolve(context.Background(), url)
+		assert.ErrorIs(t, err, ErrHashMismatch)
+	})
+
+	t.Run("root signed by another key", func(t *testing.T) {
+		resolver := newStubResolver()
+		newTestTree(t, resolver, domain, 1, nodes, nil)
+
+		// the URL of the same domain, with another key
+		url := newTestTree(t, newStubResolver(), domain, 1, nodes, nil)
+
+		_, err := NewClient(resolver, hclog.NewNullLogger()).Resolve(context.Background(), url)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("entries of the wrong kind", func(t *testing.T) {
+		resolver := newStubResolver()
+		linked := newTestTree(t, resolver, "linked.example.org", 1, nodes, nil)
+
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+
+		tree, err := MakeTree(1, nodes, []string{linked})
+		assert.NoError(t, err)
+
+		// the nodes are listed as links and the other way round
+		tree.root.eroot, tree.root.lroot = tree.root.lroot, tree.root.eroot
+
+		url, err := tree.Sign(key, domain)
+		assert.NoError(t, err)
+
+		resolver.publish(tree.Records(domain))
+
+		_, err = NewClient(resolver, hclog.NewNullLogger()).Resolve(context.Background(), url)
+		assert.ErrorIs(t, err, ErrInvalidRecord)
+	})
+
+	t.Run("stale root", func(t *testing.T) {
+		resolver := newStubResolver()
+
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+
+		publish := func(seq uint64) string {
+			tree, err := MakeTree(seq, nodes, nil)
+			assert.NoError(t, err)
+
+			url, err := tree.Sign(key, domain)
+			assert.NoError(t, err)
+
+			resolver.publish(tree.Records(domain))
+
+			return url
+		}
+
+		client := NewClient(resolver, hclog.NewNullLogger())
+
+		_, err = client.Resolve(context.Background(), publish(2))
+		assert.NoError(t, err)
+
+		_, err = client.Resolve(context.Background(), publish(1))
+		assert.ErrorIs(t, err, ErrStaleRoot)
+	})
+}
+
+func TestParseNode(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	// the enode key is the key of the peer ID
+	buf, err := crypto.MarshalPrivateKey(key)
+	assert.NoError(t, err)
+
+	libp2pKey, err := libp2pCrypto.UnmarshalSecp256k1PrivateKey(buf)
+	assert.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(libp2pKey)
+	assert.NoError(t, err)
+
+	pub := hex.EncodeToString(crypto.MarshalPublicKey(&key.PublicKey)[1:])
+
+	cases := map[string]string{
+		"10.0.0.1":         "/ip4/10.0.0.1",
+		"[::1]":            "/ip6/::1",
+		"boot.example.com": "/dns/boot.example.com",
+	}
+
+	for host, expected := range cases {
+		addr, err := ParseNode(fmt.Sprintf("enode://%s@%s:30301", pub, host))
+		assert.NoError(t, err)
+		assert.Equal(t, expected+"/tcp/30301/p2p/"+id.String(), addr)
+	}
+
+	_, err = ParseNode("/ip4/10.0.0.1/tcp/30301")
+	assert.Error(t, err)
+
+	_, err = ParseNode("enode://abcd@10.0.0.1:30301")
+	assert.Error(t, err)
+}
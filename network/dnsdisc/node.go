@@ -0,0 +1,97 @@
+package dnsdisc
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/enode"
+	"github.com/btcsuite/btcd/btcec"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const enodePrefix = "enode://"
+
+// ParseNode parses the address of a node, either a multiaddr including
+// the peer ID or an enode URL, returning the multiaddr of the node
+func ParseNode(raw string) (string, error) {
+	if strings.HasPrefix(raw, enodePrefix) {
+		return parseEnode(raw)
+	}
+
+	addr, err := multiaddr.NewMultiaddr(raw)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := peer.AddrInfoFromP2pAddr(addr); err != nil {
+		return "", err
+	}
+
+	return addr.String(), nil
+}
+
+// parseEnode converts the enode URL to a multiaddr, the secp256k1 public key
+// of the enode being the key of the libp2p peer ID
+func parseEnode(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+
+	if u.User == nil || u.Port() == "" {
+		return "", fmt.Errorf("invalid enode %s", raw)
+	}
+
+	key, err := hex.DecodeString(u.User.Username())
+	if err != nil || len(key) != 64 {
+		return "", fmt.Errorf("invalid enode public key in %s", raw)
+	}
+
+	pubkey, err := enode.NodeIDToPubKey(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid enode public key in %s", raw)
+	}
+
+	libp2pKey, err := libp2pCrypto.UnmarshalSecp256k1PublicKey(compressPubkey(pubkey))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := peer.IDFromPublicKey(libp2pKey)
+	if err != nil {
+		return "", err
+	}
+
+	// hostnames are kept as /dns/ so that the node list can use DNS names
+	host := "/dns/" + u.Hostname()
+
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		if ip.To4() != nil {
+			host = "/ip4/" + ip.String()
+		} else {
+			host = "/ip6/" + ip.String()
+		}
+	}
+
+	return fmt.Sprintf("%s/tcp/%s/p2p/%s", host, u.Port(), id), nil
+}
+
+func compressPubkey(pubkey *ecdsa.PublicKey) []byte {
+	return (*btcec.PublicKey)(pubkey).SerializeCompressed()
+}
+
+func decompressPubkey(buf []byte) (*ecdsa.PublicKey, error) {
+	pubkey, err := btcec.ParsePubKey(buf, crypto.S256)
+	if err != nil {
+		return nil, err
+	}
+
+	return pubkey.ToECDSA(), nil
+}
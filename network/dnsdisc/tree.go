@@ -0,0 +1,375 @@
+package dnsdisc
+
+import (
+	"crypto/ecdsa"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+)
+
+// Prefixes of the tree records, following EIP-1459.
+// The leaves hold libp2p multiaddrs instead of ENRs
+const (
+	rootPrefix   = "enrtree-root:v1"
+	branchPrefix = "enrtree-branch:"
+	linkPrefix   = "enrtree://"
+	nodePrefix   = "libp2p:"
+)
+
+const (
+	// maxChildren is the maximum number of hashes in a branch,
+	// keeping the records within the TXT record size
+	maxChildren = 13
+
+	// hashLength is the number of bytes of the Keccak256 hash naming the records
+	hashLength = 16
+)
+
+var (
+	ErrInvalidURL       = errors.New("invalid DNS tree URL")
+	ErrInvalidRecord    = errors.New("invalid DNS tree record")
+	ErrInvalidSignature = errors.New("invalid DNS tree signature")
+	ErrHashMismatch     = errors.New("DNS tree record hash mismatch")
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Tree is a tree of nodes and links to other trees, published as DNS TXT records
+type Tree struct {
+	root    *rootEntry
+	entries map[string]entry
+}
+
+// MakeTree creates the tree of the given nodes (multiaddrs or enode URLs)
+// and links (URLs of the other trees)
+func MakeTree(seq uint64, nodes []string, links []string) (*Tree, error) {
+	nodeEntries := make([]entry, 0, len(nodes))
+
+	for _, raw := range nodes {
+		addr, err := ParseNode(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node %s: %w", raw, err)
+		}
+
+		nodeEntries = append(nodeEntries, &nodeEntry{addr: addr})
+	}
+
+	linkEntries := make([]entry, 0, len(links))
+
+	for _, raw := range links {
+		link, err := parseLink(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		linkEntries = append(linkEntries, link)
+	}
+
+	t := &Tree{
+		entries: map[string]entry{},
+	}
+
+	// the records are sorted so the tree does not depend on the order of the input
+	nodesRoot := t.addSubtree(sortEntries(nodeEntries))
+	linksRoot := t.addSubtree(sortEntries(linkEntries))
+
+	t.root = &rootEntry{
+		eroot: hashEntry(nodesRoot),
+		lroot: hashEntry(linksRoot),
+		seq:   seq,
+	}
+
+	return t, nil
+}
+
+// addSubtree adds the entries under branches of up to maxChildren hashes, returning the subtree root
+func (t *Tree) addSubtree(entries []entry) entry {
+	if len(entries) == 1 {
+		t.entries[hashEntry(entries[0])] = entries[0]
+
+		return entries[0]
+	}
+
+	if len(entries) <= maxChildren {
+		branch := &branchEntry{children: make([]string, len(entries))}
+
+		for i, e := range entries {
+			branch.children[i] = hashEntry(e)
+			t.entries[branch.children[i]] = e
+		}
+
+		t.entries[hashEntry(branch)] = branch
+
+		return branch
+	}
+
+	// split the entries among the children, which are subtrees themselves
+	size := (len(entries) + maxChildren - 1) / maxChildren
+	subtrees := make([]entry, 0, maxChildren)
+
+	for start := 0; start < len(entries); start += size {
+		end := start + size
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		subtrees = append(subtrees, t.addSubtree(entries[start:end]))
+	}
+
+	return t.addSubtree(subtrees)
+}
+
+// Sign signs the root of the tree with the key, returning the URL of the tree at the domain
+func (t *Tree) Sign(key *ecdsa.PrivateKey, domain string) (string, error) {
+	sig, err := crypto.Sign(key, t.root.signingHash())
+	if err != nil {
+		return "", err
+	}
+
+	t.root.sig = sig
+
+	return (&linkEntry{domain: domain, pubkey: &key.PublicKey}).String(), nil
+}
+
+// Seq returns the sequence number of the tree
+func (t *Tree) Seq() uint64 {
+	return t.root.seq
+}
+
+// Nodes returns the multiaddrs of the nodes in the tree
+func (t *Tree) Nodes() []string {
+	nodes := []string{}
+
+	for _, e := range t.entries {
+		if node, ok := e.(*nodeEntry); ok {
+			nodes = append(nodes, node.addr)
+		}
+	}
+
+	sort.Strings(nodes)
+
+	return nodes
+}
+
+// Records returns the TXT records of the signed tree published at the domain,
+// mapped by their DNS name
+func (t *Tree) Records(domain string) map[string]string {
+	records := make(map[string]string, len(t.entries)+1)
+	records[domain] = t.root.String()
+
+	for hash, e := range t.entries {
+		records[hash+"."+domain] = e.String()
+	}
+
+	return records
+}
+
+// entry is a record of the tree
+type entry interface {
+	String() string
+}
+
+// rootEntry is the signed record at the domain of the tree, pointing to the roots
+// of the nodes subtree and of the links subtree
+type rootEntry struct {
+	eroot string
+	lroot string
+	seq   uint64
+	sig   []byte
+}
+
+func (r *rootEntry) signedText() string {
+	return fmt.Sprintf("%s e=%s l=%s seq=%d", rootPrefix, r.eroot, r.lroot, r.seq)
+}
+
+func (r *rootEntry) signingHash() []byte {
+	return crypto.Keccak256([]byte(r.signedText()))
+}
+
+func (r *rootEntry) String() string {
+	return r.signedText() + " sig=" + base64.RawURLEncoding.EncodeToString(r.sig)
+}
+
+// verify returns true if the root is signed by the key
+func (r *rootEntry) verify(pubkey *ecdsa.PublicKey) bool {
+	signer, err := crypto.SigToPub(r.signingHash(), r.sig)
+	if err != nil {
+		return false
+	}
+
+	return signer.X.Cmp(pubkey.X) == 0 && signer.Y.Cmp(pubkey.Y) == 0
+}
+
+// branchEntry lists the hashes of its children
+type branchEntry struct {
+	children []string
+}
+
+func (b *branchEntry) String() string {
+	return branchPrefix + strings.Join(b.children, ",")
+}
+
+// nodeEntry is a leaf holding the multiaddr of a node
+type nodeEntry struct {
+	addr string
+}
+
+func (n *nodeEntry) String() string {
+	return nodePrefix + n.addr
+}
+
+// linkEntry is a leaf pointing to another tree, signed by the key
+type linkEntry struct {
+	domain string
+	pubkey *ecdsa.PublicKey
+}
+
+func (l *linkEntry) String() string {
+	return linkPrefix + b32.EncodeToString(compressPubkey(l.pubkey)) + "@" + l.domain
+}
+
+// hashEntry returns the name of the entry record, relative to the domain of the tree
+func hashEntry(e entry) string {
+	return hashText(e.String())
+}
+
+func hashText(text string) string {
+	return b32.EncodeToString(crypto.Keccak256([]byte(text))[:hashLength])
+}
+
+func sortEntries(entries []entry) []entry {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].String() < entries[j].String()
+	})
+
+	return entries
+}
+
+// parseEntry parses the text of a TXT record
+func parseEntry(text string) (entry, error) {
+	switch {
+	case strings.HasPrefix(text, rootPrefix):
+		return parseRoot(text)
+	case strings.HasPrefix(text, branchPrefix):
+		return parseBranch(text)
+	case strings.HasPrefix(text, linkPrefix):
+		return parseLink(text)
+	case strings.HasPrefix(text, nodePrefix):
+		addr, err := ParseNode(strings.TrimPrefix(text, nodePrefix))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRecord, err.Error())
+		}
+
+		return &nodeEntry{addr: addr}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown record %q", ErrInvalidRecord, text)
+	}
+}
+
+func parseRoot(text string) (*rootEntry, error) {
+	fields := strings.Fields(strings.TrimPrefix(text, rootPrefix))
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("%w: malformed root %q", ErrInvalidRecord, text)
+	}
+
+	values := make(map[string]string, len(fields))
+
+	for _, field := range fields {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: malformed root %q", ErrInvalidRecord, text)
+		}
+
+		values[kv[0]] = kv[1]
+	}
+
+	seq, err := strconv.ParseUint(values["seq"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid root sequence %q", ErrInvalidRecord, values["seq"])
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(values["sig"])
+	if err != nil || len(sig) != 65 {
+		return nil, fmt.Errorf("%w: invalid root signature %q", ErrInvalidRecord, values["sig"])
+	}
+
+	root := &rootEntry{
+		eroot: values["e"],
+		lroot: values["l"],
+		seq:   seq,
+		sig:   sig,
+	}
+
+	if !isHash(root.eroot) || !isHash(root.lroot) {
+		return nil, fmt.Errorf("%w: invalid root hashes %q", ErrInvalidRecord, text)
+	}
+
+	return root, nil
+}
+
+func parseBranch(text string) (*branchEntry, error) {
+	branch := &branchEntry{}
+
+	if list := strings.TrimPrefix(text, branchPrefix); list != "" {
+		branch.children = strings.Split(list, ",")
+	}
+
+	for _, hash := range branch.children {
+		if !isHash(hash) {
+			return nil, fmt.Errorf("%w: invalid branch hash %q", ErrInvalidRecord, hash)
+		}
+	}
+
+	return branch, nil
+}
+
+// parseLink parses the URL of a tree: enrtree://<base32 compressed public key>@<domain>
+func parseLink(url string) (*linkEntry, error) {
+	if !IsURL(url) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidURL, url)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(url, linkPrefix), "@", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidURL, url)
+	}
+
+	key, err := b32.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid public key in %s", ErrInvalidURL, url)
+	}
+
+	pubkey, err := decompressPubkey(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid public key in %s", ErrInvalidURL, url)
+	}
+
+	return &linkEntry{
+		domain: parts[1],
+		pubkey: pubkey,
+	}, nil
+}
+
+// IsURL returns true if the raw string is the URL of a DNS tree, rather than a multiaddr
+func IsURL(raw string) bool {
+	return strings.HasPrefix(raw, linkPrefix)
+}
+
+// ValidateURL returns an error if the URL of the DNS tree is malformed
+func ValidateURL(url string) error {
+	_, err := parseLink(url)
+
+	return err
+}
+
+func isHash(s string) bool {
+	b, err := b32.DecodeString(s)
+
+	return err == nil && len(b) == hashLength
+}
@@ -0,0 +1,46 @@
+package network
+
+import (
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// gater is the libp2p connection gater, refusing the connections
+// of the banned peers and of the peers missing from the allowlist
+type gater struct {
+	reputation *reputation
+	allowlist  *allowlist
+}
+
+// accepts returns true if the connections of the peer are accepted
+func (g *gater) accepts(p peer.ID) bool {
+	return g.allowlist.isAllowed(p) && !g.reputation.isBanned(p)
+}
+
+// InterceptPeerDial implements the libp2p connection gater, refusing to dial refused peers
+func (g *gater) InterceptPeerDial(p peer.ID) bool {
+	return g.accepts(p)
+}
+
+// InterceptAddrDial implements the libp2p connection gater
+func (g *gater) InterceptAddrDial(peer.ID, multiaddr.Multiaddr) bool {
+	return true
+}
+
+// InterceptAccept implements the libp2p connection gater
+func (g *gater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured implements the libp2p connection gater,
+// refusing the connections of refused peers once they are identified
+func (g *gater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return g.accepts(p)
+}
+
+// InterceptUpgraded implements the libp2p connection gater
+func (g *gater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
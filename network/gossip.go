@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -38,6 +39,15 @@ func (t *Topic) Publish(obj proto.Message) error {
 }
 
 func (t *Topic) Subscribe(handler func(obj interface{})) error {
+	return t.SubscribeWithSender(func(obj interface{}, _ peer.ID) {
+		handler(obj)
+	})
+}
+
+// SubscribeWithSender subscribes to the topic, passing the peer which
+// published each message to the handler, so that it can be reported
+// if the message is invalid
+func (t *Topic) SubscribeWithSender(handler func(obj interface{}, from peer.ID)) error {
 	sub, err := t.topic.Subscribe(pubsub.WithBufferSize(bufferSize))
 	if err != nil {
 		return err
@@ -48,7 +58,7 @@ func (t *Topic) Subscribe(handler func(obj interface{})) error {
 	return nil
 }
 
-func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{})) {
+func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, from peer.ID)) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 
 	go func() {
@@ -72,7 +82,7 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{})
 				return
 			}
 
-			handler(obj)
+			handler(obj, msg.GetFrom())
 		}()
 	}
 }
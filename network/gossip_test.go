@@ -30,7 +30,19 @@ func WaitForSubscribers(ctx context.Context, srv *Server, topic string, expected
 func TestSimpleGossip(t *testing.T) {
 	numServers := 10
 	sentMessage := fmt.Sprintf("%d", time.Now().Unix())
-	servers, createErr := createServers(numServers, nil)
+
+	// in the full mesh, the last server accepts a connection from every other server,
+	// which must fit in the slots left by the ones reserved to the outbound connections
+	params := map[int]*CreateServerParams{}
+	for i := 0; i < numServers; i++ {
+		params[i] = &CreateServerParams{
+			ConfigCallback: func(c *Config) {
+				c.MaxPeers = uint64(2 * numServers)
+			},
+		}
+	}
+
+	servers, createErr := createServers(numServers, params)
 
 	if createErr != nil {
 		t.Fatalf("Unable to create servers, %v", createErr)
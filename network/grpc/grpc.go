@@ -8,8 +8,8 @@ import (
 
 	manet "github.com/multiformats/go-multiaddr/net"
 
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
 	grpcPeer "google.golang.org/grpc/peer"
 )
@@ -137,7 +137,8 @@ type wrapLibp2pAddr struct {
 func (c *streamConn) LocalAddr() net.Addr {
 	addr, err := manet.ToNetAddr(c.Stream.Conn().LocalMultiaddr())
 	if err != nil {
-		return fakeRemoteAddr()
+		// the relayed connections have no network address
+		addr = fakeLocalAddr()
 	}
 
 	return &wrapLibp2pAddr{Addr: addr, id: c.Stream.Conn().LocalPeer()}
@@ -147,7 +148,8 @@ func (c *streamConn) LocalAddr() net.Addr {
 func (c *streamConn) RemoteAddr() net.Addr {
 	addr, err := manet.ToNetAddr(c.Stream.Conn().RemoteMultiaddr())
 	if err != nil {
-		return fakeRemoteAddr()
+		// the relayed connections have no network address
+		addr = fakeRemoteAddr()
 	}
 
 	return &wrapLibp2pAddr{Addr: addr, id: c.Stream.Conn().RemotePeer()}
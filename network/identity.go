@@ -10,8 +10,8 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/network/proto"
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -26,36 +26,44 @@ var (
 type identity struct {
 	proto.UnimplementedIdentityServer
 
-	pending     sync.Map
-	pendingSize int64
+	// direction of the connections pending of handshake
+	pending         sync.Map
+	pendingInbound  int64
+	pendingOutbound int64
 
 	srv *Server
 
 	initialized uint32
 }
 
-func (i *identity) numPending() int64 {
-	return atomic.LoadInt64(&i.pendingSize)
+// pendingCounter returns the counter of the pending connections in the given direction
+func (i *identity) pendingCounter(direction network.Direction) *int64 {
+	if direction == network.DirOutbound {
+		return &i.pendingOutbound
+	}
+
+	return &i.pendingInbound
+}
+
+func (i *identity) numPending(direction network.Direction) int64 {
+	return atomic.LoadInt64(i.pendingCounter(direction))
 }
 
 func (i *identity) isPending(id peer.ID) bool {
-	val, ok := i.pending.Load(id)
-	if !ok {
-		return false
-	}
+	_, ok := i.pending.Load(id)
 
-	return val.(bool)
+	return ok
 }
 
 func (i *identity) delPending(id peer.ID) {
-	if _, loaded := i.pending.LoadAndDelete(id); loaded {
-		atomic.AddInt64(&i.pendingSize, -1)
+	if direction, loaded := i.pending.LoadAndDelete(id); loaded {
+		atomic.AddInt64(i.pendingCounter(direction.(network.Direction)), -1) //nolint:forcetypeassert
 	}
 }
 
-func (i *identity) setPending(id peer.ID) {
-	if _, loaded := i.pending.LoadOrStore(id, true); !loaded {
-		atomic.AddInt64(&i.pendingSize, 1)
+func (i *identity) setPending(id peer.ID, direction network.Direction) {
+	if _, loaded := i.pending.LoadOrStore(id, direction); !loaded {
+		atomic.AddInt64(i.pendingCounter(direction), 1)
 	}
 }
 
@@ -72,7 +80,14 @@ func (i *identity) setup() {
 	i.srv.host.Network().Notify(&network.NotifyBundle{
 		ConnectedF: func(net network.Network, conn network.Conn) {
 			peerID := conn.RemotePeer()
-			i.srv.logger.Debug("Conn", "peer", peerID, "direction", conn.Stat().Direction)
+			direction := conn.Stat().Direction
+			i.srv.logger.Debug("Conn", "peer", peerID, "direction", direction)
+
+			if conn.Stat().Limited {
+				// the limited connections through a relay only carry the hole punching,
+				// the handshake is done once the direct connection is open
+				return
+			}
 
 			initialized := atomic.LoadUint32(&i.initialized)
 			if initialized == 0 {
@@ -87,13 +102,13 @@ func (i *identity) setup() {
 				return
 			}
 
-			if i.srv.numOpenSlots() == 0 {
+			if i.srv.numOpenSlots(direction) == 0 && !i.srv.isPersistent(peerID) {
 				i.srv.Disconnect(peerID, ErrNoAvailableSlots.Error())
 
 				return
 			}
 			// pending of handshake
-			i.setPending(peerID)
+			i.setPending(peerID, direction)
 
 			go func() {
 				defer func() {
@@ -103,7 +118,7 @@ func (i *identity) setup() {
 					}
 				}()
 
-				if err := i.handleConnected(peerID); err != nil {
+				if err := i.handleConnected(peerID, direction); err != nil {
 					i.srv.Disconnect(peerID, err.Error())
 				}
 			}()
@@ -123,7 +138,7 @@ func (i *identity) getStatus() *proto.Status {
 	}
 }
 
-func (i *identity) handleConnected(peerID peer.ID) error {
+func (i *identity) handleConnected(peerID peer.ID, direction network.Direction) error {
 	// we initiated the connection, now we perform the handshake
 	conn, err := i.srv.NewProtoStream(identityProtoV1, peerID)
 	if err != nil {
@@ -144,7 +159,7 @@ func (i *identity) handleConnected(peerID peer.ID) error {
 		return ErrInvalidChainID
 	}
 
-	i.srv.addPeer(peerID)
+	i.srv.addPeer(peerID, direction)
 
 	return nil
 }
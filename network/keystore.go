@@ -4,7 +4,7 @@ import (
 	"encoding/hex"
 
 	"github.com/0xPolygon/polygon-edge/secrets"
-	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p/core/crypto"
 )
 
 // ReadLibp2pKey reads the private networking key from the secrets manager
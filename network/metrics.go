@@ -11,6 +11,14 @@ import (
 type Metrics struct {
 	// No.of connected peers
 	Peers metrics.Gauge
+
+	// Reachability of the node (0: unknown, 1: public, 2: private)
+	Reachability metrics.Gauge
+
+	// No.of dials attempted, failed, and skipped as the addresses of the peer were backed off
+	DialsAttempted metrics.Counter
+	DialsFailed    metrics.Counter
+	DialsBackedOff metrics.Counter
 }
 
 // GetPrometheusMetrics return the network metrics instance
@@ -28,12 +36,40 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 			Name:      "peers",
 			Help:      "Number of connected peers.",
 		}, labels).With(labelsWithValues...),
+		Reachability: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "network",
+			Name:      "reachability",
+			Help:      "Reachability of the node detected by AutoNAT (0: unknown, 1: public, 2: private).",
+		}, labels).With(labelsWithValues...),
+		DialsAttempted: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "network",
+			Name:      "dials_attempted",
+			Help:      "Number of dials attempted.",
+		}, labels).With(labelsWithValues...),
+		DialsFailed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "network",
+			Name:      "dials_failed",
+			Help:      "Number of failed dials.",
+		}, labels).With(labelsWithValues...),
+		DialsBackedOff: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "network",
+			Name:      "dials_backed_off",
+			Help:      "Number of dials skipped as the addresses of the peer are backed off after failed dials.",
+		}, labels).With(labelsWithValues...),
 	}
 }
 
 // NilMetrics will return the non operational metrics
 func NilMetrics() *Metrics {
 	return &Metrics{
-		Peers: discard.NewGauge(),
+		Peers:          discard.NewGauge(),
+		Reachability:   discard.NewGauge(),
+		DialsAttempted: discard.NewCounter(),
+		DialsFailed:    discard.NewCounter(),
+		DialsBackedOff: discard.NewCounter(),
 	}
 }
@@ -0,0 +1,117 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+)
+
+// natOptions returns the libp2p options traversing the NAT of the node:
+//   - the NAT port mapping (UPnP, NAT-PMP) when enabled
+//   - the hole punching (DCUtR) of the connections through a relay into direct ones
+//   - the relay service (circuit relay v2) and the AutoNAT service for the nodes
+//     acting as relays (bootnodes), which need to be publicly reachable
+//   - the auto relay through the bootnodes, once AutoNAT detects the node is not reachable
+func natOptions(config *Config, self peer.ID) ([]libp2p.Option, error) {
+	holePunching := []holepunch.Option{}
+	if config.holePunchFilter != nil {
+		holePunching = append(holePunching, holepunch.WithAddrFilter(config.holePunchFilter))
+	}
+
+	options := []libp2p.Option{
+		libp2p.EnableHolePunching(holePunching...),
+	}
+
+	if config.NATPortMap {
+		options = append(options, libp2p.NATPortMap())
+	}
+
+	if config.Relay {
+		return append(options,
+			libp2p.EnableRelayService(),
+			libp2p.EnableNATService(),
+			libp2p.ForceReachabilityPublic(),
+		), nil
+	}
+
+	if !config.AutoRelay {
+		return options, nil
+	}
+
+	relays := []peer.AddrInfo{}
+
+	if config.Chain != nil {
+		for _, raw := range config.Chain.Bootnodes {
+			if dnsdisc.IsURL(raw) {
+				// the relays are the static bootnodes
+				continue
+			}
+
+			node, err := StringToAddrInfo(raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse bootnode %s: %w", raw, err)
+			}
+
+			if node.ID != self {
+				relays = append(relays, *node)
+			}
+		}
+	}
+
+	if len(relays) == 0 {
+		return nil, errors.New("auto relay requires bootnodes acting as relays")
+	}
+
+	return append(options,
+		libp2p.EnableAutoRelayWithStaticRelays(relays),
+	), nil
+}
+
+// Reachability returns the reachability of the node
+// from the other peers, as detected by AutoNAT
+func (s *Server) Reachability() network.Reachability {
+	return network.Reachability(atomic.LoadInt32(&s.reachability))
+}
+
+// setReachability updates the reachability of the node
+func (s *Server) setReachability(reachability network.Reachability) {
+	atomic.StoreInt32(&s.reachability, int32(reachability))
+	s.metrics.Reachability.Set(float64(reachability))
+}
+
+// watchReachability tracks the reachability changes detected by AutoNAT
+func (s *Server) watchReachability() error {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer sub.Close()
+
+		for {
+			select {
+			case evnt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+
+				reachability, _ := evnt.(event.EvtLocalReachabilityChanged)
+
+				s.logger.Info("Reachability changed", "reachability", reachability.Reachability)
+				s.setReachability(reachability.Reachability)
+			case <-s.closeCh:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
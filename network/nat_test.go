@@ -0,0 +1,161 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+// simulateNAT makes the server believe it is not reachable from
+// the other peers, as AutoNAT does behind a NAT
+func simulateNAT(t *testing.T, server *Server) {
+	t.Helper()
+
+	emitter, err := server.host.EventBus().Emitter(new(event.EvtLocalReachabilityChanged))
+	assert.NoError(t, err)
+
+	defer emitter.Close()
+
+	assert.NoError(t, emitter.Emit(event.EvtLocalReachabilityChanged{
+		Reachability: network.ReachabilityPrivate,
+	}))
+}
+
+// loopbackFilter replaces the addresses sent when hole punching with the loopback address
+// of the server, which stands for the public address of the NAT in front of it
+type loopbackFilter struct {
+	addr multiaddr.Multiaddr
+}
+
+func (f *loopbackFilter) FilterLocal(_ peer.ID, _ []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	return []multiaddr.Multiaddr{f.addr}
+}
+
+func (f *loopbackFilter) FilterRemote(_ peer.ID, addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	return addrs
+}
+
+// hideBehindNAT makes the server advertise a public address the other peers cannot dial,
+// so that it is only reachable through a relay until the connection is hole punched
+func hideBehindNAT(c *Config, name string) {
+	c.DNS = multiaddr.StringCast(fmt.Sprintf("/dns4/%s.example.com/tcp/%d", name, c.Addr.Port))
+	c.holePunchFilter = &loopbackFilter{
+		addr: multiaddr.StringCast(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", c.Addr.Port)),
+	}
+}
+
+// relayAddr returns the address of the server through a relay, if any
+func relayAddr(server *Server) multiaddr.Multiaddr {
+	for _, addr := range server.host.Addrs() {
+		if _, err := addr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+			return addr
+		}
+	}
+
+	return nil
+}
+
+func TestServer_Reachability(t *testing.T) {
+	servers, createErr := createServers(1, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	assert.Equal(t, network.ReachabilityUnknown, servers[0].Reachability())
+
+	simulateNAT(t, servers[0])
+
+	_, err := tests.RetryUntilTimeout(context.Background(), func() (interface{}, bool) {
+		return nil, servers[0].Reachability() != network.ReachabilityPrivate
+	})
+	assert.NoError(t, err)
+}
+
+func TestServer_HolePunching(t *testing.T) {
+	relay, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.Relay = true
+			c.NoDiscover = true
+
+			// the relays are used through their public or DNS addresses only
+			c.DNS = multiaddr.StringCast(fmt.Sprintf("/dns4/localhost/tcp/%d", c.Addr.Port))
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create relay, %v", createErr)
+	}
+
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {
+			ConfigCallback: func(c *Config) {
+				c.AutoRelay = true
+				c.NoDiscover = true
+				c.Chain.Bootnodes = []string{AddrInfoToString(relay.AddrInfo())}
+
+				hideBehindNAT(c, "natted")
+			},
+		},
+		1: {
+			ConfigCallback: func(c *Config) {
+				hideBehindNAT(c, "peer")
+			},
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, append(servers, relay))
+	})
+
+	// the node behind the NAT advertises its address through the relay
+	simulateNAT(t, servers[0])
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelFn()
+
+	addr, err := tests.RetryUntilTimeout(ctx, func() (interface{}, bool) {
+		addr := relayAddr(servers[0])
+
+		return addr, addr == nil
+	})
+	if err != nil {
+		t.Fatalf("Relay address not advertised, %v", err)
+	}
+
+	// the other peers reach it through the relay
+	natted := servers[0].AddrInfo().ID
+
+	go func() {
+		_ = servers[1].Join(&peer.AddrInfo{
+			ID:    natted,
+			Addrs: []multiaddr.Multiaddr{addr.(multiaddr.Multiaddr)},
+		}, DefaultJoinTimeout)
+	}()
+
+	_, err = WaitUntilPeerConnectsTo(ctx, servers[1], natted)
+	assert.NoError(t, err)
+
+	// once the connection through the relay is hole punched into a direct one
+	direct := false
+
+	for _, conn := range servers[1].host.Network().ConnsToPeer(natted) {
+		if _, err := conn.RemoteMultiaddr().ValueForProtocol(multiaddr.P_CIRCUIT); err != nil {
+			direct = true
+		}
+	}
+
+	assert.True(t, direct)
+}
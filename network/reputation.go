@@ -0,0 +1,331 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Penalty is the score a peer loses when reported for a misbehavior
+type Penalty int64
+
+// Penalties of the misbehaviors reported by the protocols
+const (
+	// PenaltyInvalidBlock is applied to peers sending blocks failing the validation
+	PenaltyInvalidBlock Penalty = 50
+
+	// PenaltyInvalidMessage is applied to peers sending malformed consensus messages
+	PenaltyInvalidMessage Penalty = 20
+
+	// PenaltyInvalidTx is applied to peers sending malformed transactions
+	PenaltyInvalidTx Penalty = 10
+)
+
+const (
+	// banThreshold is the score at which a peer is banned
+	banThreshold = -100
+
+	// scoreRecovery is the time it takes for a peer to recover one point
+	scoreRecovery = 30 * time.Second
+
+	// DefaultBanDuration is the duration a peer is banned for once its score is too low
+	DefaultBanDuration = time.Hour
+
+	// bannedPeersFile is the name of the file the banned peers are kept in
+	bannedPeersFile = "banned_peers.json"
+)
+
+var (
+	ErrPeerNotBanned = errors.New("peer is not banned")
+	ErrBanSelf       = errors.New("can't ban the node itself")
+)
+
+// BannedPeer is a peer which connections are refused until the ban expires
+type BannedPeer struct {
+	ID     peer.ID   `json:"id"`
+	Reason string    `json:"reason"`
+	Until  time.Time `json:"until"` // zero if the ban is permanent
+}
+
+// expired returns true if the ban is expired at the given time
+func (b *BannedPeer) expired(now time.Time) bool {
+	return !b.Until.IsZero() && !now.Before(b.Until)
+}
+
+// peerScore is the score of a peer, which recovers over time
+type peerScore struct {
+	score   int64
+	updated time.Time
+}
+
+// reputation keeps the scores of the peers and the list of the banned ones,
+// which is persisted to the given path (unless empty)
+type reputation struct {
+	lock   sync.Mutex
+	path   string
+	scores map[peer.ID]*peerScore
+	banned map[peer.ID]*BannedPeer
+
+	// the last time the recovered scores were pruned
+	pruned time.Time
+}
+
+func newReputation(path string) *reputation {
+	return &reputation{
+		path:   path,
+		scores: map[peer.ID]*peerScore{},
+		banned: map[peer.ID]*BannedPeer{},
+	}
+}
+
+// load reads the banned peers from the disk, skipping the expired bans
+func (r *reputation) load() error {
+	if r.path == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var banned []*BannedPeer
+	if err := json.Unmarshal(data, &banned); err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+
+	for _, b := range banned {
+		if !b.expired(now) {
+			r.banned[b.ID] = b
+		}
+	}
+
+	return nil
+}
+
+// save writes the banned peers to the disk. [not thread-safe]
+func (r *reputation) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	banned := make([]*BannedPeer, 0, len(r.banned))
+	for _, b := range r.banned {
+		banned = append(banned, b)
+	}
+
+	data, err := json.MarshalIndent(banned, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(r.path+".new", data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(r.path+".new", r.path)
+}
+
+// penalize lowers the score of the peer, returning the new score. [thread-safe]
+func (r *reputation) penalize(id peer.ID, penalty Penalty) int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+
+	if now.Sub(r.pruned) >= scoreRecovery {
+		r.prune(now)
+	}
+
+	s, ok := r.scores[id]
+	if !ok {
+		s = &peerScore{updated: now}
+		r.scores[id] = s
+	}
+
+	// recover the points earned since the last penalty
+	if recovered := int64(now.Sub(s.updated) / scoreRecovery); recovered > 0 {
+		s.score += recovered
+		s.updated = s.updated.Add(time.Duration(recovered) * scoreRecovery)
+
+		if s.score > 0 {
+			s.score = 0
+		}
+	}
+
+	s.score -= int64(penalty)
+
+	return s.score
+}
+
+// prune removes the scores which fully recovered at the given time,
+// as they are the same as the score of a new peer. [not thread-safe]
+func (r *reputation) prune(now time.Time) {
+	for id, s := range r.scores {
+		if s.score+int64(now.Sub(s.updated)/scoreRecovery) >= 0 {
+			delete(r.scores, id)
+		}
+	}
+
+	r.pruned = now
+}
+
+// ban bans the peer until the given time (forever if zero). [thread-safe]
+func (r *reputation) ban(id peer.ID, until time.Time, reason string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.banned[id] = &BannedPeer{
+		ID:     id,
+		Reason: reason,
+		Until:  until,
+	}
+
+	// the peer starts from scratch once unbanned
+	delete(r.scores, id)
+
+	return r.save()
+}
+
+// unban lifts the ban of the peer. [thread-safe]
+func (r *reputation) unban(id peer.ID) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.banned[id]; !ok {
+		return ErrPeerNotBanned
+	}
+
+	delete(r.banned, id)
+
+	return r.save()
+}
+
+// isBanned returns true if the peer is currently banned. [thread-safe]
+func (r *reputation) isBanned(id peer.ID) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, ok := r.banned[id]
+	if !ok {
+		return false
+	}
+
+	if b.expired(time.Now()) {
+		delete(r.banned, id)
+
+		// the expired ban is removed from the disk on the next update
+		return false
+	}
+
+	return true
+}
+
+// list returns the currently banned peers. [thread-safe]
+func (r *reputation) list() []*BannedPeer {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	banned := make([]*BannedPeer, 0, len(r.banned))
+
+	for _, b := range r.banned {
+		if !b.expired(now) {
+			banned = append(banned, b)
+		}
+	}
+
+	sort.Slice(banned, func(i, j int) bool {
+		return banned[i].ID < banned[j].ID
+	})
+
+	return banned
+}
+
+// bannedPeersPath returns the path of the banned peers file in the data dir
+func bannedPeersPath(dataDir string) string {
+	if dataDir == "" {
+		return ""
+	}
+
+	return filepath.Join(dataDir, bannedPeersFile)
+}
+
+// ReportPeer lowers the score of a misbehaving peer,
+// banning it for the default duration once the score is too low
+func (s *Server) ReportPeer(id peer.ID, penalty Penalty, reason string) {
+	if id == s.host.ID() {
+		// the messages published by the node are received back
+		return
+	}
+
+	score := s.reputation.penalize(id, penalty)
+
+	s.logger.Debug("peer reported", "id", id, "reason", reason, "score", score)
+
+	if score > banThreshold {
+		return
+	}
+
+	if err := s.BanPeer(id, DefaultBanDuration, reason); err != nil {
+		s.logger.Error("failed to ban peer", "id", id, "err", err)
+	}
+}
+
+// BanPeer disconnects from the peer and refuses its connections
+// for the given duration (forever if zero)
+func (s *Server) BanPeer(id peer.ID, duration time.Duration, reason string) error {
+	if id == s.host.ID() {
+		return ErrBanSelf
+	}
+
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+
+	if err := s.reputation.ban(id, until, reason); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer banned", "id", id, "reason", reason, "until", until)
+
+	s.Disconnect(id, "banned: "+reason)
+
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer
+func (s *Server) UnbanPeer(id peer.ID) error {
+	if err := s.reputation.unban(id); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer unbanned", "id", id)
+
+	return nil
+}
+
+// BannedPeers returns the currently banned peers
+func (s *Server) BannedPeers() []*BannedPeer {
+	return s.reputation.list()
+}
+
+// IsBanned returns true if the peer is currently banned
+func (s *Server) IsBanned(id peer.ID) bool {
+	return s.reputation.isBanned(id)
+}
@@ -0,0 +1,142 @@
+package network
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputation_Penalize(t *testing.T) {
+	r := newReputation("")
+	id := peer.ID("A")
+
+	assert.Equal(t, int64(-50), r.penalize(id, PenaltyInvalidBlock))
+	assert.Equal(t, int64(-60), r.penalize(id, PenaltyInvalidTx))
+
+	// the score recovers over time
+	r.scores[id].updated = r.scores[id].updated.Add(-10 * scoreRecovery)
+	assert.Equal(t, int64(-60), r.penalize(id, PenaltyInvalidTx))
+
+	// but never above zero
+	r.scores[id].updated = r.scores[id].updated.Add(-1000 * scoreRecovery)
+	assert.Equal(t, int64(-10), r.penalize(id, PenaltyInvalidTx))
+}
+
+func TestReputation_Prune(t *testing.T) {
+	r := newReputation("")
+	recovered, penalized := peer.ID("A"), peer.ID("B")
+
+	r.penalize(recovered, PenaltyInvalidTx)
+	r.penalize(penalized, PenaltyInvalidTx)
+
+	r.scores[recovered].updated = r.scores[recovered].updated.Add(-10 * scoreRecovery)
+	r.scores[penalized].updated = r.scores[penalized].updated.Add(-9 * scoreRecovery)
+
+	// the scores are pruned at most once per recovery period
+	r.penalize(peer.ID("C"), PenaltyInvalidTx)
+	assert.Len(t, r.scores, 3)
+
+	r.pruned = r.pruned.Add(-scoreRecovery)
+
+	// only the fully recovered scores are pruned
+	r.penalize(peer.ID("C"), PenaltyInvalidTx)
+	assert.Len(t, r.scores, 2)
+	assert.NotContains(t, r.scores, recovered)
+	assert.Contains(t, r.scores, penalized)
+}
+
+func newTestPeerID(t *testing.T) peer.ID {
+	t.Helper()
+
+	key, _, err := GenerateAndEncodeLibp2pKey()
+	assert.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	assert.NoError(t, err)
+
+	return id
+}
+
+func TestReputation_Ban(t *testing.T) {
+	path := filepath.Join(t.TempDir(), bannedPeersFile)
+	a, b, c := newTestPeerID(t), newTestPeerID(t), newTestPeerID(t)
+
+	// sort the peers like the ban list
+	if a > b {
+		a, b = b, a
+	}
+
+	r := newReputation(path)
+	assert.NoError(t, r.load())
+
+	assert.NoError(t, r.ban(a, time.Now().Add(time.Hour), "reason A"))
+	assert.NoError(t, r.ban(b, time.Time{}, "reason B"))
+	assert.NoError(t, r.ban(c, time.Now().Add(-time.Second), "expired"))
+
+	assert.True(t, r.isBanned(a))
+	assert.True(t, r.isBanned(b))
+	assert.False(t, r.isBanned(c))
+
+	// the ban list is persisted
+	loaded := newReputation(path)
+	assert.NoError(t, loaded.load())
+
+	banned := loaded.list()
+	assert.Len(t, banned, 2)
+	assert.Equal(t, a, banned[0].ID)
+	assert.Equal(t, "reason A", banned[0].Reason)
+	assert.Equal(t, b, banned[1].ID)
+	assert.True(t, banned[1].Until.IsZero())
+
+	assert.NoError(t, loaded.unban(a))
+	assert.ErrorIs(t, loaded.unban(a), ErrPeerNotBanned)
+	assert.False(t, loaded.isBanned(a))
+
+	reloaded := newReputation(path)
+	assert.NoError(t, reloaded.load())
+	assert.Len(t, reloaded.list(), 1)
+}
+
+func TestServer_ReportPeer(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	if joinErr := JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	id := servers[1].AddrInfo().ID
+
+	// the peer is banned once its score is too low
+	servers[0].ReportPeer(id, PenaltyInvalidBlock, "invalid block")
+	assert.False(t, servers[0].IsBanned(id))
+
+	servers[0].ReportPeer(id, PenaltyInvalidBlock, "invalid block")
+	assert.True(t, servers[0].IsBanned(id))
+
+	disconnectCtx, cancelFn := context.WithTimeout(context.Background(), DefaultLeaveTimeout)
+	defer cancelFn()
+
+	_, disconnectErr := WaitUntilPeerDisconnectsFrom(disconnectCtx, servers[0], id)
+	assert.NoError(t, disconnectErr)
+
+	// the banned peer can't connect
+	assert.Error(t, JoinAndWait(servers[1], servers[0], 5*time.Second, 5*time.Second))
+	assert.False(t, servers[0].hasPeer(id))
+
+	// unless unbanned
+	assert.NoError(t, servers[0].UnbanPeer(id))
+	assert.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	assert.ErrorIs(t, servers[0].BanPeer(servers[0].AddrInfo().ID, 0, "self"), ErrBanSelf)
+}
@@ -0,0 +1,113 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Roles of the node in a sentry topology
+const (
+	// RoleValidator hides the node behind its sentries: discovery is disabled
+	// and only the persistent peers (the sentries) can connect to it
+	RoleValidator = "validator"
+
+	// RoleSentry relays the traffic of the validators, listed in the private peers,
+	// which are never advertised to the rest of the network
+	RoleSentry = "sentry"
+)
+
+// persistentRedialInterval is the interval the disconnected persistent peers are redialed at
+var persistentRedialInterval = 10 * time.Second
+
+// applyRole adjusts the config to the role of the node
+func applyRole(config *Config) error {
+	switch config.Role {
+	case "":
+	case RoleValidator:
+		if len(config.PersistentPeers) == 0 {
+			return errors.New("the validator role requires the sentries as persistent peers")
+		}
+
+		config.NoDiscover = true
+		config.Allowlist = true
+
+		for _, info := range config.PersistentPeers {
+			config.AllowedPeers = append(config.AllowedPeers, info.ID)
+		}
+	case RoleSentry:
+		if len(config.PrivatePeers) == 0 {
+			return errors.New("the sentry role requires the validators as private peers")
+		}
+	default:
+		return fmt.Errorf("unknown network role %s", config.Role)
+	}
+
+	return nil
+}
+
+// isPersistent returns true if the peer is always redialed and never refused for
+// the lack of slots. The private peers are persistent too, as they are trusted
+func (s *Server) isPersistent(id peer.ID) bool {
+	if _, ok := s.persistentPeers[id]; ok {
+		return true
+	}
+
+	return s.isPrivate(id)
+}
+
+// isPrivate returns true if the peer is never advertised to the other peers
+func (s *Server) isPrivate(id peer.ID) bool {
+	_, ok := s.privatePeers[id]
+
+	return ok
+}
+
+// keepPersistentPeers dials the persistent peers, and redials them once disconnected
+func (s *Server) keepPersistentPeers() {
+	if len(s.persistentPeers) == 0 {
+		return
+	}
+
+	redialCh := make(chan struct{}, 1)
+
+	if err := s.SubscribeFn(func(evnt *PeerEvent) {
+		if evnt.Type == PeerDisconnected && s.isPersistent(evnt.PeerID) {
+			select {
+			case redialCh <- struct{}{}:
+			default:
+			}
+		}
+	}); err != nil {
+		s.logger.Error("failed to subscribe to the peer events", "err", err)
+	}
+
+	for {
+		for _, info := range s.persistentPeers {
+			if !s.hasPeer(info.ID) && !s.identity.isPending(info.ID) {
+				go s.dialPersistentPeer(*info)
+			}
+		}
+
+		select {
+		case <-time.After(persistentRedialInterval):
+		case <-redialCh:
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// dialPersistentPeer connects to the persistent peer,
+// bypassing the dial queue limited by the open slots
+func (s *Server) dialPersistentPeer(info peer.AddrInfo) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), persistentRedialInterval)
+	defer cancelFn()
+
+	if err := s.host.Connect(ctx, info); err != nil {
+		s.logger.Debug("failed to dial persistent peer", "id", info.ID, "err", err)
+	}
+}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRole(t *testing.T) {
+	sentry := &peer.AddrInfo{ID: newTestPeerID(t)}
+
+	assert.Error(t, applyRole(&Config{Role: RoleValidator}))
+	assert.Error(t, applyRole(&Config{Role: RoleSentry}))
+	assert.Error(t, applyRole(&Config{Role: "unknown"}))
+	assert.NoError(t, applyRole(&Config{}))
+
+	config := &Config{
+		Role:            RoleValidator,
+		PersistentPeers: []*peer.AddrInfo{sentry},
+	}
+	assert.NoError(t, applyRole(config))
+
+	// the validator only accepts the connections of its sentries
+	assert.True(t, config.NoDiscover)
+	assert.True(t, config.Allowlist)
+	assert.Equal(t, []peer.ID{sentry.ID}, config.AllowedPeers)
+}
+
+func TestServer_PersistentPeers(t *testing.T) {
+	sentry, createErr := CreateServer(nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create sentry, %v", createErr)
+	}
+
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {
+			ConfigCallback: func(c *Config) {
+				c.Role = RoleValidator
+				c.PersistentPeers = []*peer.AddrInfo{sentry.AddrInfo()}
+
+				// the persistent peers don't take any slot
+				c.MaxPeers = 0
+			},
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, append(servers, sentry))
+	})
+
+	validator := servers[0]
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelFn()
+
+	// the persistent peers are dialed on start
+	_, err := WaitUntilPeerConnectsTo(ctx, validator, sentry.AddrInfo().ID)
+	assert.NoError(t, err)
+
+	// and redialed once disconnected
+	conn := validator.host.Network().ConnsToPeer(sentry.AddrInfo().ID)[0]
+	validator.Disconnect(sentry.AddrInfo().ID, "test")
+
+	_, err = tests.RetryUntilTimeout(ctx, func() (interface{}, bool) {
+		conns := validator.host.Network().ConnsToPeer(sentry.AddrInfo().ID)
+
+		return nil, len(conns) == 0 || conns[0] == conn || !validator.hasPeer(sentry.AddrInfo().ID)
+	})
+	assert.NoError(t, err)
+
+	// the other peers can't connect to the validator
+	assert.Error(t, JoinAndWait(servers[1], validator, 5*time.Second, 5*time.Second))
+}
+
+func TestDiscovery_PrivatePeers(t *testing.T) {
+	servers, createErr := createServers(3, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	sentry, validator, other := servers[0], servers[1], servers[2]
+
+	// set before any connection
+	sentry.privatePeers[validator.AddrInfo().ID] = struct{}{}
+
+	for _, srv := range []*Server{validator, other} {
+		if joinErr := JoinAndWait(srv, sentry, DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+			t.Fatalf("Unable to join peers, %v", joinErr)
+		}
+	}
+
+	// the validator is not advertised by the sentry
+	nodes, err := other.discovery.findPeersCall(sentry.AddrInfo().ID)
+	assert.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	assert.NotContains(t, sentry.discovery.routingTable.ListPeers(), validator.AddrInfo().ID)
+	assert.Contains(t, sentry.discovery.routingTable.ListPeers(), other.AddrInfo().ID)
+}
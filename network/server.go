@@ -2,27 +2,27 @@ package network
 
 import (
 	"context"
-	"crypto/rand"
 	"errors"
 	"fmt"
-	"math/big"
 	"net"
 	"regexp"
 	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/network/dnsdisc"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p"
-	"github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/libp2p/go-libp2p-core/event"
-	"github.com/libp2p/go-libp2p-core/host"
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
-	"github.com/libp2p/go-libp2p-core/protocol"
-	noise "github.com/libp2p/go-libp2p-noise"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	noise "github.com/libp2p/go-libp2p/p2p/security/noise"
 	"github.com/multiformats/go-multiaddr"
 )
 
@@ -52,6 +52,32 @@ type Config struct {
 	Chain          *chain.Chain
 	SecretsManager secrets.SecretsManager
 	Metrics        *Metrics
+
+	// MaxInboundPeers and MaxOutboundPeers limit the connections
+	// in each direction, up to MaxPeers if zero
+	MaxInboundPeers  uint64
+	MaxOutboundPeers uint64
+
+	// DNSResolver resolves the DNS trees listed in the bootnodes, the system resolver if nil
+	DNSResolver dnsdisc.Resolver
+
+	// Allowlist restricts the connections to the allowed peers,
+	// initially the AllowedPeers and the peers of the node registry
+	Allowlist    bool
+	AllowedPeers []peer.ID
+
+	// NAT traversal, see natOptions
+	NATPortMap bool
+	Relay      bool
+	AutoRelay  bool
+
+	// holePunchFilter replaces the addresses exchanged when hole punching, set by the tests
+	holePunchFilter holepunch.AddrFilter
+
+	// Sentry topology, see applyRole
+	Role            string
+	PersistentPeers []*peer.AddrInfo
+	PrivatePeers    []peer.ID
 }
 
 func DefaultConfig() *Config {
@@ -94,12 +120,28 @@ type Server struct {
 	joinWatchersLock sync.Mutex
 
 	emitterPeerEvent event.Emitter
+
+	// scores of the peers and banned peers
+	reputation *reputation
+
+	// peers allowed to connect to the node
+	allowlist *allowlist
+
+	// reachability of the node detected by AutoNAT
+	reachability int32
+
+	// peers always redialed, and never advertised
+	persistentPeers map[peer.ID]*peer.AddrInfo
+	privatePeers    map[peer.ID]struct{}
 }
 
 type Peer struct {
 	srv *Server
 
 	Info peer.AddrInfo
+
+	// direction of the connection
+	direction network.Direction
 }
 
 // setupLibp2pKey is a helper method for setting up the networking private key
@@ -159,13 +201,43 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return addrs
 	}
 
+	if err := applyRole(config); err != nil {
+		return nil, err
+	}
+
+	reputation := newReputation(bannedPeersPath(config.DataDir))
+	if err := reputation.load(); err != nil {
+		return nil, fmt.Errorf("failed to load banned peers: %w", err)
+	}
+
+	allowlist := newAllowlist(config.Allowlist, allowedPeersPath(config.DataDir), config.AllowedPeers)
+	if err := allowlist.load(); err != nil {
+		return nil, fmt.Errorf("failed to load allowed peers: %w", err)
+	}
+
+	self, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	natOptions, err := natOptions(config, self)
+	if err != nil {
+		return nil, err
+	}
+
 	host, err := libp2p.New(
-		context.Background(),
-		// Use noise as the encryption protocol
-		libp2p.Security(noise.ID, noise.New),
-		libp2p.ListenAddrs(listenAddr),
-		libp2p.AddrsFactory(addrsFactory),
-		libp2p.Identity(key),
+		append([]libp2p.Option{
+			// Use noise as the encryption protocol
+			libp2p.Security(noise.ID, noise.New),
+			libp2p.ListenAddrs(listenAddr),
+			libp2p.AddrsFactory(addrsFactory),
+			libp2p.Identity(key),
+			// Refuse the connections of banned and not allowed peers
+			libp2p.ConnectionGater(&gater{
+				reputation: reputation,
+				allowlist:  allowlist,
+			}),
+		}, natOptions...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create libp2p stack: %w", err)
@@ -188,6 +260,18 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		emitterPeerEvent: emitter,
 		protocols:        map[string]Protocol{},
 		secretsManager:   config.SecretsManager,
+		reputation:       reputation,
+		allowlist:        allowlist,
+		persistentPeers:  map[peer.ID]*peer.AddrInfo{},
+		privatePeers:     map[peer.ID]struct{}{},
+	}
+
+	for _, info := range config.PersistentPeers {
+		srv.persistentPeers[info.ID] = info
+	}
+
+	for _, id := range config.PrivatePeers {
+		srv.privatePeers[id] = struct{}{}
 	}
 
 	// start identity
@@ -210,22 +294,34 @@ func (s *Server) Start() error {
 		return identityStartErr
 	}
 
+	if err := s.watchReachability(); err != nil {
+		return err
+	}
+
 	go s.runDial()
 	go s.checkPeerConnections()
+	go s.keepPersistentPeers()
 	s.logger.Info("LibP2P server running", "addr", AddrInfoToString(s.AddrInfo()))
 
 	if !s.config.NoDiscover {
-		if s.config.Chain.Bootnodes != nil && len(s.config.Chain.Bootnodes) < MinimumBootNodes {
-			return errors.New("minimum two bootnodes are required")
-		}
-
 		// start discovery
 		s.discovery = &discovery{srv: s}
 
-		// try to decode the bootnodes
+		// try to decode the bootnodes, and collect the URLs of the DNS trees
 		bootnodes := []*peer.AddrInfo{}
+		dnsTrees := []string{}
 
 		for _, raw := range s.config.Chain.Bootnodes {
+			if dnsdisc.IsURL(raw) {
+				if err := dnsdisc.ValidateURL(raw); err != nil {
+					return fmt.Errorf("failed to parse bootnode %s: %w", raw, err)
+				}
+
+				dnsTrees = append(dnsTrees, raw)
+
+				continue
+			}
+
 			node, err := StringToAddrInfo(raw)
 			if err != nil {
 				return fmt.Errorf("failed to parse bootnode %s: %w", raw, err)
@@ -240,7 +336,20 @@ func (s *Server) Start() error {
 			bootnodes = append(bootnodes, node)
 		}
 
-		if setupErr := s.discovery.setup(bootnodes); setupErr != nil {
+		// a DNS tree lists any number of bootnodes
+		if len(dnsTrees) == 0 && s.config.Chain.Bootnodes != nil && len(s.config.Chain.Bootnodes) < MinimumBootNodes {
+			return errors.New("minimum two bootnodes are required")
+		}
+
+		// the bootnodes are reachable even if not allowed by the other sources
+		bootnodeIDs := make([]peer.ID, len(bootnodes))
+		for i, node := range bootnodes {
+			bootnodeIDs[i] = node.ID
+		}
+
+		s.allowlist.setBootnodes(bootnodeIDs)
+
+		if setupErr := s.discovery.setup(bootnodes, dnsTrees); setupErr != nil {
 			return fmt.Errorf("unable to setup discovery, %w", setupErr)
 		}
 	}
@@ -254,6 +363,11 @@ func (s *Server) Start() error {
 	// watch for disconnected peers
 	s.host.Network().Notify(&network.NotifyBundle{
 		DisconnectedF: func(net network.Network, conn network.Conn) {
+			if conn.Stat().Limited {
+				// the peers are never added through a relay
+				return
+			}
+
 			go func() {
 				s.delPeer(conn.RemotePeer())
 			}()
@@ -273,10 +387,9 @@ func (s *Server) checkPeerConnections() {
 		}
 
 		if s.numPeers() < MinimumPeerConnections {
-			if s.config.NoDiscover || len(s.discovery.bootnodes) == 0 {
+			if s.config.NoDiscover {
 				//TODO: dial peers from the peerstore
-			} else {
-				randomNode := s.getRandomBootNode()
+			} else if randomNode := s.discovery.randomBootnode(); randomNode != nil {
 				s.addToDialQueue(randomNode, PriorityRandomDial)
 			}
 		}
@@ -308,7 +421,7 @@ func (s *Server) runDial() {
 		// TODO: Right now the dial task are done sequentially because Connect
 		// is a blocking request. In the future we should try to make up to
 		// maxDials requests concurrently.
-		for i := int64(0); i < s.numOpenSlots(); i++ {
+		for i := int64(0); i < s.numOpenSlots(network.DirOutbound); i++ {
 			tt := s.dialQueue.pop()
 			if tt == nil {
 				// dial closed
@@ -324,9 +437,16 @@ func (s *Server) runDial() {
 			} else {
 				// the connection process is async because it involves connection (here) +
 				// the handshake done in the identity service.
+				s.metrics.DialsAttempted.Add(1)
+
 				if err := s.host.Connect(context.Background(), *tt.addr); err != nil {
-					s.logger.Debug("failed to dial", "addr", tt.addr.String(), "err", err)
+					backoff := s.dialQueue.failed(tt.addr)
+
+					s.logger.Debug("failed to dial", "addr", tt.addr.String(), "err", err, "backoff", backoff)
+					s.metrics.DialsFailed.Add(1)
 					s.emitEvent(tt.addr.ID, PeerFailedToConnect)
+				} else {
+					s.dialQueue.succeeded(tt.addr)
 				}
 			}
 		}
@@ -348,12 +468,6 @@ func (s *Server) numPeers() int64 {
 	return int64(len(s.peers))
 }
 
-func (s *Server) getRandomBootNode() *peer.AddrInfo {
-	randNum, _ := rand.Int(rand.Reader, big.NewInt(int64(len(s.discovery.bootnodes))))
-
-	return s.discovery.bootnodes[randNum.Int64()]
-}
-
 func (s *Server) Peers() []*Peer {
 	s.peersLock.Lock()
 	defer s.peersLock.Unlock()
@@ -376,36 +490,33 @@ func (s *Server) hasPeer(peerID peer.ID) bool {
 	return ok
 }
 
-func (s *Server) numOpenSlots() int64 {
-	n := int64(s.config.MaxPeers) - (s.numPeers() + s.identity.numPending())
-	if n < 0 {
-		n = 0
-	}
-
-	return n
-}
-
 func (s *Server) isConnected(peerID peer.ID) bool {
 	return s.host.Network().Connectedness(peerID) == network.Connected
 }
 
 func (s *Server) GetProtocols(peerID peer.ID) ([]string, error) {
-	return s.host.Peerstore().GetProtocols(peerID)
+	protocols, err := s.host.Peerstore().GetProtocols(peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.ConvertToStrings(protocols), nil
 }
 
 func (s *Server) GetPeerInfo(peerID peer.ID) peer.AddrInfo {
 	return s.host.Peerstore().PeerInfo(peerID)
 }
 
-func (s *Server) addPeer(id peer.ID) {
-	s.logger.Info("Peer connected", "id", id.String())
+func (s *Server) addPeer(id peer.ID, direction network.Direction) {
+	s.logger.Info("Peer connected", "id", id.String(), "direction", direction)
 
 	s.peersLock.Lock()
 	defer s.peersLock.Unlock()
 
 	p := &Peer{
-		srv:  s,
-		Info: s.host.Peerstore().PeerInfo(id),
+		srv:       s,
+		Info:      s.host.Peerstore().PeerInfo(id),
+		direction: direction,
 	}
 	s.peers[id] = p
 
@@ -577,7 +688,12 @@ func (s *Server) AddrInfo() *peer.AddrInfo {
 }
 
 func (s *Server) addToDialQueue(addr *peer.AddrInfo, priority uint64) {
-	s.dialQueue.add(addr, priority)
+	if !s.dialQueue.add(addr, priority) {
+		s.metrics.DialsBackedOff.Add(1)
+
+		return
+	}
+
 	s.emitEvent(addr.ID, PeerAddedToDialQueue)
 }
 
@@ -600,7 +716,12 @@ type Subscription struct {
 func (s *Subscription) run() {
 	// convert interface{} to *PeerEvent channels
 	for {
-		evnt := <-s.sub.Out()
+		evnt, ok := <-s.sub.Out()
+		if !ok {
+			// the subscription was closed
+			return
+		}
+
 		if obj, ok := evnt.(PeerEvent); ok {
 			s.ch <- &obj
 		}
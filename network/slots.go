@@ -0,0 +1,86 @@
+package network
+
+import (
+	"github.com/libp2p/go-libp2p/core/network"
+)
+
+// DialRatio is the ratio of the peer slots reserved to the outbound connections,
+// so that the node dials diverse peers instead of being saturated by inbound ones
+const DialRatio = 3
+
+// maxPeers returns the maximum number of connections in the given direction
+func (s *Server) maxPeers(direction network.Direction) int64 {
+	limit := s.config.MaxInboundPeers
+	if direction == network.DirOutbound {
+		limit = s.config.MaxOutboundPeers
+	}
+
+	if limit == 0 || limit > s.config.MaxPeers {
+		limit = s.config.MaxPeers
+	}
+
+	return int64(limit)
+}
+
+// minOutboundPeers returns the number of slots
+// the inbound connections can't take from the outbound ones
+func (s *Server) minOutboundPeers() int64 {
+	min := int64(s.config.MaxPeers / DialRatio)
+	if max := s.maxPeers(network.DirOutbound); min > max {
+		min = max
+	}
+
+	return min
+}
+
+// numPeersDirection returns the number of connected peers in the given direction,
+// the persistent peers are not counted as they don't take any slot
+func (s *Server) numPeersDirection(direction network.Direction) int64 {
+	s.peersLock.Lock()
+	defer s.peersLock.Unlock()
+
+	num := int64(0)
+
+	for id, p := range s.peers {
+		if isOutbound(p.direction) == isOutbound(direction) && !s.isPersistent(id) {
+			num++
+		}
+	}
+
+	return num
+}
+
+// numOpenSlots returns the number of new connections accepted in the given direction
+func (s *Server) numOpenSlots(direction network.Direction) int64 {
+	inbound := s.numPeersDirection(network.DirInbound) + s.identity.numPending(network.DirInbound)
+	outbound := s.numPeersDirection(network.DirOutbound) + s.identity.numPending(network.DirOutbound)
+
+	n := int64(s.config.MaxPeers) - (inbound + outbound)
+
+	if isOutbound(direction) {
+		if limit := s.maxPeers(network.DirOutbound) - outbound; n > limit {
+			n = limit
+		}
+	} else {
+		// keep the slots reserved to the outbound connections
+		if reserved := s.minOutboundPeers() - outbound; reserved > 0 {
+			n -= reserved
+		}
+
+		if limit := s.maxPeers(network.DirInbound) - inbound; n > limit {
+			n = limit
+		}
+	}
+
+	if n < 0 {
+		n = 0
+	}
+
+	return n
+}
+
+// isOutbound returns true for the outbound connections,
+// the connections of unknown direction are considered inbound
+func isOutbound(direction network.Direction) bool {
+	return direction == network.DirOutbound
+}
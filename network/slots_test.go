@@ -0,0 +1,70 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_NumOpenSlots(t *testing.T) {
+	newServer := func(config *Config) *Server {
+		s := &Server{
+			config:          config,
+			peers:           map[peer.ID]*Peer{},
+			persistentPeers: map[peer.ID]*peer.AddrInfo{},
+			privatePeers:    map[peer.ID]struct{}{},
+		}
+		s.identity = &identity{srv: s}
+
+		return s
+	}
+
+	addPeers := func(s *Server, n int, direction network.Direction) {
+		for i := 0; i < n; i++ {
+			id := newTestPeerID(t)
+			s.peers[id] = &Peer{srv: s, direction: direction}
+		}
+	}
+
+	t.Run("outbound slots are reserved", func(t *testing.T) {
+		s := newServer(&Config{MaxPeers: 9})
+
+		addPeers(s, 5, network.DirInbound)
+
+		// 3 slots are reserved to the outbound connections
+		assert.Equal(t, int64(1), s.numOpenSlots(network.DirInbound))
+		assert.Equal(t, int64(4), s.numOpenSlots(network.DirOutbound))
+
+		addPeers(s, 1, network.DirInbound)
+		assert.Equal(t, int64(0), s.numOpenSlots(network.DirInbound))
+
+		addPeers(s, 3, network.DirOutbound)
+		assert.Equal(t, int64(0), s.numOpenSlots(network.DirOutbound))
+	})
+
+	t.Run("direction limits", func(t *testing.T) {
+		s := newServer(&Config{MaxPeers: 10, MaxInboundPeers: 2, MaxOutboundPeers: 3})
+
+		addPeers(s, 1, network.DirInbound)
+		addPeers(s, 1, network.DirOutbound)
+
+		assert.Equal(t, int64(1), s.numOpenSlots(network.DirInbound))
+		assert.Equal(t, int64(2), s.numOpenSlots(network.DirOutbound))
+
+		// the pending connections take a slot too
+		s.identity.setPending(newTestPeerID(t), network.DirOutbound)
+		assert.Equal(t, int64(1), s.numOpenSlots(network.DirOutbound))
+	})
+
+	t.Run("persistent peers don't take slots", func(t *testing.T) {
+		s := newServer(&Config{MaxPeers: 3})
+
+		id := newTestPeerID(t)
+		s.persistentPeers[id] = &peer.AddrInfo{ID: id}
+		s.peers[id] = &Peer{srv: s, direction: network.DirInbound}
+
+		assert.Equal(t, int64(2), s.numOpenSlots(network.DirInbound))
+	})
+}
@@ -0,0 +1,60 @@
+package noderegistry
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Gas limit used when querying the registry contract
+var queryGasLimit uint64 = 1000000
+
+type TxQueryHandler interface {
+	Apply(*types.Transaction) (*runtime.ExecutionResult, error)
+	GetNonce(types.Address) uint64
+}
+
+// QueryNodes reads the libp2p IDs of the registered nodes from the registry contract.
+func QueryNodes(t TxQueryHandler, registry types.Address) ([]string, error) {
+	method, ok := abis.NodeRegistryABI.Methods["nodes"]
+	if !ok {
+		return nil, errors.New("nodes method doesn't exist in the registry contract ABI")
+	}
+
+	res, err := t.Apply(&types.Transaction{
+		From:     types.ZeroAddress,
+		To:       &registry,
+		Value:    big.NewInt(0),
+		Input:    method.ID(),
+		GasPrice: big.NewInt(0),
+		Gas:      queryGasLimit,
+		Nonce:    t.GetNonce(types.ZeroAddress),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	decodedResults, err := method.Outputs.Decode(res.ReturnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results, ok := decodedResults.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed type assertion from decodedResults to map")
+	}
+
+	nodes, ok := results["0"].([]string)
+	if !ok {
+		return nil, errors.New("failed type assertion from results[0] to []string")
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,138 @@
+package noderegistry
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+type executor interface {
+	BeginTxn(parentRoot types.Hash, header *types.Header, coinbaseReceiver types.Address) (*state.Transition, error)
+}
+
+type blockchainInterface interface {
+	Header() *types.Header
+	SubscribeEvents() blockchain.Subscription
+}
+
+// peersSetter receives the nodes allowed by the registry
+type peersSetter interface {
+	SetRegistryPeers(ids []peer.ID)
+}
+
+// Watcher keeps the peers allowed by the network in sync with the nodes
+// held by the registry contract at the head of the chain.
+type Watcher struct {
+	logger hclog.Logger
+
+	registry   types.Address
+	executor   executor
+	blockchain blockchainInterface
+	peers      peersSetter
+
+	sub blockchain.Subscription
+}
+
+// NewWatcher creates a watcher of the nodes held by the given registry contract.
+func NewWatcher(
+	logger hclog.Logger,
+	registry types.Address,
+	executor executor,
+	blockchain blockchainInterface,
+	peers peersSetter,
+) *Watcher {
+	return &Watcher{
+		logger:     logger.Named("node-registry"),
+		registry:   registry,
+		executor:   executor,
+		blockchain: blockchain,
+		peers:      peers,
+	}
+}
+
+// Start updates the allowed peers from the current head,
+// and then on every new head of the chain. Returns an error
+// if the registry cannot be queried at the current head.
+func (w *Watcher) Start() error {
+	nodes, err := w.Nodes(w.blockchain.Header())
+	if err != nil {
+		return err
+	}
+
+	w.peers.SetRegistryPeers(nodes)
+
+	w.sub = w.blockchain.SubscribeEvents()
+
+	go func() {
+		for {
+			evnt := w.sub.GetEvent()
+			if evnt == nil {
+				return
+			}
+
+			if evnt.Type == blockchain.EventFork || len(evnt.NewChain) == 0 {
+				continue
+			}
+
+			w.update(evnt.NewChain[len(evnt.NewChain)-1])
+		}
+	}()
+
+	return nil
+}
+
+// Close stops watching the chain.
+func (w *Watcher) Close() {
+	if w.sub != nil {
+		w.sub.Close()
+	}
+}
+
+// update sets the nodes held by the registry at the state of the given header.
+// The previous nodes are kept if the registry cannot be queried
+func (w *Watcher) update(head *types.Header) {
+	nodes, err := w.Nodes(head)
+	if err != nil {
+		w.logger.Error("failed to query the nodes, keeping the previous ones", "registry", w.registry, "err", err)
+
+		return
+	}
+
+	w.peers.SetRegistryPeers(nodes)
+}
+
+// Nodes returns the libp2p IDs of the nodes held by the registry
+// at the state of the given header, skipping the invalid IDs.
+func (w *Watcher) Nodes(head *types.Header) ([]peer.ID, error) {
+	// the query must not be limited by the block gas limit
+	header := head.Copy()
+	header.Number++
+	header.GasLimit = queryGasLimit
+
+	transition, err := w.executor.BeginTxn(head.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := QueryNodes(transition, w.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]peer.ID, 0, len(list))
+
+	for _, raw := range list {
+		id, err := peer.Decode(raw)
+		if err != nil {
+			w.logger.Warn("invalid node ID in the registry", "id", raw, "err", err)
+
+			continue
+		}
+
+		nodes = append(nodes, id)
+	}
+
+	return nodes, nil
+}
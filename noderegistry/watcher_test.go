@@ -0,0 +1,170 @@
+package noderegistry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+var registry = types.StringToAddress("1300")
+
+// registryCode returns the code of a registry contract returning the given nodes
+func registryCode(t *testing.T, nodes []string) []byte {
+	t.Helper()
+
+	data, err := abis.NodeRegistryABI.Methods["nodes"].Outputs.Encode([]interface{}{nodes})
+	assert.NoError(t, err)
+
+	size := []byte{byte(len(data) >> 8), byte(len(data))}
+
+	code := []byte{
+		0x61, size[0], size[1], // PUSH2 size
+		0x60, 0x0e, // PUSH1 offset of the data
+		0x60, 0x00, // PUSH1 memory offset
+		0x39,                   // CODECOPY
+		0x61, size[0], size[1], // PUSH2 size
+		0x60, 0x00, // PUSH1 memory offset
+		0xf3, // RETURN
+	}
+
+	return append(code, data...)
+}
+
+func newTestPeerID(t *testing.T) peer.ID {
+	t.Helper()
+
+	key, _, err := network.GenerateAndEncodeLibp2pKey()
+	assert.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	assert.NoError(t, err)
+
+	return id
+}
+
+func newTestExecutor(t *testing.T, alloc map[types.Address]*chain.GenesisAccount) (*state.Executor, types.Hash) {
+	t.Helper()
+
+	executor := state.NewExecutor(
+		&chain.Params{Forks: chain.AllForksEnabled},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	executor.SetRuntime(evm.NewEVM())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.Hash{}
+		}
+	}
+
+	return executor, executor.WriteGenesis(alloc)
+}
+
+type mockBlockchain struct {
+	head *types.Header
+	sub  *blockchain.MockSubscription
+}
+
+func (m *mockBlockchain) Header() *types.Header {
+	return m.head
+}
+
+func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
+	return m.sub
+}
+
+type mockPeers struct {
+	updates chan []peer.ID
+}
+
+func (m *mockPeers) SetRegistryPeers(ids []peer.ID) {
+	m.updates <- ids
+}
+
+func TestWatcher_Nodes(t *testing.T) {
+	node := newTestPeerID(t)
+
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		registry: {
+			Code: registryCode(t, []string{node.String(), "invalid"}),
+		},
+	})
+
+	watcher := NewWatcher(hclog.NewNullLogger(), registry, executor, nil, nil)
+
+	// the invalid IDs are skipped
+	nodes, err := watcher.Nodes(&types.Header{StateRoot: root})
+	assert.NoError(t, err)
+	assert.Equal(t, []peer.ID{node}, nodes)
+}
+
+func TestWatcher_MissingRegistry(t *testing.T) {
+	executor, root := newTestExecutor(t, nil)
+
+	watcher := NewWatcher(hclog.NewNullLogger(), registry, executor, nil, nil)
+
+	_, err := watcher.Nodes(&types.Header{StateRoot: root})
+	assert.Error(t, err)
+}
+
+func TestWatcher_Start(t *testing.T) {
+	node := newTestPeerID(t)
+
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		registry: {
+			Code: registryCode(t, []string{node.String()}),
+		},
+	})
+
+	chain := &mockBlockchain{
+		head: &types.Header{StateRoot: root},
+		sub:  blockchain.NewMockSubscription(),
+	}
+	peers := &mockPeers{updates: make(chan []peer.ID, 2)}
+
+	watcher := NewWatcher(hclog.NewNullLogger(), registry, executor, chain, peers)
+	assert.NoError(t, watcher.Start())
+
+	// the peers are set from the current head
+	assert.Equal(t, []peer.ID{node}, <-peers.updates)
+
+	// and updated on every new head
+	chain.sub.Push(&blockchain.Event{
+		Type:     blockchain.EventHead,
+		NewChain: []*types.Header{{Number: 1, StateRoot: root}},
+	})
+
+	select {
+	case ids := <-peers.updates:
+		assert.Equal(t, []peer.ID{node}, ids)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the peers were not updated")
+	}
+}
+
+func TestWatcher_StartMissingRegistry(t *testing.T) {
+	executor, root := newTestExecutor(t, nil)
+
+	chain := &mockBlockchain{
+		head: &types.Header{StateRoot: root},
+		sub:  blockchain.NewMockSubscription(),
+	}
+	peers := &mockPeers{updates: make(chan []peer.ID, 1)}
+
+	watcher := NewWatcher(hclog.NewNullLogger(), registry, executor, chain, peers)
+
+	// the node does not start isolated from every peer
+	assert.Error(t, watcher.Start())
+	assert.Empty(t, peers.updates)
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/protocol/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	any "google.golang.org/protobuf/types/known/anypb"
@@ -680,6 +680,7 @@ func (s *Syncer) WatchSyncWithPeer(p *SyncPeer, handler func(b *types.Block) boo
 
 		if err := s.blockchain.WriteBlock(b); err != nil {
 			s.logger.Error("failed to write block", "err", err)
+			s.reportInvalidBlock(p.peer, err)
 
 			break
 		}
@@ -699,6 +700,14 @@ func (s *Syncer) logSyncPeerPopBlockError(err error, peer *SyncPeer) {
 	}
 }
 
+// reportInvalidBlock reports the peer which sent a block failing the validation
+func (s *Syncer) reportInvalidBlock(peerID peer.ID, err error) {
+	var invalidErr *blockchain.InvalidBlockError
+	if errors.As(err, &invalidErr) {
+		s.server.ReportPeer(peerID, network.PenaltyInvalidBlock, err.Error())
+	}
+}
+
 // BulkSyncWithPeer finds common ancestor with a peer and syncs block until latest block
 func (s *Syncer) BulkSyncWithPeer(p *SyncPeer, newBlockHandler func(block *types.Block)) error {
 	// find the common ancestor
@@ -753,6 +762,8 @@ func (s *Syncer) BulkSyncWithPeer(p *SyncPeer, newBlockHandler func(block *types
 			for _, slot := range sk.slots {
 				for _, block := range slot.blocks {
 					if err := s.blockchain.WriteBlock(block); err != nil {
+						s.reportInvalidBlock(p.peer, err)
+
 						return fmt.Errorf("failed to write bulk sync blocks: %w", err)
 					}
 
@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Role is a set of permissions on the gRPC operator services
+type Role string
+
+const (
+	// RoleReadOnly allows the queries and subscriptions
+	RoleReadOnly Role = "read-only"
+
+	// RolePeerAdmin allows managing the peers of the node
+	RolePeerAdmin Role = "peer-admin"
+
+	// RoleConsensusAdmin allows voting on the validator set
+	RoleConsensusAdmin Role = "consensus-admin"
+
+	// RoleTxPoolAdmin allows adding and dropping transactions, and reloading the admission policy
+	RoleTxPoolAdmin Role = "txpool-admin"
+
+	// RoleAdmin allows every call
+	RoleAdmin Role = "admin"
+)
+
+// authorizationHeader is the metadata key of the bearer tokens
+const authorizationHeader = "authorization"
+
+// methodRoles is the role required by each RPC of the operator services.
+// A call to a method which is not listed here is always denied
+var methodRoles = map[string]Role{
+	// System
+	"/v1.System/GetStatus":        RoleReadOnly,
+	"/v1.System/Subscribe":        RoleReadOnly,
+	"/v1.System/PeersList":        RoleReadOnly,
+	"/v1.System/PeersStatus":      RoleReadOnly,
+	"/v1.System/PeersListBanned":  RoleReadOnly,
+	"/v1.System/PeersListAllowed": RoleReadOnly,
+	"/v1.System/PeersAdd":         RolePeerAdmin,
+	"/v1.System/PeersRemove":      RolePeerAdmin,
+	"/v1.System/PeersBan":         RolePeerAdmin,
+	"/v1.System/PeersUnban":       RolePeerAdmin,
+	"/v1.System/PeersAllow":       RolePeerAdmin,
+	"/v1.System/PeersDisallow":    RolePeerAdmin,
+
+	// TxnPoolOperator
+	"/v1.TxnPoolOperator/Status":                RoleReadOnly,
+	"/v1.TxnPoolOperator/Subscribe":             RoleReadOnly,
+	"/v1.TxnPoolOperator/ListTxns":              RoleReadOnly,
+	"/v1.TxnPoolOperator/GetTxn":                RoleReadOnly,
+	"/v1.TxnPoolOperator/AddTxn":                RoleTxPoolAdmin,
+	"/v1.TxnPoolOperator/AddPrivateTxn":         RoleTxPoolAdmin,
+	"/v1.TxnPoolOperator/DropTxn":               RoleTxPoolAdmin,
+	"/v1.TxnPoolOperator/DropAccount":           RoleTxPoolAdmin,
+	"/v1.TxnPoolOperator/ReloadAdmissionPolicy": RoleTxPoolAdmin,
+
+	// IbftOperator
+	"/v1.IbftOperator/GetSnapshot": RoleReadOnly,
+	"/v1.IbftOperator/Candidates":  RoleReadOnly,
+	"/v1.IbftOperator/Status":      RoleReadOnly,
+	"/v1.IbftOperator/Propose":     RoleConsensusAdmin,
+}
+
+var (
+	ErrUnknownRole     = errors.New("unknown role")
+	ErrEmptyCredential = errors.New("empty credential")
+)
+
+// AuthPolicy maps the credentials of the gRPC clients to their roles
+type AuthPolicy struct {
+	// Tokens are the bearer tokens sent in the authorization metadata
+	Tokens []*AuthCredential `json:"tokens"`
+
+	// Identities are the common names of the client certificates, verified by mutual TLS
+	Identities []*AuthCredential `json:"identities"`
+
+	// Anonymous are the roles of the clients without any known credential
+	Anonymous []Role `json:"anonymous"`
+}
+
+// AuthCredential is a credential and its roles. The name identifies
+// the holder of the credential in the audit logs
+type AuthCredential struct {
+	Name    string `json:"name"`
+	Token   string `json:"token,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	Roles   []Role `json:"roles"`
+}
+
+// LoadAuthPolicy reads the JSON encoded policy at the path
+func LoadAuthPolicy(path string) (*AuthPolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the authorization policy: %w", err)
+	}
+
+	policy := &AuthPolicy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse the authorization policy: %w", err)
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *AuthPolicy) validate() error {
+	validRoles := func(roles []Role) error {
+		for _, role := range roles {
+			switch role {
+			case RoleReadOnly, RolePeerAdmin, RoleConsensusAdmin, RoleTxPoolAdmin, RoleAdmin:
+			default:
+				return fmt.Errorf("%w: %s", ErrUnknownRole, role)
+			}
+		}
+
+		return nil
+	}
+
+	for _, c := range p.Tokens {
+		if c.Token == "" {
+			return fmt.Errorf("%w: token of %s", ErrEmptyCredential, c.Name)
+		}
+
+		if err := validRoles(c.Roles); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range p.Identities {
+		if c.Subject == "" {
+			return fmt.Errorf("%w: subject of %s", ErrEmptyCredential, c.Name)
+		}
+
+		if err := validRoles(c.Roles); err != nil {
+			return err
+		}
+	}
+
+	return validRoles(p.Anonymous)
+}
+
+// principal is the authenticated caller of an RPC
+type principal struct {
+	name  string
+	roles []Role
+}
+
+// hasRole checks if the principal is granted the role. Any role grants read-only access
+func (p *principal) hasRole(required Role) bool {
+	for _, role := range p.roles {
+		if role == required || role == RoleAdmin || required == RoleReadOnly {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authorizer checks the calls to the gRPC server against the policy
+type authorizer struct {
+	logger hclog.Logger
+	policy *AuthPolicy
+}
+
+func newAuthorizer(logger hclog.Logger, policy *AuthPolicy) *authorizer {
+	return &authorizer{
+		logger: logger.Named("audit"),
+		policy: policy,
+	}
+}
+
+// authorize checks that the caller is granted the role of the method, audit logging the denied calls
+func (a *authorizer) authorize(ctx context.Context, method string) error {
+	caller, err := a.authenticate(ctx)
+	if err != nil {
+		a.deny(ctx, method, "unknown", err.Error())
+
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	required, ok := methodRoles[method]
+	if !ok {
+		a.deny(ctx, method, caller.name, "no permission annotation")
+
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed", method)
+	}
+
+	if !caller.hasRole(required) {
+		a.deny(ctx, method, caller.name, fmt.Sprintf("missing role %s", required))
+
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s role", method, required)
+	}
+
+	a.logger.Debug("call allowed", "method", method, "principal", caller.name)
+
+	return nil
+}
+
+func (a *authorizer) deny(ctx context.Context, method, name, reason string) {
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	a.logger.Warn("call denied", "method", method, "principal", name, "remote", addr, "reason", reason)
+}
+
+// authenticate returns the principal of the bearer token, or else of the verified
+// client certificate. Callers without any known credential are anonymous
+func (a *authorizer) authenticate(ctx context.Context) (*principal, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			// the tokens sent in plaintext over the network are not accepted
+			if !isSecureTransport(ctx) {
+				return nil, errors.New("token sent without TLS from a remote host")
+			}
+
+			token := strings.TrimPrefix(values[0], "Bearer ")
+
+			for _, c := range a.policy.Tokens {
+				if subtle.ConstantTimeCompare([]byte(c.Token), []byte(token)) == 1 {
+					return &principal{name: c.Name, roles: c.Roles}, nil
+				}
+			}
+
+			return nil, errors.New("invalid token")
+		}
+	}
+
+	if subject := clientSubject(ctx); subject != "" {
+		for _, c := range a.policy.Identities {
+			if c.Subject == subject {
+				return &principal{name: c.Name, roles: c.Roles}, nil
+			}
+		}
+	}
+
+	return &principal{name: "anonymous", roles: a.policy.Anonymous}, nil
+}
+
+// isSecureTransport returns a flag indicating if the call was received over TLS,
+// or from the local host
+func isSecureTransport(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	if _, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		return true
+	}
+
+	switch addr := p.Addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP.IsLoopback()
+	case *net.UnixAddr:
+		return true
+	default:
+		return false
+	}
+}
+
+// clientSubject returns the common name of the client certificate verified by mutual TLS, if any
+func clientSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func (a *authorizer) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *authorizer) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := a.authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	ibftProto "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	txpoolProto "github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodRoles_Annotated(t *testing.T) {
+	services := []grpc.ServiceDesc{
+		proto.System_ServiceDesc,
+		txpoolProto.TxnPoolOperator_ServiceDesc,
+		ibftProto.IbftOperator_ServiceDesc,
+	}
+
+	for _, desc := range services {
+		for _, method := range desc.Methods {
+			fullMethod := "/" + desc.ServiceName + "/" + method.MethodName
+
+			_, ok := methodRoles[fullMethod]
+			assert.True(t, ok, "%s has no permission annotation", fullMethod)
+		}
+
+		for _, stream := range desc.Streams {
+			fullMethod := "/" + desc.ServiceName + "/" + stream.StreamName
+
+			_, ok := methodRoles[fullMethod]
+			assert.True(t, ok, "%s has no permission annotation", fullMethod)
+		}
+	}
+}
+
+// withToken returns the context of a call with the token, from the local host
+func withToken(token string) context.Context {
+	return withRemoteToken(token, &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000}, nil)
+}
+
+func withRemoteToken(token string, addr net.Addr, authInfo credentials.AuthInfo) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: authInfo})
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, "Bearer "+token))
+}
+
+func withSubject(subject string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: subject}}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+}
+
+func TestAuthorizer(t *testing.T) {
+	auth := newAuthorizer(hclog.NewNullLogger(), &AuthPolicy{
+		Tokens: []*AuthCredential{
+			{Name: "monitoring", Token: "monitoring-token", Roles: []Role{RoleReadOnly}},
+			{Name: "operator", Token: "operator-token", Roles: []Role{RolePeerAdmin, RoleTxPoolAdmin}},
+			{Name: "root", Token: "root-token", Roles: []Role{RoleAdmin}},
+		},
+		Identities: []*AuthCredential{
+			{Name: "validator", Subject: "validator.example.org", Roles: []Role{RoleConsensusAdmin}},
+		},
+	})
+
+	cases := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"anonymous denied", context.Background(), "/v1.System/GetStatus", codes.PermissionDenied},
+		{"invalid token", withToken("unknown"), "/v1.System/GetStatus", codes.Unauthenticated},
+		{"read-only query", withToken("monitoring-token"), "/v1.TxnPoolOperator/Status", codes.OK},
+		{"read-only write", withToken("monitoring-token"), "/v1.System/PeersAdd", codes.PermissionDenied},
+		{"admin roles imply read-only", withToken("operator-token"), "/v1.IbftOperator/Status", codes.OK},
+		{"peer admin", withToken("operator-token"), "/v1.System/PeersBan", codes.OK},
+		{"txpool admin", withToken("operator-token"), "/v1.TxnPoolOperator/DropTxn", codes.OK},
+		{"missing consensus role", withToken("operator-token"), "/v1.IbftOperator/Propose", codes.PermissionDenied},
+		{"mTLS identity", withSubject("validator.example.org"), "/v1.IbftOperator/Propose", codes.OK},
+		{"unknown mTLS identity", withSubject("other.example.org"), "/v1.IbftOperator/Propose", codes.PermissionDenied},
+		{"admin", withToken("root-token"), "/v1.IbftOperator/Propose", codes.OK},
+		{"unannotated method", withToken("root-token"), "/v1.System/Unknown", codes.PermissionDenied},
+		{
+			"remote token without TLS",
+			withRemoteToken("root-token", &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}, nil),
+			"/v1.System/GetStatus",
+			codes.Unauthenticated,
+		},
+		{
+			"remote token over TLS",
+			withRemoteToken("root-token", &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}, credentials.TLSInfo{}),
+			"/v1.System/GetStatus",
+			codes.OK,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+
+				return nil, nil
+			}
+
+			_, err := auth.unaryInterceptor(c.ctx, nil, &grpc.UnaryServerInfo{FullMethod: c.method}, handler)
+
+			assert.Equal(t, c.code, status.Code(err))
+			assert.Equal(t, c.code == codes.OK, called)
+		})
+	}
+}
+
+func TestLoadAuthPolicy(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), "auth.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+		return path
+	}
+
+	policy, err := LoadAuthPolicy(write(t, `{
+		"tokens": [{"name": "monitoring", "token": "secret", "roles": ["read-only"]}],
+		"identities": [{"name": "ops", "subject": "ops.example.org", "roles": ["peer-admin", "txpool-admin"]}],
+		"anonymous": ["read-only"]
+	}`))
+	assert.NoError(t, err)
+	assert.Len(t, policy.Tokens, 1)
+	assert.Len(t, policy.Identities, 1)
+	assert.Equal(t, []Role{RoleReadOnly}, policy.Anonymous)
+
+	_, err = LoadAuthPolicy(write(t, `{"anonymous": ["superuser"]}`))
+	assert.ErrorIs(t, err, ErrUnknownRole)
+
+	_, err = LoadAuthPolicy(write(t, `{"tokens": [{"name": "monitoring", "roles": ["read-only"]}]}`))
+	assert.ErrorIs(t, err, ErrEmptyCredential)
+}
+
+func TestJSONRPCHub_Authorize(t *testing.T) {
+	// no policy, as the gRPC server
+	hub := &jsonRPCHub{}
+	assert.NoError(t, hub.Authorize("/v1.System/PeersRemove"))
+
+	// the JSON-RPC callers have the roles of the anonymous gRPC callers
+	hub.authorizer = newAuthorizer(hclog.NewNullLogger(), &AuthPolicy{
+		Tokens: []*AuthCredential{
+			{Name: "root", Token: "root-token", Roles: []Role{RoleAdmin}},
+		},
+		Anonymous: []Role{RoleReadOnly},
+	})
+
+	assert.NoError(t, hub.Authorize("/v1.System/PeersList"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(hub.Authorize("/v1.System/PeersRemove")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(hub.Authorize("/v1.IbftOperator/Propose")))
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"net"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
@@ -23,8 +24,59 @@ type Config struct {
 	DataDir        string
 	Seal           bool
 	PriceLimit     uint64
+	PriceBump      uint64
 	MaxSlots       uint64
 	SecretsManager *secrets.SecretsManagerConfig
+
+	// MaxAccountEnqueued and MaxAccountPromoted limit the transactions per account in the pool
+	MaxAccountEnqueued uint64
+	MaxAccountPromoted uint64
+
+	// EnqueuedLifetime is the time enqueued transactions with nonce gaps are kept in the pool
+	EnqueuedLifetime time.Duration
+
+	// NoJournal disables the journal of local transactions, rotated every JournalRotate
+	NoJournal     bool
+	JournalRotate time.Duration
+
+	// LegacyTxGossip publishes full transactions on the legacy txpool gossip topic
+	// in addition to the hash announcements
+	LegacyTxGossip bool
+
+	// PrivateTxLifetime is the number of blocks private transactions are kept in the pool
+	PrivateTxLifetime uint64
+
+	// AdmissionPolicyFile is the local file of the transaction admission policy
+	AdmissionPolicyFile string
+
+	// EnablePersonal exposes the personal JSON-RPC namespace for managing the node accounts
+	EnablePersonal bool
+
+	// EnableAdmin exposes the admin and ibft JSON-RPC namespaces for managing the node
+	EnableAdmin bool
+
+	// JSONRPCAllowedOrigins are the origins of the browser requests allowed
+	// once the personal or admin namespaces are exposed
+	JSONRPCAllowedOrigins []string
+
+	// GraphQLAddr is the address of the GraphQL server, which is disabled if not set
+	GraphQLAddr          *net.TCPAddr
+	GraphQLMaxDepth      uint64
+	GraphQLMaxComplexity uint64
+
+	// TLS of the gRPC operator server, plaintext if not set.
+	// The clients must present a certificate signed by GRPCTLSClientCA, if set
+	GRPCTLSCert     string
+	GRPCTLSKey      string
+	GRPCTLSClientCA string
+
+	// TLS of the JSON-RPC (HTTP and WS) and GraphQL servers, plaintext if not set
+	JSONRPCTLSCert string
+	JSONRPCTLSKey  string
+
+	// GRPCAuthFile is the path to the policy mapping the credentials of the gRPC
+	// clients to their roles. All the calls are allowed if not set
+	GRPCAuthFile string
 }
 
 // DefaultConfig returns the default config for JSON-RPC, GRPC (ports) and Networking
@@ -4,10 +4,10 @@ package proto
 
 import (
 	context "context"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,15 +20,29 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SystemClient interface {
 	// GetInfo returns info about the client
-	GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServerStatus, error)
+	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerStatus, error)
 	// PeersAdd adds a new peer
-	PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersRemove disconnects from a peer
+	PeersRemove(ctx context.Context, in *PeersRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// PeersList returns the list of peers
-	PeersList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeersListResponse, error)
+	PeersList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListResponse, error)
 	// PeersInfo returns the info of a peer
 	PeersStatus(ctx context.Context, in *PeersStatusRequest, opts ...grpc.CallOption) (*Peer, error)
+	// PeersBan disconnects from a peer and refuses its connections
+	PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersUnban lifts the ban of a peer
+	PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersListBanned returns the list of banned peers
+	PeersListBanned(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListBannedResponse, error)
+	// PeersAllow adds a peer to the allowlist
+	PeersAllow(ctx context.Context, in *PeersAllowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersDisallow removes a peer added to the allowlist at runtime
+	PeersDisallow(ctx context.Context, in *PeersDisallowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersListAllowed returns the allowlist
+	PeersListAllowed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListAllowedResponse, error)
 	// Subscribe subscribes to blockchain events
-	Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error)
+	Subscribe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error)
 }
 
 type systemClient struct {
@@ -39,7 +53,7 @@ func NewSystemClient(cc grpc.ClientConnInterface) SystemClient {
 	return &systemClient{cc}
 }
 
-func (c *systemClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServerStatus, error) {
+func (c *systemClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerStatus, error) {
 	out := new(ServerStatus)
 	err := c.cc.Invoke(ctx, "/v1.System/GetStatus", in, out, opts...)
 	if err != nil {
@@ -48,8 +62,8 @@ func (c *systemClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...g
 	return out, nil
 }
 
-func (c *systemClient) PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
+func (c *systemClient) PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/v1.System/PeersAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -57,7 +71,16 @@ func (c *systemClient) PeersAdd(ctx context.Context, in *PeersAddRequest, opts .
 	return out, nil
 }
 
-func (c *systemClient) PeersList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeersListResponse, error) {
+func (c *systemClient) PeersRemove(ctx context.Context, in *PeersRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListResponse, error) {
 	out := new(PeersListResponse)
 	err := c.cc.Invoke(ctx, "/v1.System/PeersList", in, out, opts...)
 	if err != nil {
@@ -75,7 +98,61 @@ func (c *systemClient) PeersStatus(ctx context.Context, in *PeersStatusRequest,
 	return out, nil
 }
 
-func (c *systemClient) Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error) {
+func (c *systemClient) PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersUnban", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersListBanned(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListBannedResponse, error) {
+	out := new(PeersListBannedResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersListBanned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersAllow(ctx context.Context, in *PeersAllowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersAllow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersDisallow(ctx context.Context, in *PeersDisallowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersDisallow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersListAllowed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListAllowedResponse, error) {
+	out := new(PeersListAllowedResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersListAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) Subscribe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[0], "/v1.System/Subscribe", opts...)
 	if err != nil {
 		return nil, err
@@ -112,15 +189,29 @@ func (x *systemSubscribeClient) Recv() (*BlockchainEvent, error) {
 // for forward compatibility
 type SystemServer interface {
 	// GetInfo returns info about the client
-	GetStatus(context.Context, *empty.Empty) (*ServerStatus, error)
+	GetStatus(context.Context, *emptypb.Empty) (*ServerStatus, error)
 	// PeersAdd adds a new peer
-	PeersAdd(context.Context, *PeersAddRequest) (*empty.Empty, error)
+	PeersAdd(context.Context, *PeersAddRequest) (*emptypb.Empty, error)
+	// PeersRemove disconnects from a peer
+	PeersRemove(context.Context, *PeersRemoveRequest) (*emptypb.Empty, error)
 	// PeersList returns the list of peers
-	PeersList(context.Context, *empty.Empty) (*PeersListResponse, error)
+	PeersList(context.Context, *emptypb.Empty) (*PeersListResponse, error)
 	// PeersInfo returns the info of a peer
 	PeersStatus(context.Context, *PeersStatusRequest) (*Peer, error)
+	// PeersBan disconnects from a peer and refuses its connections
+	PeersBan(context.Context, *PeersBanRequest) (*emptypb.Empty, error)
+	// PeersUnban lifts the ban of a peer
+	PeersUnban(context.Context, *PeersUnbanRequest) (*emptypb.Empty, error)
+	// PeersListBanned returns the list of banned peers
+	PeersListBanned(context.Context, *emptypb.Empty) (*PeersListBannedResponse, error)
+	// PeersAllow adds a peer to the allowlist
+	PeersAllow(context.Context, *PeersAllowRequest) (*emptypb.Empty, error)
+	// PeersDisallow removes a peer added to the allowlist at runtime
+	PeersDisallow(context.Context, *PeersDisallowRequest) (*emptypb.Empty, error)
+	// PeersListAllowed returns the allowlist
+	PeersListAllowed(context.Context, *emptypb.Empty) (*PeersListAllowedResponse, error)
 	// Subscribe subscribes to blockchain events
-	Subscribe(*empty.Empty, System_SubscribeServer) error
+	Subscribe(*emptypb.Empty, System_SubscribeServer) error
 	mustEmbedUnimplementedSystemServer()
 }
 
@@ -128,19 +219,40 @@ type SystemServer interface {
 type UnimplementedSystemServer struct {
 }
 
-func (UnimplementedSystemServer) GetStatus(context.Context, *empty.Empty) (*ServerStatus, error) {
+func (UnimplementedSystemServer) GetStatus(context.Context, *emptypb.Empty) (*ServerStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
-func (UnimplementedSystemServer) PeersAdd(context.Context, *PeersAddRequest) (*empty.Empty, error) {
+func (UnimplementedSystemServer) PeersAdd(context.Context, *PeersAddRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeersAdd not implemented")
 }
-func (UnimplementedSystemServer) PeersList(context.Context, *empty.Empty) (*PeersListResponse, error) {
+func (UnimplementedSystemServer) PeersRemove(context.Context, *PeersRemoveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersRemove not implemented")
+}
+func (UnimplementedSystemServer) PeersList(context.Context, *emptypb.Empty) (*PeersListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeersList not implemented")
 }
 func (UnimplementedSystemServer) PeersStatus(context.Context, *PeersStatusRequest) (*Peer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeersStatus not implemented")
 }
-func (UnimplementedSystemServer) Subscribe(*empty.Empty, System_SubscribeServer) error {
+func (UnimplementedSystemServer) PeersBan(context.Context, *PeersBanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersBan not implemented")
+}
+func (UnimplementedSystemServer) PeersUnban(context.Context, *PeersUnbanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersUnban not implemented")
+}
+func (UnimplementedSystemServer) PeersListBanned(context.Context, *emptypb.Empty) (*PeersListBannedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersListBanned not implemented")
+}
+func (UnimplementedSystemServer) PeersAllow(context.Context, *PeersAllowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersAllow not implemented")
+}
+func (UnimplementedSystemServer) PeersDisallow(context.Context, *PeersDisallowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersDisallow not implemented")
+}
+func (UnimplementedSystemServer) PeersListAllowed(context.Context, *emptypb.Empty) (*PeersListAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersListAllowed not implemented")
+}
+func (UnimplementedSystemServer) Subscribe(*emptypb.Empty, System_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
 func (UnimplementedSystemServer) mustEmbedUnimplementedSystemServer() {}
@@ -157,7 +269,7 @@ func RegisterSystemServer(s grpc.ServiceRegistrar, srv SystemServer) {
 }
 
 func _System_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -169,7 +281,7 @@ func _System_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: "/v1.System/GetStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SystemServer).GetStatus(ctx, req.(*empty.Empty))
+		return srv.(SystemServer).GetStatus(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -192,8 +304,26 @@ func _System_PeersAdd_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _System_PeersRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersRemove(ctx, req.(*PeersRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _System_PeersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -205,7 +335,7 @@ func _System_PeersList_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: "/v1.System/PeersList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SystemServer).PeersList(ctx, req.(*empty.Empty))
+		return srv.(SystemServer).PeersList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -228,8 +358,116 @@ func _System_PeersStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _System_PeersBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersBan(ctx, req.(*PeersBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersUnban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersUnbanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersUnban(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersUnban",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersUnban(ctx, req.(*PeersUnbanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersListBanned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersListBanned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersListBanned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersListBanned(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersAllow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersAllowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersAllow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersAllow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersAllow(ctx, req.(*PeersAllowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersDisallow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersDisallowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersDisallow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersDisallow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersDisallow(ctx, req.(*PeersDisallowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersListAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersListAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersListAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersListAllowed(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _System_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -264,6 +502,10 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PeersAdd",
 			Handler:    _System_PeersAdd_Handler,
 		},
+		{
+			MethodName: "PeersRemove",
+			Handler:    _System_PeersRemove_Handler,
+		},
 		{
 			MethodName: "PeersList",
 			Handler:    _System_PeersList_Handler,
@@ -272,6 +514,30 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PeersStatus",
 			Handler:    _System_PeersStatus_Handler,
 		},
+		{
+			MethodName: "PeersBan",
+			Handler:    _System_PeersBan_Handler,
+		},
+		{
+			MethodName: "PeersUnban",
+			Handler:    _System_PeersUnban_Handler,
+		},
+		{
+			MethodName: "PeersListBanned",
+			Handler:    _System_PeersListBanned_Handler,
+		},
+		{
+			MethodName: "PeersAllow",
+			Handler:    _System_PeersAllow_Handler,
+		},
+		{
+			MethodName: "PeersDisallow",
+			Handler:    _System_PeersDisallow_Handler,
+		},
+		{
+			MethodName: "PeersListAllowed",
+			Handler:    _System_PeersListAllowed_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -280,5 +546,5 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 		},
 	},
-	Metadata: "minimal/proto/system.proto",
+	Metadata: "system.proto",
 }
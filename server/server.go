@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,17 +10,24 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/accounts"
+	"github.com/0xPolygon/polygon-edge/admission"
 	"github.com/0xPolygon/polygon-edge/chain"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/helper/tlsutil"
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/noderegistry"
 	"github.com/0xPolygon/polygon-edge/protocol"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/sponsor"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool"
@@ -29,6 +37,7 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
@@ -57,37 +66,71 @@ type Server struct {
 	// jsonrpc stack
 	jsonrpcServer *jsonrpc.JSONRPC
 
+	// graphql server, nil if disabled
+	graphqlServer *jsonrpc.GraphQL
+
 	// system grpc server
 	grpcServer *grpc.Server
 
+	// authorizer of the calls to the operator services, nil if no policy is set
+	authorizer *authorizer
+
 	// libp2p network
 	network *network.Server
 
 	// transaction pool
 	txpool *txpool.TxPool
 
+	// admission policy of permissioned chains, nil if disabled
+	admission *admission.Manager
+
+	// sponsors of fee delegated transactions, nil if disabled
+	sponsors *sponsor.Whitelist
+
+	// watcher of the nodes allowed by the node registry, nil if disabled
+	nodeRegistry *noderegistry.Watcher
+
 	serverMetrics *serverMetrics
 
 	prometheusServer *http.Server
 	// secrets manager
 	secretsManager secrets.SecretsManager
+
+	// keystore of the node-managed user accounts
+	keystore *accounts.Keystore
 }
 
 var dirPaths = []string{
 	"blockchain",
-	"keystore",
 	"trie",
+	"txpool",
 }
 
 // NewServer creates a new Minimal server, using the passed in configuration
 func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 	m := &Server{
-		logger:     logger,
-		config:     config,
-		chain:      config.Chain,
-		grpcServer: grpc.NewServer(),
+		logger: logger,
+		config: config,
+		chain:  config.Chain,
+	}
+
+	// the calls are authorized only if a policy is set
+	if config.GRPCAuthFile != "" {
+		policy, err := LoadAuthPolicy(config.GRPCAuthFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up the gRPC server: %w", err)
+		}
+
+		m.authorizer = newAuthorizer(logger, policy)
+	}
+
+	grpcServer, err := newGRPCServer(config, m.authorizer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the gRPC server: %w", err)
 	}
 
+	m.grpcServer = grpcServer
+
 	m.logger.Info("Data dir", "path", config.DataDir)
 
 	// Generate all the paths in the dataDir
@@ -106,6 +149,20 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to set up the secrets manager: %w", err)
 	}
 
+	// Set up the keystore of the user accounts, managed through the personal namespace
+	if m.config.EnablePersonal {
+		keystore, err := accounts.NewKeystore(
+			filepath.Join(m.config.DataDir, "keystore"),
+			accounts.StandardScryptN,
+			accounts.StandardScryptP,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up the keystore: %w", err)
+		}
+
+		m.keystore = keystore
+	}
+
 	// start libp2p
 	{
 		netConfig := config.Network
@@ -114,6 +171,11 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		netConfig.SecretsManager = m.secretsManager
 		netConfig.Metrics = m.serverMetrics.network
 
+		// the node registry restricts the connections to the registered nodes
+		if m.config.Chain.Params.NodeRegistry != nil {
+			netConfig.Allowlist = true
+		}
+
 		network, err := network.NewServer(logger, netConfig)
 		if err != nil {
 			return nil, err
@@ -148,6 +210,21 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 
 	m.executor.GetHash = m.blockchain.GetHashHelper
 
+	// setup the admission policy
+	if err := m.setupAdmission(); err != nil {
+		return nil, err
+	}
+
+	// setup the fee delegation, active from the block of its fork
+	if params := m.config.Chain.Params.FeeDelegation; params != nil {
+		if m.config.Chain.Params.Forks.FeeDelegation == nil {
+			return nil, errors.New("fee delegation registry requires the feeDelegation fork")
+		}
+
+		m.sponsors = sponsor.NewWhitelist(m.logger, params.Registry, m.executor)
+		m.executor.SponsorWhitelist = m.sponsors
+	}
+
 	{
 		hub := &txpoolHub{
 			state:      m.state,
@@ -165,15 +242,34 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 				Sealing:    m.config.Seal,
 				MaxSlots:   m.config.MaxSlots,
 				PriceLimit: m.config.PriceLimit,
+				PriceBump:  m.config.PriceBump,
+
+				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
+				MaxAccountPromoted: m.config.MaxAccountPromoted,
+				EnqueuedLifetime:   m.config.EnqueuedLifetime,
+
+				Journal:       m.txpoolJournalPath(),
+				JournalRotate: m.config.JournalRotate,
+
+				LegacyGossip:      m.config.LegacyTxGossip,
+				PrivateTxLifetime: m.config.PrivateTxLifetime,
 			},
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		// use the eip155 signer
-		signer := crypto.NewEIP155Signer(uint64(m.config.Chain.Params.ChainID))
+		// use the eip155 signer, extended with fee delegated transactions
+		signer := crypto.NewFeeDelegationSigner(uint64(m.config.Chain.Params.ChainID))
 		m.txpool.SetSigner(signer)
+
+		if m.admission != nil {
+			m.txpool.SetAdmissionPolicy(m.admission)
+		}
+
+		if m.sponsors != nil {
+			m.txpool.SetSponsorWhitelist(m.sponsors, *m.config.Chain.Params.Forks.FeeDelegation)
+		}
 	}
 
 	{
@@ -201,6 +297,16 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// setup graphql
+	if err := m.setupGraphQL(); err != nil {
+		return nil, err
+	}
+
+	// keep the allowed peers in sync with the node registry
+	if err := m.setupNodeRegistry(); err != nil {
+		return nil, err
+	}
+
 	if err := m.consensus.Start(); err != nil {
 		return nil, err
 	}
@@ -214,6 +320,80 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 	return m, nil
 }
 
+// setupAdmission sets up the transaction admission policy, read either from
+// the registry contract defined in the genesis, or from a local file
+func (s *Server) setupAdmission() error {
+	params := s.config.Chain.Params.Admission
+
+	switch {
+	case params != nil && s.config.AdmissionPolicyFile != "":
+		return errors.New("admission policy file cannot be used with a registry defined in the genesis")
+	case params != nil:
+		if err := s.checkGenesisCode("admission registry", params.Registry); err != nil {
+			return err
+		}
+
+		s.admission = admission.NewRegistryManager(s.logger, params.Registry, s.executor)
+
+		// the on-chain policy is the same for all the nodes, so it is enforced
+		// when processing the blocks as well
+		s.executor.AdmissionPolicy = s.admission
+	case s.config.AdmissionPolicyFile != "":
+		// the local policy differs between the nodes and can be reloaded at runtime,
+		// so it is only enforced by the txpool, when adding txs and building blocks
+		manager, err := admission.NewFileManager(s.logger, s.config.AdmissionPolicyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load admission policy: %w", err)
+		}
+
+		s.admission = manager
+	}
+
+	return nil
+}
+
+// setupNodeRegistry starts keeping the allowed peers in sync with the node registry
+// defined in the genesis (if any). A node syncing from genesis only allows the nodes
+// registered at its head, and the bootnodes, until it reaches the later registrations
+func (s *Server) setupNodeRegistry() error {
+	params := s.config.Chain.Params.NodeRegistry
+	if params == nil {
+		return nil
+	}
+
+	if err := s.checkGenesisCode("node registry", params.Registry); err != nil {
+		return err
+	}
+
+	s.nodeRegistry = noderegistry.NewWatcher(s.logger, params.Registry, s.executor, s.blockchain, s.network)
+
+	if err := s.nodeRegistry.Start(); err != nil {
+		return fmt.Errorf("failed to start the node registry watcher: %w", err)
+	}
+
+	return nil
+}
+
+// checkGenesisCode returns an error if no contract is deployed
+// at the given registry address in the genesis
+func (s *Server) checkGenesisCode(name string, addr types.Address) error {
+	if account, ok := s.config.Chain.Genesis.Alloc[addr]; !ok || len(account.Code) == 0 {
+		return fmt.Errorf("no %s contract deployed at %s in the genesis", name, addr)
+	}
+
+	return nil
+}
+
+// txpoolJournalPath returns the path of the local transactions journal,
+// or an empty path if the journal is disabled
+func (s *Server) txpoolJournalPath() string {
+	if s.config.NoJournal {
+		return ""
+	}
+
+	return filepath.Join(s.config.DataDir, "txpool", "transactions.journal")
+}
+
 type txpoolHub struct {
 	state state.State
 	*blockchain.Blockchain
@@ -348,7 +528,14 @@ func (s *Server) setupConsensus() error {
 }
 
 type jsonRPCHub struct {
-	state state.State
+	state    state.State
+	sealing  bool
+	keystore *accounts.Keystore
+	system   proto.SystemServer
+	dataDir  string
+
+	// authorizer of the calls to the operator services, nil if no policy is set
+	authorizer *authorizer
 
 	*blockchain.Blockchain
 	*txpool.TxPool
@@ -429,6 +616,8 @@ func (j *jsonRPCHub) GetCode(hash types.Hash) ([]byte, error) {
 func (j *jsonRPCHub) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverride *types.BlockOverride,
 ) (result *runtime.ExecutionResult, err error) {
 	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
 	if err != nil {
@@ -441,32 +630,322 @@ func (j *jsonRPCHub) ApplyTxn(
 		return
 	}
 
+	// Apply the overrides on the transition only, the persisted state is never touched
+	transition.WithBlockOverride(blockOverride)
+
+	if err = transition.WithStateOverride(stateOverride); err != nil {
+		return
+	}
+
 	result, err = transition.Apply(txn)
 
 	return
 }
 
+// GetPendingBlock builds the pending block on top of the current head, by executing
+// the promoted transactions from the pool in price and nonce order.
+// The state is never committed, so the block has no state root
+func (j *jsonRPCHub) GetPendingBlock() (*types.Block, error) {
+	parent := j.Header()
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Difficulty: parent.Number + 1,
+		Sha3Uncles: types.EmptyUncleHash,
+		Timestamp:  uint64(time.Now().Unix()),
+	}
+
+	gasLimit, err := j.CalculateGasLimit(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	header.GasLimit = gasLimit
+
+	// The block creator is not known until the block is sealed
+	transition, err := j.BeginTxn(parent.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, _ := j.GetTxs(false)
+	txs := writePendingTransactions(transition, gasLimit, promoted)
+
+	header.GasUsed = transition.TotalGas()
+	header.LogsBloom = types.CreateBloom(transition.Receipts())
+
+	return consensus.BuildBlock(consensus.BuildBlockParams{
+		Header:   header,
+		Txns:     txs,
+		Receipts: transition.Receipts(),
+	}), nil
+}
+
+// writePendingTransactions writes the promoted transactions to the transition,
+// always picking the highest priced account head first.
+// Returns the transactions that were applied successfully
+func writePendingTransactions(
+	transition *state.Transition,
+	gasLimit uint64,
+	promoted map[types.Address][]*types.Transaction,
+) []*types.Transaction {
+	heads := make(map[types.Address][]*types.Transaction, len(promoted))
+
+	for addr, txs := range promoted {
+		sorted := make([]*types.Transaction, len(txs))
+		copy(sorted, txs)
+
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Nonce < sorted[j].Nonce
+		})
+
+		heads[addr] = sorted
+	}
+
+	successful := []*types.Transaction{}
+
+	for len(heads) > 0 {
+		// find the account with the highest priced head
+		var best types.Address
+
+		for addr, txs := range heads {
+			if bestTxs, ok := heads[best]; !ok || txs[0].GasPrice.Cmp(bestTxs[0].GasPrice) > 0 {
+				best = addr
+			}
+		}
+
+		tx := heads[best][0]
+
+		if tx.ExceedsBlockGasLimit(gasLimit) {
+			// the rest of the account transactions can't be executed without this one
+			delete(heads, best)
+
+			continue
+		}
+
+		if err := transition.Write(tx); err != nil {
+			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { // nolint:errorlint
+				break
+			}
+
+			delete(heads, best)
+
+			continue
+		}
+
+		successful = append(successful, tx)
+
+		if heads[best] = heads[best][1:]; len(heads[best]) == 0 {
+			delete(heads, best)
+		}
+	}
+
+	return successful
+}
+
+// IsSealing returns a flag indicating if the node is sealing blocks
+func (j *jsonRPCHub) IsSealing() bool {
+	return j.sealing
+}
+
+// errKeystoreDisabled is returned by the account methods if the personal namespace is disabled,
+// as the node keystore is set up only along with it
+var errKeystoreDisabled = errors.New("node keystore is disabled")
+
+// Accounts returns the addresses of the accounts managed by the node
+func (j *jsonRPCHub) Accounts() []types.Address {
+	if j.keystore == nil {
+		return nil
+	}
+
+	return j.keystore.Accounts()
+}
+
+// SignHash signs the hash using the key of the given unlocked account
+func (j *jsonRPCHub) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	if j.keystore == nil {
+		return nil, errKeystoreDisabled
+	}
+
+	return j.keystore.SignHash(addr, hash)
+}
+
+// SignTx signs the transaction using the key of the given unlocked account
+func (j *jsonRPCHub) SignTx(addr types.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if j.keystore == nil {
+		return nil, errKeystoreDisabled
+	}
+
+	return j.keystore.SignTx(addr, tx, j.signer())
+}
+
+// NewAccount creates a new account in the keystore, encrypted with the passphrase
+func (j *jsonRPCHub) NewAccount(passphrase string) (types.Address, error) {
+	if j.keystore == nil {
+		return types.ZeroAddress, errKeystoreDisabled
+	}
+
+	return j.keystore.NewAccount(passphrase)
+}
+
+// UnlockAccount unlocks the account for the given duration (0 means indefinitely)
+func (j *jsonRPCHub) UnlockAccount(addr types.Address, passphrase string, duration time.Duration) error {
+	if j.keystore == nil {
+		return errKeystoreDisabled
+	}
+
+	return j.keystore.Unlock(addr, passphrase, duration)
+}
+
+// LockAccount locks the account
+func (j *jsonRPCHub) LockAccount(addr types.Address) error {
+	if j.keystore == nil {
+		return errKeystoreDisabled
+	}
+
+	return j.keystore.Lock(addr)
+}
+
+// SignHashWithPassphrase signs the hash using the key of the given account, decrypted with the passphrase
+func (j *jsonRPCHub) SignHashWithPassphrase(addr types.Address, passphrase string, hash []byte) ([]byte, error) {
+	if j.keystore == nil {
+		return nil, errKeystoreDisabled
+	}
+
+	return j.keystore.SignHashWithPassphrase(addr, passphrase, hash)
+}
+
+// SignTxWithPassphrase signs the transaction using the key of the given account, decrypted with the passphrase
+func (j *jsonRPCHub) SignTxWithPassphrase(
+	addr types.Address,
+	passphrase string,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	if j.keystore == nil {
+		return nil, errKeystoreDisabled
+	}
+
+	return j.keystore.SignTxWithPassphrase(addr, passphrase, tx, j.signer())
+}
+
+// signer returns the transaction signer for the forks active at the current head
+func (j *jsonRPCHub) signer() crypto.TxSigner {
+	return crypto.NewSigner(
+		j.Executor.GetForksInTime(j.Blockchain.Header().Number),
+		uint64(j.Blockchain.Config().ChainID),
+	)
+}
+
+// ibftOperator is implemented by the consensus mechanisms that expose the IBFT operator service
+type ibftOperator interface {
+	Operator() ibftOp.IbftOperatorServer
+}
+
+// SystemService returns the gRPC System service of the node
+func (j *jsonRPCHub) SystemService() proto.SystemServer {
+	return j.system
+}
+
+// IbftOperator returns the IBFT operator service, or nil if the node doesn't run IBFT
+func (j *jsonRPCHub) IbftOperator() ibftOp.IbftOperatorServer {
+	if operator, ok := j.Consensus.(ibftOperator); ok {
+		return operator.Operator()
+	}
+
+	return nil
+}
+
+// Authorize checks the call to the operator service method against the authorization policy, if any.
+// The JSON-RPC callers are never authenticated, so they are granted the roles of the anonymous gRPC callers
+func (j *jsonRPCHub) Authorize(method string) error {
+	if j.authorizer == nil {
+		return nil
+	}
+
+	return j.authorizer.authorize(context.Background(), method)
+}
+
+// DataDir returns the data directory of the node
+func (j *jsonRPCHub) DataDir() string {
+	return j.dataDir
+}
+
 func (j *jsonRPCHub) GetSyncProgression() *protocol.Progression {
 	return j.Consensus.GetSyncProgression()
 }
 
 // SETUP //
 
-// setupJSONRCP sets up the JSONRPC server, using the set configuration
-func (s *Server) setupJSONRPC() error {
-	hub := &jsonRPCHub{
+// newJSONRPCHub returns the hub backing the JSONRPC and GraphQL servers
+func (s *Server) newJSONRPCHub() *jsonRPCHub {
+	return &jsonRPCHub{
 		state:      s.state,
+		sealing:    s.config.Seal,
+		keystore:   s.keystore,
+		system:     &systemService{s: s},
+		authorizer: s.authorizer,
+		dataDir:    s.config.DataDir,
 		Blockchain: s.blockchain,
 		TxPool:     s.txpool,
 		Executor:   s.executor,
 		Consensus:  s.consensus,
 		Server:     s.network,
 	}
+}
+
+// newGRPCServer creates the gRPC operator server, serving TLS if a certificate is set
+// and authorizing the calls if an authorizer is set
+func newGRPCServer(config *Config, auth *authorizer) (*grpc.Server, error) {
+	opts := []grpc.ServerOption{}
+
+	if config.GRPCTLSCert != "" || config.GRPCTLSKey != "" {
+		tlsConfig, err := tlsutil.ServerConfig(config.GRPCTLSCert, config.GRPCTLSKey, config.GRPCTLSClientCA)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if config.GRPCTLSClientCA != "" {
+		return nil, errors.New("the client CA requires the TLS certificate and key")
+	}
+
+	if auth != nil {
+		opts = append(
+			opts,
+			grpc.ChainUnaryInterceptor(auth.unaryInterceptor),
+			grpc.ChainStreamInterceptor(auth.streamInterceptor),
+		)
+	}
+
+	return grpc.NewServer(opts...), nil
+}
+
+// jsonRPCTLSConfig returns the TLS config of the JSON-RPC and GraphQL servers, nil if no certificate is set
+func (s *Server) jsonRPCTLSConfig() (*tls.Config, error) {
+	if s.config.JSONRPCTLSCert == "" && s.config.JSONRPCTLSKey == "" {
+		return nil, nil
+	}
+
+	return tlsutil.ServerConfig(s.config.JSONRPCTLSCert, s.config.JSONRPCTLSKey, "")
+}
+
+// setupJSONRCP sets up the JSONRPC server, using the set configuration
+func (s *Server) setupJSONRPC() error {
+	tlsConfig, err := s.jsonRPCTLSConfig()
+	if err != nil {
+		return err
+	}
 
 	conf := &jsonrpc.Config{
-		Store:   hub,
-		Addr:    s.config.JSONRPCAddr,
-		ChainID: uint64(s.config.Chain.Params.ChainID),
+		Store:     s.newJSONRPCHub(),
+		Addr:      s.config.JSONRPCAddr,
+		ChainID:   uint64(s.config.Chain.Params.ChainID),
+		TLSConfig: tlsConfig,
+
+		EnablePersonal: s.config.EnablePersonal,
+		EnableAdmin:    s.config.EnableAdmin,
+		AllowedOrigins: s.config.JSONRPCAllowedOrigins,
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -479,6 +958,37 @@ func (s *Server) setupJSONRPC() error {
 	return nil
 }
 
+// setupGraphQL sets up the GraphQL server, if an address is set
+func (s *Server) setupGraphQL() error {
+	if s.config.GraphQLAddr == nil {
+		return nil
+	}
+
+	tlsConfig, err := s.jsonRPCTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	conf := &jsonrpc.GraphQLConfig{
+		Store:     s.newJSONRPCHub(),
+		Addr:      s.config.GraphQLAddr,
+		ChainID:   uint64(s.config.Chain.Params.ChainID),
+		TLSConfig: tlsConfig,
+
+		MaxDepth:      s.config.GraphQLMaxDepth,
+		MaxComplexity: s.config.GraphQLMaxComplexity,
+	}
+
+	srv, err := jsonrpc.NewGraphQL(s.logger, conf)
+	if err != nil {
+		return err
+	}
+
+	s.graphqlServer = srv
+
+	return nil
+}
+
 // setupGRPC sets up the grpc server and listens on tcp
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{s: s})
@@ -510,6 +1020,11 @@ func (s *Server) Join(addr0 string, dur time.Duration) error {
 
 // Close closes the Minimal server (blockchain, networking, consensus)
 func (s *Server) Close() {
+	// Stop watching the node registry
+	if s.nodeRegistry != nil {
+		s.nodeRegistry.Close()
+	}
+
 	// Close the blockchain layer
 	if err := s.blockchain.Close(); err != nil {
 		s.logger.Error("failed to close blockchain", "err", err.Error())
@@ -2,11 +2,12 @@ package server
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/server/proto"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -23,6 +24,8 @@ type systemService struct {
 // Current: { Number: <blockNumber>; Hash: <headerHash> }
 //
 // P2PAddr: <libp2pAddress>
+//
+// Reachability: <Unknown|Public|Private>
 func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto.ServerStatus, error) {
 	header := s.s.blockchain.Header()
 
@@ -32,7 +35,8 @@ func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto
 			Number: int64(header.Number),
 			Hash:   header.Hash.String(),
 		},
-		P2PAddr: network.AddrInfoToString(s.s.network.AddrInfo()),
+		P2PAddr:      network.AddrInfoToString(s.s.network.AddrInfo()),
+		Reachability: s.s.network.Reachability().String(),
 	}
 
 	return status, nil
@@ -150,3 +154,125 @@ func (s *systemService) PeersList(
 
 	return resp, nil
 }
+
+// PeersRemove implements the 'peers remove' operator service
+func (s *systemService) PeersRemove(ctx context.Context, req *proto.PeersRemoveRequest) (*empty.Empty, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.s.network.Disconnect(peerID, "removed by the operator")
+
+	return &empty.Empty{}, nil
+}
+
+// PeersBan implements the 'peers ban' operator service
+func (s *systemService) PeersBan(ctx context.Context, req *proto.PeersBanRequest) (*empty.Empty, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Duration < 0 {
+		return nil, errors.New("invalid ban duration")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "banned by the operator"
+	}
+
+	if err := s.s.network.BanPeer(peerID, time.Duration(req.Duration)*time.Second, reason); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PeersUnban implements the 'peers unban' operator service
+func (s *systemService) PeersUnban(ctx context.Context, req *proto.PeersUnbanRequest) (*empty.Empty, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.s.network.UnbanPeer(peerID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PeersListBanned implements the 'peers list-banned' operator service
+func (s *systemService) PeersListBanned(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.PeersListBannedResponse, error) {
+	resp := &proto.PeersListBannedResponse{
+		Peers: []*proto.BannedPeer{},
+	}
+
+	for _, b := range s.s.network.BannedPeers() {
+		var until int64
+		if !b.Until.IsZero() {
+			until = b.Until.Unix()
+		}
+
+		resp.Peers = append(resp.Peers, &proto.BannedPeer{
+			Id:     b.ID.String(),
+			Reason: b.Reason,
+			Until:  until,
+		})
+	}
+
+	return resp, nil
+}
+
+// PeersAllow implements the 'peers allow' operator service
+func (s *systemService) PeersAllow(ctx context.Context, req *proto.PeersAllowRequest) (*empty.Empty, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.s.network.AllowPeer(peerID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PeersDisallow implements the 'peers disallow' operator service
+func (s *systemService) PeersDisallow(ctx context.Context, req *proto.PeersDisallowRequest) (*empty.Empty, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.s.network.DisallowPeer(peerID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PeersListAllowed implements the 'peers list-allowed' operator service
+func (s *systemService) PeersListAllowed(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.PeersListAllowedResponse, error) {
+	resp := &proto.PeersListAllowedResponse{
+		Enabled: s.s.network.AllowlistEnabled(),
+		Peers:   []*proto.AllowedPeer{},
+	}
+
+	for _, a := range s.s.network.AllowedPeers() {
+		resp.Peers = append(resp.Peers, &proto.AllowedPeer{
+			Id:     a.ID.String(),
+			Source: a.Source,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package sponsor
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/go-web3"
+)
+
+// Gas limit used when querying the registry contract
+var queryGasLimit uint64 = 1000000
+
+type TxQueryHandler interface {
+	Apply(*types.Transaction) (*runtime.ExecutionResult, error)
+	GetNonce(types.Address) uint64
+}
+
+// QuerySponsors reads the whitelisted sponsors from the registry contract.
+func QuerySponsors(t TxQueryHandler, registry types.Address) ([]types.Address, error) {
+	method, ok := abis.SponsorRegistryABI.Methods["sponsors"]
+	if !ok {
+		return nil, errors.New("sponsors method doesn't exist in the registry contract ABI")
+	}
+
+	res, err := t.Apply(&types.Transaction{
+		From:     types.ZeroAddress,
+		To:       &registry,
+		Value:    big.NewInt(0),
+		Input:    method.ID(),
+		GasPrice: big.NewInt(0),
+		Gas:      queryGasLimit,
+		Nonce:    t.GetNonce(types.ZeroAddress),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	decodedResults, err := method.Outputs.Decode(res.ReturnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results, ok := decodedResults.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed type assertion from decodedResults to map")
+	}
+
+	web3Addresses, ok := results["0"].([]web3.Address)
+	if !ok {
+		return nil, errors.New("failed type assertion from results[0] to []web3.Address")
+	}
+
+	addresses := make([]types.Address, len(web3Addresses))
+	for idx, waddr := range web3Addresses {
+		addresses[idx] = types.Address(waddr)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,92 @@
+package sponsor
+
+import (
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+type executor interface {
+	BeginTxn(parentRoot types.Hash, header *types.Header, coinbaseReceiver types.Address) (*state.Transition, error)
+}
+
+// Whitelist provides the sponsors allowed to pay for the gas of fee
+// delegated transactions at a given state, as held by the registry contract.
+type Whitelist struct {
+	logger hclog.Logger
+	lock   sync.RWMutex
+
+	registry types.Address
+	executor executor
+
+	// the sponsors of the last queried state
+	cachedRoot     types.Hash
+	cachedSponsors map[types.Address]struct{}
+}
+
+// NewWhitelist creates a whitelist of the sponsors
+// held by the given registry contract.
+func NewWhitelist(logger hclog.Logger, registry types.Address, executor executor) *Whitelist {
+	return &Whitelist{
+		logger:   logger.Named("sponsor"),
+		registry: registry,
+		executor: executor,
+	}
+}
+
+// SponsorsAt returns the sponsors whitelisted for a block
+// with the given header, built on the given parent state.
+func (w *Whitelist) SponsorsAt(parentRoot types.Hash, header *types.Header) (map[types.Address]struct{}, error) {
+	w.lock.RLock()
+
+	if w.cachedSponsors != nil && w.cachedRoot == parentRoot {
+		defer w.lock.RUnlock()
+
+		return w.cachedSponsors, nil
+	}
+
+	w.lock.RUnlock()
+
+	// the query must not be limited by the block gas limit
+	header = header.Copy()
+	header.GasLimit = queryGasLimit
+
+	transition, err := w.executor.BeginTxn(parentRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := QuerySponsors(transition, w.registry)
+	if err != nil {
+		w.logger.Error("failed to query the sponsors", "registry", w.registry, "err", err)
+
+		return nil, err
+	}
+
+	sponsors := make(map[types.Address]struct{}, len(list))
+	for _, addr := range list {
+		sponsors[addr] = struct{}{}
+	}
+
+	w.lock.Lock()
+	w.cachedRoot = parentRoot
+	w.cachedSponsors = sponsors
+	w.lock.Unlock()
+
+	return sponsors, nil
+}
+
+// IsWhitelisted returns true if the given account can pay for the gas of fee delegated
+// transactions included in a block with the given header, built on the given parent state.
+func (w *Whitelist) IsWhitelisted(parentRoot types.Hash, header *types.Header, sponsor types.Address) (bool, error) {
+	sponsors, err := w.SponsorsAt(parentRoot, header)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := sponsors[sponsor]
+
+	return ok, nil
+}
@@ -0,0 +1,178 @@
+package sponsor
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+const chainID = 100
+
+var registry = types.StringToAddress("1200")
+
+// registryCode returns the code of a registry contract
+// returning the given address as the only sponsor
+func registryCode(addr types.Address) []byte {
+	code := []byte{
+		0x60, 0x60, // PUSH1 size
+		0x60, 0x0c, // PUSH1 offset of the data
+		0x60, 0x00, // PUSH1 memory offset
+		0x39,       // CODECOPY
+		0x60, 0x60, // PUSH1 size
+		0x60, 0x00, // PUSH1 memory offset
+		0xf3, // RETURN
+	}
+
+	// abi encoded address[] with one element
+	data := make([]byte, 0x60)
+	data[0x1f] = 0x20
+	data[0x3f] = 0x01
+	copy(data[0x4c:], addr.Bytes())
+
+	return append(code, data...)
+}
+
+func newTestExecutor(t *testing.T, alloc map[types.Address]*chain.GenesisAccount) (*state.Executor, types.Hash) {
+	t.Helper()
+
+	forks := *chain.AllForksEnabled
+	forks.FeeDelegation = chain.NewFork(0)
+
+	executor := state.NewExecutor(
+		&chain.Params{Forks: &forks, ChainID: chainID},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	executor.SetRuntime(evm.NewEVM())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.Hash{}
+		}
+	}
+
+	return executor, executor.WriteGenesis(alloc)
+}
+
+func TestWhitelist(t *testing.T) {
+	sponsor := types.StringToAddress("1")
+
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		registry: {
+			Code: registryCode(sponsor),
+		},
+	})
+
+	whitelist := NewWhitelist(hclog.NewNullLogger(), registry, executor)
+
+	whitelisted, err := whitelist.IsWhitelisted(root, &types.Header{Number: 1}, sponsor)
+	assert.NoError(t, err)
+	assert.True(t, whitelisted)
+
+	whitelisted, err = whitelist.IsWhitelisted(root, &types.Header{Number: 1}, types.StringToAddress("2"))
+	assert.NoError(t, err)
+	assert.False(t, whitelisted)
+}
+
+func TestWhitelist_MissingRegistry(t *testing.T) {
+	executor, root := newTestExecutor(t, nil)
+
+	whitelist := NewWhitelist(hclog.NewNullLogger(), registry, executor)
+
+	_, err := whitelist.IsWhitelisted(root, &types.Header{Number: 1}, types.StringToAddress("1"))
+	assert.Error(t, err)
+}
+
+func TestExecutor_FeeDelegation(t *testing.T) {
+	senderKey, sender := tests.GenerateKeyAndAddr(t)
+	sponsorKey, sponsor := tests.GenerateKeyAndAddr(t)
+	otherKey, other := tests.GenerateKeyAndAddr(t)
+
+	receiver := types.StringToAddress("2")
+	balance := big.NewInt(1000000000)
+
+	executor, root := newTestExecutor(t, map[types.Address]*chain.GenesisAccount{
+		registry: {Code: registryCode(sponsor)},
+		sender:   {Balance: big.NewInt(1)},
+		sponsor:  {Balance: balance},
+		other:    {Balance: balance},
+	})
+
+	signer := crypto.NewFeeDelegationSigner(chainID)
+
+	newTx := func(t *testing.T, feePayer types.Address, feePayerKey *ecdsa.PrivateKey) *types.Transaction {
+		t.Helper()
+
+		tx, err := signer.SignTx(&types.Transaction{
+			To:       &receiver,
+			Value:    big.NewInt(1),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+			FeePayer: &feePayer,
+		}, senderKey)
+		assert.NoError(t, err)
+
+		tx, err = signer.SignFeePayer(tx, feePayerKey)
+		assert.NoError(t, err)
+
+		return tx
+	}
+
+	header := &types.Header{Number: 1, GasLimit: 1000000}
+
+	t.Run("disabled", func(t *testing.T) {
+		transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		err = transition.Write(newTx(t, sponsor, sponsorKey))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), state.ErrFeeDelegationDisabled.Error())
+		}
+	})
+
+	executor.SponsorWhitelist = NewWhitelist(hclog.NewNullLogger(), registry, executor)
+
+	t.Run("sponsor pays the gas", func(t *testing.T) {
+		transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		// the sender only holds the value
+		assert.NoError(t, transition.Write(newTx(t, sponsor, sponsorKey)))
+
+		assert.Equal(t, 0, transition.GetBalance(sender).Sign())
+		assert.Equal(t, big.NewInt(1), transition.GetBalance(receiver))
+		assert.Equal(t, new(big.Int).Sub(balance, big.NewInt(21000)), transition.GetBalance(sponsor))
+		assert.Equal(t, uint64(1), transition.GetNonce(sender))
+		assert.Equal(t, uint64(0), transition.GetNonce(sponsor))
+	})
+
+	t.Run("sponsor not whitelisted", func(t *testing.T) {
+		transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		err = transition.Write(newTx(t, other, otherKey))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), state.ErrSponsorNotWhitelisted.Error())
+		}
+	})
+
+	t.Run("invalid fee payer signature", func(t *testing.T) {
+		transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		// signed by an account other than the fee payer
+		err = transition.Write(newTx(t, sponsor, otherKey))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), state.ErrInvalidFeePayer.Error())
+		}
+	})
+}
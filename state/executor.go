@@ -28,6 +28,18 @@ type GetHashByNumber = func(i uint64) types.Hash
 
 type GetHashByNumberHelper = func(*types.Header) GetHashByNumber
 
+// AdmissionPolicy decides whether a transaction can be
+// included in a block built on top of the given parent state
+type AdmissionPolicy interface {
+	Admit(parentRoot types.Hash, header *types.Header, tx *types.Transaction) error
+}
+
+// SponsorWhitelist decides which accounts can pay for the gas of fee delegated
+// transactions included in a block built on top of the given parent state
+type SponsorWhitelist interface {
+	IsWhitelisted(parentRoot types.Hash, header *types.Header, sponsor types.Address) (bool, error)
+}
+
 // Executor is the main entity
 type Executor struct {
 	logger   hclog.Logger
@@ -37,6 +49,13 @@ type Executor struct {
 	GetHash  GetHashByNumberHelper
 
 	PostHook func(txn *Transition)
+
+	// AdmissionPolicy rejects the transactions not allowed on the chain (optional).
+	// It is enforced when processing blocks, so it needs to be the same for all the nodes
+	AdmissionPolicy AdmissionPolicy
+
+	// SponsorWhitelist enables fee delegated transactions (optional)
+	SponsorWhitelist SponsorWhitelist
 }
 
 // NewExecutor creates a new executor
@@ -116,6 +135,35 @@ func (e *Executor) ProcessBlock(
 	return res, nil
 }
 
+// CheckAdmission checks the transactions of the block against
+// the admission policy in effect at the parent state
+func (e *Executor) CheckAdmission(parent *types.Header, block *types.Block) error {
+	if e.AdmissionPolicy == nil {
+		return nil
+	}
+
+	signer := crypto.NewSigner(e.config.Forks.At(block.Number()), uint64(e.config.ChainID))
+
+	for _, tx := range block.Transactions {
+		msg := tx.Copy()
+
+		if msg.From == emptyFrom {
+			from, err := signer.Sender(msg)
+			if err != nil {
+				return err
+			}
+
+			msg.From = from
+		}
+
+		if err := e.AdmissionPolicy.Admit(parent.StateRoot, block.Header, msg); err != nil {
+			return fmt.Errorf("transaction %s not admitted: %w", tx.Hash, err)
+		}
+	}
+
+	return nil
+}
+
 // StateAt returns snapshot at given root
 func (e *Executor) State() State {
 	return e.state
@@ -164,6 +212,9 @@ func (e *Executor) BeginTxn(
 		config:   config,
 		gasPool:  uint64(env2.GasLimit),
 
+		parentRoot: parentRoot,
+		header:     header,
+
 		receipts: []*types.Receipt{},
 		totalGas: 0,
 	}
@@ -180,6 +231,10 @@ type Transition struct {
 	// the current block being processed
 	block *types.Block
 
+	// the parent state root and the header of the block being built
+	parentRoot types.Hash
+	header     *types.Header
+
 	r       *Executor
 	config  chain.ForksInTime
 	state   *Txn
@@ -215,6 +270,13 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		}
 	}
 
+	// Reject transactions not allowed by the admission policy
+	if t.r.AdmissionPolicy != nil {
+		if err := t.r.AdmissionPolicy.Admit(t.parentRoot, t.header, txn); err != nil {
+			return newPolicyError(err)
+		}
+	}
+
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
@@ -266,6 +328,73 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	return nil
 }
 
+// Copy returns a copy of the transition, so that transactions
+// can be simulated without affecting the original one
+func (t *Transition) Copy() *Transition {
+	c := *t
+	c.state = t.state.Copy()
+	c.receipts = make([]*types.Receipt, len(t.receipts))
+	copy(c.receipts, t.receipts)
+
+	return &c
+}
+
+// WriteBundle writes the given transactions atomically and in order.
+// They are simulated on a copy of the transition, which is only kept
+// if all of them are applied and none of them fails
+func (t *Transition) WriteBundle(txns []*types.Transaction) error {
+	sim := t.Copy()
+
+	for _, txn := range txns {
+		if err := sim.Write(txn); err != nil {
+			return err
+		}
+
+		receipt := sim.receipts[len(sim.receipts)-1]
+		if receipt.Status != nil && *receipt.Status == types.ReceiptFailed {
+			return NewTransitionApplicationError(ErrBundleTxFailed, false)
+		}
+	}
+
+	*t = *sim
+
+	return nil
+}
+
+// checkFeePayer verifies the fee payer signature of a fee delegated
+// transaction and checks the fee payer is a whitelisted sponsor
+func (t *Transition) checkFeePayer(txn *types.Transaction) error {
+	if t.r.SponsorWhitelist == nil || !t.config.EIP155 || !t.config.FeeDelegation {
+		return ErrFeeDelegationDisabled
+	}
+
+	feePayer, err := crypto.NewFeeDelegationSigner(uint64(t.r.config.ChainID)).FeePayer(txn)
+	if err != nil || feePayer != *txn.FeePayer {
+		return ErrInvalidFeePayer
+	}
+
+	whitelisted, err := t.r.SponsorWhitelist.IsWhitelisted(t.parentRoot, t.header, feePayer)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRegistryQuery, err)
+	}
+
+	if !whitelisted {
+		return ErrSponsorNotWhitelisted
+	}
+
+	return nil
+}
+
+// newPolicyError rejects the transaction not allowed by a policy of the chain,
+// unless the policy could not be queried
+func newPolicyError(err error) error {
+	if errors.Is(err, ErrRegistryQuery) {
+		return err
+	}
+
+	return NewTransitionApplicationError(err, false)
+}
+
 // Commit commits the final result
 func (t *Transition) Commit() (Snapshot, types.Hash) {
 	s2, root := t.state.Commit(t.config.EIP155)
@@ -301,6 +430,14 @@ func (t *Transition) GetTxnHash() types.Hash {
 
 // Apply applies a new transaction
 func (t *Transition) Apply(msg *types.Transaction) (*runtime.ExecutionResult, error) {
+	// Reject fee delegated transactions not paid by a whitelisted sponsor,
+	// whether they are written to the block or only simulated
+	if msg.IsFeeDelegated() {
+		if err := t.checkFeePayer(msg); err != nil {
+			return nil, newPolicyError(err)
+		}
+	}
+
 	s := t.state.Snapshot() //nolint:ifshort //nolint:nolintlint
 	result, err := t.apply(msg)
 
@@ -321,12 +458,70 @@ func (t *Transition) ContextPtr() *runtime.TxContext {
 	return &t.ctx
 }
 
+// ErrStateAndStateDiff is returned when an account override sets both the full storage and a storage diff
+var ErrStateAndStateDiff = fmt.Errorf("account has both 'state' and 'stateDiff' overrides")
+
+// WithStateOverride applies the account overrides to the transition state.
+// The overrides are never committed, so they only affect the current simulation
+func (t *Transition) WithStateOverride(override types.StateOverride) error {
+	for addr, account := range override {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("%w: %s", ErrStateAndStateDiff, addr.String())
+		}
+
+		if account.Nonce != nil {
+			t.state.SetNonce(addr, *account.Nonce)
+		}
+
+		if account.Balance != nil {
+			t.state.SetBalance(addr, account.Balance)
+		}
+
+		if account.Code != nil {
+			t.state.SetCode(addr, account.Code)
+		}
+
+		if account.State != nil {
+			t.state.SetFullStorage(addr, account.State)
+		}
+
+		for key, value := range account.StateDiff {
+			t.state.SetState(addr, key, value)
+		}
+	}
+
+	return nil
+}
+
+// WithBlockOverride applies the block environment overrides to the transition context
+func (t *Transition) WithBlockOverride(override *types.BlockOverride) {
+	if override == nil {
+		return
+	}
+
+	if override.Number != nil {
+		t.ctx.Number = int64(*override.Number)
+	}
+
+	if override.Timestamp != nil {
+		t.ctx.Timestamp = int64(*override.Timestamp)
+	}
+
+	if override.GasLimit != nil {
+		t.ctx.GasLimit = int64(*override.GasLimit)
+		t.gasPool = *override.GasLimit
+	}
+
+	if override.Coinbase != nil {
+		t.ctx.Coinbase = *override.Coinbase
+	}
+}
+
 func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
-	// deduct the upfront max gas cost
-	upfrontGasCost := new(big.Int).Set(msg.GasPrice)
-	upfrontGasCost.Mul(upfrontGasCost, new(big.Int).SetUint64(msg.Gas))
+	// deduct the upfront max gas cost from the sender or the fee payer
+	upfrontGasCost := msg.GasCost()
 
-	if err := t.state.SubBalance(msg.From, upfrontGasCost); err != nil {
+	if err := t.state.SubBalance(msg.GasPayer(), upfrontGasCost); err != nil {
 		if errors.Is(err, runtime.ErrNotEnoughFunds) {
 			return ErrNotEnoughFundsForGas
 		}
@@ -358,8 +553,27 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 	ErrNotEnoughFunds        = fmt.Errorf("not enough funds for transfer with given value")
+	ErrFeeDelegationDisabled = fmt.Errorf("fee delegation is not enabled")
+	ErrInvalidFeePayer       = fmt.Errorf("invalid fee payer signature")
+	ErrSponsorNotWhitelisted = fmt.Errorf("fee payer is not a whitelisted sponsor")
+	ErrBundleTxFailed        = fmt.Errorf("bundle transaction failed")
 )
 
+// ErrRegistryQuery is returned (wrapped) when a registry contract enforced on the transactions
+// cannot be queried. It is a failure of the node, so the transaction is not rejected by it
+var ErrRegistryQuery = errors.New("failed to query the registry")
+
+// IsInvalidTxError returns a flag indicating if the error returned
+// by the transition rejects the transaction as invalid
+func IsInvalidTxError(err error) bool {
+	var (
+		appErr      *TransitionApplicationError
+		gasLimitErr *GasLimitReachedTransitionApplicationError
+	)
+
+	return errors.As(err, &appErr) || errors.As(err, &gasLimitErr)
+}
+
 type TransitionApplicationError struct {
 	Err           error
 	IsRecoverable bool // Should the transaction be discarded, or put back in the queue.
@@ -391,7 +605,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	// applying the message. The rules include these clauses
 	//
 	// 1. the nonce of the message caller is correct
-	// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
+	// 2. caller (or fee payer) has enough balance to cover transaction fee(gaslimit * gasprice)
 	// 3. the amount of gas required is available in the block
 	// 4. there is no overflow when calculating intrinsic gas
 	// 5. the purchased gas is enough to cover intrinsic usage
@@ -403,7 +617,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		return nil, NewTransitionApplicationError(err, true)
 	}
 
-	// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
+	// 2. caller (or fee payer) has enough balance to cover transaction fee(gaslimit * gasprice)
 	if err := t.subGasLimitPrice(msg); err != nil {
 		return nil, NewTransitionApplicationError(err, true)
 	}
@@ -449,9 +663,9 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	refund := txn.GetRefund()
 	result.UpdateGasUsed(msg.Gas, refund)
 
-	// refund the sender
+	// refund the sender or the fee payer
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), gasPrice)
-	txn.AddBalance(msg.From, remaining)
+	txn.AddBalance(msg.GasPayer(), remaining)
 
 	// pay the coinbase
 	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), gasPrice)
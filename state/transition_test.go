@@ -4,7 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -149,3 +152,247 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestWithStateOverride(t *testing.T) {
+	var (
+		nonce   = uint64(10)
+		balance = big.NewInt(100)
+		code    = []byte{0x60, 0x01}
+	)
+
+	t.Run("should override account fields and replace the storage", func(t *testing.T) {
+		transition := newTestTransition(nil)
+		transition.SetStorage(addr1, hash1, hash1, &chain.ForksInTime{})
+
+		err := transition.WithStateOverride(types.StateOverride{
+			addr1: {
+				Nonce:   &nonce,
+				Balance: balance,
+				Code:    code,
+				State: map[types.Hash]types.Hash{
+					hash2: hash2,
+				},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, nonce, transition.GetNonce(addr1))
+		assert.Equal(t, balance, transition.GetBalance(addr1))
+		assert.Equal(t, code, transition.GetCode(addr1))
+
+		// the previously set slot is wiped out
+		assert.Equal(t, types.Hash{}, transition.GetStorage(addr1, hash1))
+		assert.Equal(t, hash2, transition.GetStorage(addr1, hash2))
+	})
+
+	t.Run("should modify only the given storage slots", func(t *testing.T) {
+		transition := newTestTransition(nil)
+		transition.SetStorage(addr1, hash1, hash1, &chain.ForksInTime{})
+
+		err := transition.WithStateOverride(types.StateOverride{
+			addr1: {
+				StateDiff: map[types.Hash]types.Hash{
+					hash2: hash2,
+				},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, hash1, transition.GetStorage(addr1, hash1))
+		assert.Equal(t, hash2, transition.GetStorage(addr1, hash2))
+	})
+
+	t.Run("should fail when both state and stateDiff are set", func(t *testing.T) {
+		transition := newTestTransition(nil)
+
+		err := transition.WithStateOverride(types.StateOverride{
+			addr1: {
+				State:     map[types.Hash]types.Hash{},
+				StateDiff: map[types.Hash]types.Hash{},
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrStateAndStateDiff)
+	})
+}
+
+func TestWithBlockOverride(t *testing.T) {
+	var (
+		number   = uint64(10)
+		gasLimit = uint64(5000)
+	)
+
+	transition := newTestTransition(nil)
+	transition.WithBlockOverride(&types.BlockOverride{
+		Number:   &number,
+		GasLimit: &gasLimit,
+		Coinbase: &addr2,
+	})
+
+	ctx := transition.GetTxContext()
+	assert.Equal(t, int64(number), ctx.Number)
+	assert.Equal(t, int64(gasLimit), ctx.GasLimit)
+	assert.Equal(t, addr2, ctx.Coinbase)
+	assert.Equal(t, gasLimit, transition.gasPool)
+}
+
+func TestWriteBundle(t *testing.T) {
+	newTransition := func() *Transition {
+		transition := newTestTransition(map[types.Address]*PreState{
+			addr1: {
+				Nonce:   0,
+				Balance: 1000000,
+			},
+		})
+		transition.r = &Executor{
+			config:   &chain.Params{ChainID: 100},
+			runtimes: []runtime.Runtime{evm.NewEVM()},
+		}
+		transition.config = chain.AllForksEnabled.At(0)
+		transition.gasPool = 1000000
+
+		return transition
+	}
+
+	transfer := func(nonce uint64, value int64) *types.Transaction {
+		return &types.Transaction{
+			Nonce:    nonce,
+			From:     addr1,
+			To:       &addr2,
+			Value:    big.NewInt(value),
+			Gas:      TxGas,
+			GasPrice: big.NewInt(1),
+		}
+	}
+
+	t.Run("should include all the transactions", func(t *testing.T) {
+		transition := newTransition()
+
+		assert.NoError(t, transition.WriteBundle([]*types.Transaction{
+			transfer(0, 10),
+			transfer(1, 20),
+		}))
+
+		assert.Len(t, transition.Receipts(), 2)
+		assert.Equal(t, 2*TxGas, transition.TotalGas())
+		assert.Equal(t, uint64(2), transition.GetNonce(addr1))
+		assert.Equal(t, big.NewInt(30), transition.GetBalance(addr2))
+	})
+
+	t.Run("should include none of the transactions", func(t *testing.T) {
+		transition := newTransition()
+
+		assert.Error(t, transition.WriteBundle([]*types.Transaction{
+			transfer(0, 10),
+			transfer(2, 20), // nonce gap
+		}))
+
+		assert.Len(t, transition.Receipts(), 0)
+		assert.Zero(t, transition.TotalGas())
+		assert.Equal(t, uint64(0), transition.GetNonce(addr1))
+		assert.Equal(t, uint64(1000000), transition.gasPool)
+		assert.False(t, transition.AccountExists(addr2))
+	})
+}
+
+type mockSponsorWhitelist struct {
+	sponsor types.Address
+}
+
+func (m *mockSponsorWhitelist) IsWhitelisted(_ types.Hash, _ *types.Header, sponsor types.Address) (bool, error) {
+	return sponsor == m.sponsor, nil
+}
+
+func TestApplyFeeDelegated(t *testing.T) {
+	sponsorKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	sponsor := crypto.PubKeyToAddress(&sponsorKey.PublicKey)
+	other := crypto.PubKeyToAddress(&otherKey.PublicKey)
+
+	signer := crypto.NewFeeDelegationSigner(100)
+
+	// the simulated transition of eth_call and eth_estimateGas
+	newTransition := func() *Transition {
+		transition := newTestTransition(map[types.Address]*PreState{
+			sponsor: {
+				Balance: 1000000,
+			},
+			other: {
+				Balance: 1000000,
+			},
+		})
+		transition.r = &Executor{
+			config:           &chain.Params{ChainID: 100},
+			runtimes:         []runtime.Runtime{evm.NewEVM()},
+			SponsorWhitelist: &mockSponsorWhitelist{sponsor: sponsor},
+		}
+		transition.config = chain.AllForksEnabled.At(0)
+		transition.config.FeeDelegation = true
+		transition.gasPool = 1000000
+
+		return transition
+	}
+
+	newDelegatedTx := func(t *testing.T, feePayer types.Address) *types.Transaction {
+		t.Helper()
+
+		return &types.Transaction{
+			From:     addr1,
+			To:       &addr2,
+			Value:    big.NewInt(0),
+			Gas:      TxGas,
+			GasPrice: big.NewInt(1),
+			FeePayer: &feePayer,
+		}
+	}
+
+	t.Run("should charge the whitelisted sponsor", func(t *testing.T) {
+		transition := newTransition()
+
+		tx, err := signer.SignFeePayer(newDelegatedTx(t, sponsor), sponsorKey)
+		assert.NoError(t, err)
+
+		_, err = transition.Apply(tx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, big.NewInt(1000000-int64(TxGas)), transition.GetBalance(sponsor))
+	})
+
+	t.Run("should not charge the sponsor before the fee delegation fork", func(t *testing.T) {
+		transition := newTransition()
+		transition.config.FeeDelegation = false
+
+		tx, err := signer.SignFeePayer(newDelegatedTx(t, sponsor), sponsorKey)
+		assert.NoError(t, err)
+
+		_, err = transition.Apply(tx)
+		assert.Equal(t, NewTransitionApplicationError(ErrFeeDelegationDisabled, false), err)
+
+		assert.Equal(t, big.NewInt(1000000), transition.GetBalance(sponsor))
+	})
+
+	t.Run("should not charge the fee payer without its signature", func(t *testing.T) {
+		transition := newTransition()
+
+		_, err := transition.Apply(newDelegatedTx(t, sponsor))
+		assert.Equal(t, NewTransitionApplicationError(ErrInvalidFeePayer, false), err)
+
+		assert.Equal(t, big.NewInt(1000000), transition.GetBalance(sponsor))
+	})
+
+	t.Run("should not charge a fee payer not whitelisted", func(t *testing.T) {
+		transition := newTransition()
+
+		tx, err := signer.SignFeePayer(newDelegatedTx(t, other), otherKey)
+		assert.NoError(t, err)
+
+		_, err = transition.Apply(tx)
+		assert.Equal(t, NewTransitionApplicationError(ErrSponsorNotWhitelisted, false), err)
+
+		assert.Equal(t, big.NewInt(1000000), transition.GetBalance(other))
+	})
+}
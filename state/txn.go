@@ -82,6 +82,22 @@ func (txn *Txn) RevertToSnapshot(id int) {
 	txn.txn = tree.Txn()
 }
 
+// Copy returns an independent copy of the current state,
+// which can be modified without affecting the original one
+func (txn *Txn) Copy() *Txn {
+	snapshots := make([]*iradix.Tree, len(txn.snapshots))
+	copy(snapshots, txn.snapshots)
+
+	return &Txn{
+		snapshot:  txn.snapshot,
+		state:     txn.state,
+		snapshots: snapshots,
+		txn:       txn.txn.CommitOnly().Txn(),
+		codeCache: txn.codeCache,
+		hash:      keccak.NewKeccak256(),
+	}
+}
+
 // GetAccount returns an account
 func (txn *Txn) GetAccount(addr types.Address) (*Account, bool) {
 	object, exists := txn.getStateObject(addr)
@@ -339,6 +355,27 @@ func (txn *Txn) SetState(
 	})
 }
 
+// SetFullStorage replaces the entire storage of an address with the given slots
+func (txn *Txn) SetFullStorage(
+	addr types.Address,
+	storage map[types.Hash]types.Hash,
+) {
+	txn.upsertAccount(addr, true, func(object *StateObject) {
+		// Drop the reference to the committed storage trie
+		object.Account.Root = emptyStateHash
+		object.Account.Trie = txn.state.NewSnapshot()
+		object.Txn = iradix.New().Txn()
+
+		for key, value := range storage {
+			if value == zeroHash {
+				object.Txn.Insert(key.Bytes(), nil)
+			} else {
+				object.Txn.Insert(key.Bytes(), value.Bytes())
+			}
+		}
+	})
+}
+
 // GetState returns the state of the address at a given key
 func (txn *Txn) GetState(addr types.Address, key types.Hash) types.Hash {
 	object, exists := txn.getStateObject(addr)
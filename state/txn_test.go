@@ -32,7 +32,8 @@ func (m *mockState) NewSnapshot() Snapshot {
 }
 
 func (m *mockState) GetCode(hash types.Hash) ([]byte, bool) {
-	panic("Not implemented in tests")
+	// the accounts in tests don't have code
+	return nil, false
 }
 
 type mockSnapshot struct {
@@ -139,6 +140,19 @@ func TestSnapshotUpdateData(t *testing.T) {
 	assert.Equal(t, hash1, txn.GetState(addr1, hash1))
 }
 
+func TestTxnCopy(t *testing.T) {
+	txn := newTestTxn(defaultPreState)
+	txn.SetState(addr1, hash1, hash1)
+
+	c := txn.Copy()
+	c.SetState(addr1, hash1, hash2)
+	c.AddBalance(addr1, big.NewInt(1))
+
+	assert.Equal(t, hash2, c.GetState(addr1, hash1))
+	assert.Equal(t, hash1, txn.GetState(addr1, hash1))
+	assert.Equal(t, 0, c.GetBalance(addr1).Cmp(new(big.Int).Add(txn.GetBalance(addr1), big.NewInt(1))))
+}
+
 func hashit(k []byte) []byte {
 	h := sha3.NewLegacyKeccak256()
 	h.Write(k)
@@ -1,8 +1,11 @@
 package txpool
 
 import (
+	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -15,23 +18,24 @@ type accountsMap struct {
 }
 
 // Intializes an account for the given address.
+// The account is fully initialized before it is stored,
+// so concurrent readers never observe empty queues.
 func (m *accountsMap) initOnce(addr types.Address, nonce uint64) *account {
-	a, _ := m.LoadOrStore(addr, &account{})
-	newAccount := a.(*account) // nolint:forcetypeassert
-	// run only once
-	newAccount.init.Do(func() {
-		// create queues
-		newAccount.enqueued = newAccountQueue()
-		newAccount.promoted = newAccountQueue()
+	newAccount := &account{
+		enqueued: newAccountQueue(),
+		promoted: newAccountQueue(),
+	}
 
-		// set the nonce
-		newAccount.setNonce(nonce)
+	// set the nonce
+	newAccount.setNonce(nonce)
 
+	a, loaded := m.LoadOrStore(addr, newAccount)
+	if !loaded {
 		// update global count
 		atomic.AddUint64(&m.count, 1)
-	})
+	}
 
-	return newAccount
+	return a.(*account) // nolint:forcetypeassert
 }
 
 // exists checks if an account exists within the map.
@@ -61,6 +65,32 @@ func (m *accountsMap) getPrimaries() (primaries []*types.Transaction) {
 	return primaries
 }
 
+// lowestPricedEvictable returns the lowest priced transaction that can be evicted
+// from the non-local accounts, excluding the given address, or nil if there is none.
+func (m *accountsMap) lowestPricedEvictable(exclude types.Address) (lowest *types.Transaction) {
+	m.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account := m.get(addr)
+
+		if addr == exclude || account.isLocal() {
+			return true
+		}
+
+		tx := account.evictionCandidate()
+		if tx == nil {
+			return true
+		}
+
+		if lowest == nil || tx.GasPrice.Cmp(lowest.GasPrice) < 0 {
+			lowest = tx
+		}
+
+		return true
+	})
+
+	return
+}
+
 // get returns the account associated with the given address.
 func (m *accountsMap) get(addr types.Address) *account {
 	a, ok := m.Load(addr)
@@ -116,6 +146,29 @@ func (m *accountsMap) allTxs(includeEnqueued bool) (
 	return
 }
 
+// localTxs returns the promoted and enqueued transactions of all local accounts.
+func (m *accountsMap) localTxs() (txs []*types.Transaction) {
+	m.Range(func(key, value interface{}) bool {
+		account := m.get(key.(types.Address)) // nolint:forcetypeassert
+		if !account.isLocal() {
+			return true
+		}
+
+		account.promoted.lock(false)
+		defer account.promoted.unlock()
+
+		account.enqueued.lock(false)
+		defer account.enqueued.unlock()
+
+		txs = append(txs, account.promoted.queue...)
+		txs = append(txs, account.enqueued.queue...)
+
+		return true
+	})
+
+	return
+}
+
 // An account is the core structure for processing
 // transactions from a specific address. The nextNonce
 // field is what separetes the enqueued from promoted:
@@ -128,9 +181,36 @@ func (m *accountsMap) allTxs(includeEnqueued bool) (
 // indicating the account's enqueued transaction(s)
 // are ready to be moved to the promoted queue.
 type account struct {
-	init               sync.Once
 	enqueued, promoted *accountQueue
 	nextNonce          uint64
+
+	// flag indicating if the account submitted
+	// transactions through the local endpoints
+	local uint32
+
+	// time (unix nano) of the last enqueued queue activity,
+	// used for expiring transactions with nonce gaps
+	lastActivity int64
+}
+
+// isLocal returns true if the account submitted local transactions.
+func (a *account) isLocal() bool {
+	return atomic.LoadUint32(&a.local) == 1
+}
+
+// markLocal marks the account as local, exempting it from eviction.
+func (a *account) markLocal() {
+	atomic.StoreUint32(&a.local, 1)
+}
+
+// touch records activity on the enqueued queue of the account.
+func (a *account) touch() {
+	atomic.StoreInt64(&a.lastActivity, time.Now().UnixNano())
+}
+
+// idleFor returns how long the enqueued queue of the account has been inactive.
+func (a *account) idleFor() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&a.lastActivity)))
 }
 
 // getNonce returns the next expected nonce for this account.
@@ -155,12 +235,271 @@ func (a *account) enqueue(tx *types.Transaction, demoted bool) error {
 		return ErrNonceTooLow
 	}
 
+	// an empty queue starts a new lifetime
+	if a.enqueued.length() == 0 {
+		a.touch()
+	}
+
 	// enqueue tx
 	a.enqueued.push(tx)
 
 	return nil
 }
 
+// evictionCandidate returns the transaction of the account evicted first
+// when the pool is full: the highest nonce enqueued transaction, or the
+// highest nonce promoted transaction if nothing is enqueued. The primary
+// (head of the promoted queue) is never a candidate, since it can
+// currently be processed by consensus.
+func (a *account) evictionCandidate() *types.Transaction {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if tx := a.enqueued.tail(); tx != nil {
+		return tx
+	}
+
+	if a.promoted.length() > 1 {
+		return a.promoted.tail()
+	}
+
+	return nil
+}
+
+// evict removes the given transaction from the account if it is still
+// the eviction candidate. Evicting a promoted transaction rolls back
+// the account's nextNonce. Returns a flag indicating if the transaction
+// was promoted, and a flag indicating if it was evicted.
+func (a *account) evict(tx *types.Transaction) (bool, bool) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if a.enqueued.length() != 0 {
+		return false, a.enqueued.tail() == tx && a.enqueued.remove(tx)
+	}
+
+	if a.promoted.length() < 2 ||
+		a.promoted.tail() != tx ||
+		!a.promoted.remove(tx) {
+		return true, false
+	}
+
+	if tx.Nonce < a.getNonce() {
+		// rollback nonce
+		a.setNonce(tx.Nonce)
+	}
+
+	return true, true
+}
+
+// pruneExpired removes all enqueued transactions if the first one
+// has a nonce gap and the queue has been inactive for longer than
+// the given lifetime. Returns the removed transactions.
+func (a *account) pruneExpired(lifetime time.Duration) []*types.Transaction {
+	a.enqueued.lock(true)
+	defer a.enqueued.unlock()
+
+	first := a.enqueued.peek()
+	if first == nil ||
+		first.Nonce <= a.getNonce() ||
+		a.idleFor() < lifetime {
+		return nil
+	}
+
+	return a.enqueued.clear()
+}
+
+// getByNonce returns the promoted or enqueued transaction
+// with the given nonce, or nil if there is none.
+func (a *account) getByNonce(nonce uint64) *types.Transaction {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if tx := a.promoted.getByNonce(nonce); tx != nil {
+		return tx
+	}
+
+	return a.enqueued.getByNonce(nonce)
+}
+
+// txs returns copies of the promoted and enqueued queues, sorted by nonce.
+func (a *account) txs() (promoted, enqueued []*types.Transaction) {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	return sortedByNonce(a.promoted.queue), sortedByNonce(a.enqueued.queue)
+}
+
+// contains checks if the given transaction belongs to the account.
+// Returns a flag indicating if it is promoted, and a flag indicating if it was found.
+func (a *account) contains(tx *types.Transaction) (bool, bool) {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	for _, queue := range []*accountQueue{a.promoted, a.enqueued} {
+		for _, queued := range queue.queue {
+			if queued == tx {
+				return queue == a.promoted, true
+			}
+		}
+	}
+
+	return false, false
+}
+
+// drop removes the given transaction from the account. Dropping a promoted
+// transaction rolls back the account's nextNonce and demotes the following
+// promoted transactions, which are no longer executable because of the nonce gap.
+// Returns the demoted transactions, a flag indicating if the transaction
+// was promoted, and a flag indicating if it was dropped.
+func (a *account) drop(tx *types.Transaction) ([]*types.Transaction, bool, bool) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if a.enqueued.remove(tx) {
+		return nil, false, true
+	}
+
+	if !a.promoted.remove(tx) {
+		return nil, false, false
+	}
+
+	var demoted []*types.Transaction
+
+	for _, promoted := range a.promoted.clear() {
+		if promoted.Nonce < tx.Nonce {
+			a.promoted.push(promoted)
+
+			continue
+		}
+
+		a.enqueued.push(promoted)
+		demoted = append(demoted, promoted)
+	}
+
+	if tx.Nonce < a.getNonce() {
+		// rollback nonce
+		a.setNonce(tx.Nonce)
+	}
+
+	// the demoted txs start a new lifetime
+	if len(demoted) > 0 {
+		a.touch()
+	}
+
+	return demoted, true, true
+}
+
+// dropAll removes all transactions of the account and rolls back
+// the account's nextNonce to the lowest promoted nonce.
+// Returns the removed promoted and enqueued transactions.
+func (a *account) dropAll() (promoted, enqueued []*types.Transaction) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	promoted = a.promoted.clear()
+	enqueued = a.enqueued.clear()
+
+	if len(promoted) > 0 && promoted[0].Nonce < a.getNonce() {
+		// rollback nonce
+		a.setNonce(promoted[0].Nonce)
+	}
+
+	return
+}
+
+// sortedByNonce returns a copy of the given transactions sorted by nonce.
+func sortedByNonce(txs []*types.Transaction) []*types.Transaction {
+	sorted := make([]*types.Transaction, len(txs))
+	copy(sorted, txs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Nonce < sorted[j].Nonce
+	})
+
+	return sorted
+}
+
+// replace swaps the promoted or enqueued transaction having the same nonce
+// as the given one, if the gas price of the given transaction is higher by
+// at least priceBump percent. Returns the replaced transaction (nil if there is
+// no transaction with the same nonce) and a flag indicating if it was promoted.
+func (a *account) replace(tx *types.Transaction, priceBump uint64) (*types.Transaction, bool, error) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	for _, queue := range []*accountQueue{a.promoted, a.enqueued} {
+		old := queue.getByNonce(tx.Nonce)
+		if old == nil {
+			continue
+		}
+
+		if !isReplacementPriced(old, tx, priceBump) {
+			return nil, false, ErrReplacementUnderpriced
+		}
+
+		queue.replace(tx)
+
+		return old, queue == a.promoted, nil
+	}
+
+	return nil, false, nil
+}
+
+// isReplacementPriced checks if the gas price of the replacement transaction
+// is higher than the gas price of the replaced one by at least priceBump percent.
+func isReplacementPriced(old, replacement *types.Transaction, priceBump uint64) bool {
+	if replacement.GasPrice.Cmp(old.GasPrice) <= 0 {
+		return false
+	}
+
+	// threshold = old price * (100 + priceBump) / 100
+	threshold := new(big.Int).Mul(old.GasPrice, new(big.Int).SetUint64(100+priceBump))
+	threshold.Div(threshold, big.NewInt(100))
+
+	return replacement.GasPrice.Cmp(threshold) >= 0
+}
+
 // Promote moves eligible transactions from enqueued to promoted.
 //
 // Eligible transactions are all sequential in order of nonce
@@ -211,5 +550,10 @@ func (a *account) promote() (uint64, []types.Hash) {
 		a.setNonce(nextNonce)
 	}
 
+	// promotion renews the lifetime of the remaining enqueued txs
+	if promoted > 0 {
+		a.touch()
+	}
+
 	return promoted, promotedTxnHashes
 }
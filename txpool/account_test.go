@@ -0,0 +1,92 @@
+package txpool
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrently runs each of the given functions n times
+func runConcurrently(n int, fns ...func(i int)) {
+	wg := sync.WaitGroup{}
+
+	for _, fn := range fns {
+		wg.Add(1)
+
+		go func(fn func(i int)) {
+			defer wg.Done()
+
+			for i := 0; i < n; i++ {
+				fn(i)
+			}
+		}(fn)
+	}
+
+	wg.Wait()
+}
+
+func TestAccountsMap_InitOnce_ConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	accounts := &accountsMap{}
+
+	runConcurrently(
+		1000,
+		// new accounts are stored
+		func(i int) {
+			accounts.initOnce(types.BytesToAddress([]byte{byte(i >> 8), byte(i)}), uint64(i))
+		},
+		// while the block builder and the queries read their queues
+		func(int) {
+			accounts.getPrimaries()
+		},
+		func(int) {
+			accounts.promoted()
+		},
+	)
+
+	assert.Equal(t, uint64(1000), accounts.count)
+
+	for i := 0; i < 1000; i++ {
+		account := accounts.get(types.BytesToAddress([]byte{byte(i >> 8), byte(i)}))
+
+		assert.NotNil(t, account.promoted)
+		assert.NotNil(t, account.enqueued)
+		assert.Equal(t, uint64(i), account.getNonce())
+	}
+}
+
+func TestPricedQueue_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	queue := newPricedQueue()
+
+	txs := make([]*types.Transaction, 1000)
+	for i := range txs {
+		txs[i] = newTx(addr1, uint64(i), 1)
+		txs[i].Hash = types.BytesToHash([]byte{byte(i >> 8), byte(i)})
+	}
+
+	runConcurrently(
+		len(txs),
+		// the promoted transactions are pushed
+		func(i int) {
+			queue.push(txs[i])
+		},
+		// while the replaced ones are removed
+		func(i int) {
+			queue.remove(txs[i])
+		},
+		// and the block builder pops the executables
+		func(int) {
+			queue.pop()
+		},
+		func(int) {
+			queue.length()
+		},
+	)
+
+	assert.LessOrEqual(t, queue.length(), uint64(len(txs)))
+}
@@ -0,0 +1,654 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	libp2pGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/grpc"
+	any "google.golang.org/protobuf/types/known/anypb"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	announceProto = "/txpool/announce/0.1"
+
+	// announceInterval is the period of the hash announcement batches
+	announceInterval = 100 * time.Millisecond
+
+	// maxAnnounceBatch is the maximum number of hashes
+	// in a single announcement or transaction request
+	maxAnnounceBatch = 256
+
+	// maxQueuedAnnouncements is the number of announcement batches waiting
+	// to be sent to each peer, the new batches are dropped once full
+	maxQueuedAnnouncements = 16
+
+	// maxKnownHashes is the number of hashes remembered as known by each peer
+	maxKnownHashes = 32768
+
+	// maxRelayTxs is the number of transactions kept by non sealing
+	// nodes for relaying them, as they are not added to the pool
+	maxRelayTxs = 4096
+
+	// maxFetchingHashes is the maximum number of hashes being fetched at once
+	maxFetchingHashes = 4096
+
+	// maxAlternateAnnouncers is the maximum number of other peers remembered
+	// for each hash being fetched, which are asked if the fetch fails
+	maxAlternateAnnouncers = 4
+
+	// announceTimeout is the timeout of the requests made to peers
+	announceTimeout = 5 * time.Second
+)
+
+var (
+	errUnknownAnnouncePeer   = errors.New("unknown peer")
+	errOversizedAnnouncement = errors.New("oversized announcement")
+)
+
+// hashCache is a bounded cache of transactions by hash, evicting the
+// oldest entries once full. Entries without a transaction are allowed,
+// so it also serves as a set of hashes.
+type hashCache struct {
+	sync.Mutex
+
+	entries map[types.Hash]*types.Transaction
+
+	// ring buffer of the cached hashes, in insertion order
+	order []types.Hash
+	next  int
+	size  int
+}
+
+func newHashCache(size int) *hashCache {
+	return &hashCache{
+		entries: make(map[types.Hash]*types.Transaction),
+		order:   make([]types.Hash, 0, size),
+		size:    size,
+	}
+}
+
+// add inserts the given entry into the cache.
+// Returns false if the hash was already cached.
+func (c *hashCache) add(hash types.Hash, tx *types.Transaction) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.addLocked(hash, tx)
+}
+
+func (c *hashCache) addLocked(hash types.Hash, tx *types.Transaction) bool {
+	if _, ok := c.entries[hash]; ok {
+		return false
+	}
+
+	if len(c.order) < c.size {
+		c.order = append(c.order, hash)
+	} else {
+		// evict the oldest entry
+		delete(c.entries, c.order[c.next])
+		c.order[c.next] = hash
+		c.next = (c.next + 1) % c.size
+	}
+
+	c.entries[hash] = tx
+
+	return true
+}
+
+// addUnknown inserts the given hashes into the cache
+// and returns the ones which were not cached yet.
+func (c *hashCache) addUnknown(hashes []types.Hash) []types.Hash {
+	c.Lock()
+	defer c.Unlock()
+
+	unknown := make([]types.Hash, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if c.addLocked(hash, nil) {
+			unknown = append(unknown, hash)
+		}
+	}
+
+	return unknown
+}
+
+// get returns the cached transaction with the given hash (if any).
+func (c *hashCache) get(hash types.Hash) (*types.Transaction, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	tx, ok := c.entries[hash]
+
+	return tx, ok && tx != nil
+}
+
+// has returns true if the given hash is cached.
+func (c *hashCache) has(hash types.Hash) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	_, ok := c.entries[hash]
+
+	return ok
+}
+
+// announcePeer is a peer supporting the announcement protocol
+type announcePeer struct {
+	id     peer.ID
+	conn   *grpc.ClientConn
+	client proto.TxAnnounceClient
+
+	// hashes known by the peer, which are not announced to it
+	known *hashCache
+
+	// announcement batches sent to the peer in order by a single worker
+	queue   chan []types.Hash
+	closeCh chan struct{}
+}
+
+func newAnnouncePeer(id peer.ID, conn *grpc.ClientConn) *announcePeer {
+	return &announcePeer{
+		id:      id,
+		conn:    conn,
+		client:  proto.NewTxAnnounceClient(conn),
+		known:   newHashCache(maxKnownHashes),
+		queue:   make(chan []types.Hash, maxQueuedAnnouncements),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// enqueue schedules the given batch to be announced to the peer.
+// Returns false if the queue of the peer is full.
+func (p *announcePeer) enqueue(batch []types.Hash) bool {
+	select {
+	case p.queue <- batch:
+		return true
+	default:
+		return false
+	}
+}
+
+// announcer propagates the transactions of the pool to the peers by
+// gossiping batches of their hashes. Peers fetch the transactions
+// they don't know yet over a direct stream.
+type announcer struct {
+	proto.UnimplementedTxAnnounceServer
+
+	logger  hclog.Logger
+	pool    *TxPool
+	network *network.Server
+
+	// peers supporting the announcement protocol
+	peers sync.Map
+
+	// hashes waiting for the next announcement batch
+	pendingLock sync.Mutex
+	pending     []types.Hash
+
+	// hashes being fetched from peers, with the other
+	// peers which announced them and were not asked yet
+	fetchingLock sync.Mutex
+	fetching     map[types.Hash][]peer.ID
+
+	// transactions relayed by non sealing nodes
+	relay *hashCache
+
+	closeCh chan struct{}
+}
+
+func newAnnouncer(logger hclog.Logger, pool *TxPool, network *network.Server) *announcer {
+	return &announcer{
+		logger:   logger.Named("announcer"),
+		pool:     pool,
+		network:  network,
+		fetching: make(map[types.Hash][]peer.ID),
+		relay:    newHashCache(maxRelayTxs),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+// start registers the announcement protocol and
+// starts announcing transactions to the peers.
+func (a *announcer) start() {
+	grpcStream := libp2pGrpc.NewGrpcStream()
+	proto.RegisterTxAnnounceServer(grpcStream.GrpcServer(), a)
+	grpcStream.Serve()
+	a.network.Register(announceProto, grpcStream)
+
+	for _, p := range a.network.Peers() {
+		a.addPeer(p.Info.ID)
+	}
+
+	go a.handlePeerEvents()
+	go a.run()
+}
+
+// close stops the announcements
+func (a *announcer) close() {
+	close(a.closeCh)
+
+	a.peers.Range(func(key, _ interface{}) bool {
+		a.delPeer(key.(peer.ID)) // nolint:forcetypeassert
+
+		return true
+	})
+}
+
+// handlePeerEvents keeps track of the connected peers
+func (a *announcer) handlePeerEvents() {
+	sub, err := a.network.Subscribe()
+	if err != nil {
+		a.logger.Error("failed to subscribe", "err", err)
+
+		return
+	}
+
+	// the subscription is closed by the announcer itself since the pool
+	// may stop before the network server
+	defer sub.Close()
+
+	for {
+		select {
+		case evnt := <-sub.GetCh():
+			switch evnt.Type {
+			case network.PeerConnected:
+				a.addPeer(evnt.PeerID)
+			case network.PeerDisconnected:
+				a.delPeer(evnt.PeerID)
+			}
+		case <-a.closeCh:
+			return
+		}
+	}
+}
+
+// addPeer opens an announcement stream to the given peer.
+// Peers not supporting the protocol are skipped.
+func (a *announcer) addPeer(id peer.ID) *announcePeer {
+	if p, ok := a.peers.Load(id); ok {
+		return p.(*announcePeer) // nolint:forcetypeassert
+	}
+
+	stream, err := a.network.NewStream(announceProto, id)
+	if err != nil {
+		a.logger.Debug("peer doesn't support tx announcements", "peer", id, "err", err)
+
+		return nil
+	}
+
+	conn := libp2pGrpc.WrapClient(stream)
+
+	p, loaded := a.peers.LoadOrStore(id, newAnnouncePeer(id, conn))
+	if loaded {
+		// added concurrently
+		_ = conn.Close()
+	} else {
+		go a.sendLoop(p.(*announcePeer)) // nolint:forcetypeassert
+	}
+
+	return p.(*announcePeer) // nolint:forcetypeassert
+}
+
+// delPeer closes the announcement stream to the given peer
+func (a *announcer) delPeer(id peer.ID) {
+	p, ok := a.peers.LoadAndDelete(id)
+	if !ok {
+		return
+	}
+
+	close(p.(*announcePeer).closeCh) // nolint:forcetypeassert
+
+	if err := p.(*announcePeer).conn.Close(); err != nil { // nolint:forcetypeassert
+		a.logger.Debug("failed to close stream", "peer", id, "err", err)
+	}
+}
+
+// getPeer returns the announcement peer with the given ID,
+// opening a stream to it if there is none yet
+func (a *announcer) getPeer(ctx context.Context) (*announcePeer, error) {
+	grpcCtx, ok := ctx.(*libp2pGrpc.Context)
+	if !ok {
+		return nil, errUnknownAnnouncePeer
+	}
+
+	p := a.addPeer(grpcCtx.PeerID)
+	if p == nil {
+		return nil, errUnknownAnnouncePeer
+	}
+
+	return p, nil
+}
+
+// announce schedules the given hashes for the next announcement batch
+func (a *announcer) announce(hashes ...types.Hash) {
+	a.pendingLock.Lock()
+	a.pending = append(a.pending, hashes...)
+	a.pendingLock.Unlock()
+}
+
+// run sends the pending announcements periodically
+func (a *announcer) run() {
+	ticker := time.NewTicker(announceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.flush()
+		case <-a.closeCh:
+			return
+		}
+	}
+}
+
+// flush announces the pending hashes to the peers not knowing them yet
+func (a *announcer) flush() {
+	a.pendingLock.Lock()
+	hashes := a.pending
+	a.pending = nil
+	a.pendingLock.Unlock()
+
+	if len(hashes) == 0 {
+		return
+	}
+
+	a.peers.Range(func(_, value interface{}) bool {
+		p := value.(*announcePeer) // nolint:forcetypeassert
+
+		unknown := p.known.addUnknown(hashes)
+
+		for len(unknown) > 0 {
+			batch := unknown
+			if len(batch) > maxAnnounceBatch {
+				batch = batch[:maxAnnounceBatch]
+			}
+
+			unknown = unknown[len(batch):]
+
+			if !p.enqueue(batch) {
+				a.logger.Debug("dropped announcement, the peer queue is full", "peer", p.id, "hashes", len(batch))
+			}
+		}
+
+		return true
+	})
+}
+
+// sendLoop announces the queued batches to the peer one at a time,
+// until the peer is removed
+func (a *announcer) sendLoop(p *announcePeer) {
+	for {
+		select {
+		case batch := <-p.queue:
+			a.send(p, batch)
+		case <-p.closeCh:
+			return
+		}
+	}
+}
+
+// send announces the given hashes to the peer
+func (a *announcer) send(p *announcePeer, hashes []types.Hash) {
+	ctx, cancel := context.WithTimeout(context.Background(), announceTimeout)
+	defer cancel()
+
+	if _, err := p.client.AnnounceTxs(ctx, toProtoHashes(hashes)); err != nil {
+		a.logger.Debug("failed to announce txs", "peer", p.id, "err", err)
+	}
+}
+
+// AnnounceTxs implements the announcement endpoint. It fetches
+// the announced transactions which are not known yet.
+func (a *announcer) AnnounceTxs(ctx context.Context, req *proto.TxnHashes) (*empty.Empty, error) {
+	p, err := a.getPeer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// announcements are sent in batches of limited size
+	if len(req.Hashes) > maxAnnounceBatch {
+		a.pool.reportPeer(p.id, errOversizedAnnouncement)
+
+		return nil, errOversizedAnnouncement
+	}
+
+	hashes := fromProtoHashes(req.Hashes)
+
+	// the peer knows the hashes it announced
+	p.known.addUnknown(hashes)
+
+	if missing := a.markFetching(p.id, hashes); len(missing) > 0 {
+		go a.fetch(p, missing)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetTxs implements the fetching endpoint. It returns the requested
+// transactions found in the pool (except the private ones) or relayed.
+func (a *announcer) GetTxs(ctx context.Context, req *proto.TxnHashes) (*proto.Txns, error) {
+	hashes := fromProtoHashes(req.Hashes)
+
+	if len(hashes) > maxAnnounceBatch {
+		hashes = hashes[:maxAnnounceBatch]
+	}
+
+	resp := &proto.Txns{}
+
+	for _, hash := range hashes {
+		// private txs are only forwarded to the validators
+		if a.pool.privateTxs.has(hash) {
+			continue
+		}
+
+		tx, ok := a.pool.index.get(hash)
+		if !ok {
+			if tx, ok = a.relay.get(hash); !ok {
+				continue
+			}
+		}
+
+		resp.Txs = append(resp.Txs, &proto.Txn{
+			Raw: &any.Any{
+				Value: tx.MarshalRLP(),
+			},
+		})
+	}
+
+	// the peer requesting the transactions will know them
+	if p, err := a.getPeer(ctx); err == nil {
+		p.known.addUnknown(hashes)
+	}
+
+	return resp, nil
+}
+
+// markFetching returns the hashes announced by the given peer which are unknown
+// to the node and not being fetched yet, marking them as fetched. The peer is kept
+// as an alternate announcer of the hashes which are already being fetched
+func (a *announcer) markFetching(id peer.ID, hashes []types.Hash) []types.Hash {
+	a.fetchingLock.Lock()
+	defer a.fetchingLock.Unlock()
+
+	missing := make([]types.Hash, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if alternates, ok := a.fetching[hash]; ok {
+			if len(alternates) < maxAlternateAnnouncers && !containsPeer(alternates, id) {
+				a.fetching[hash] = append(alternates, id)
+			}
+
+			continue
+		}
+
+		if _, ok := a.pool.index.get(hash); ok {
+			continue
+		}
+
+		if a.relay.has(hash) {
+			continue
+		}
+
+		// the remaining hashes are fetched once announced again
+		if len(a.fetching) >= maxFetchingHashes {
+			break
+		}
+
+		a.fetching[hash] = nil
+		missing = append(missing, hash)
+	}
+
+	return missing
+}
+
+// nextFetches removes the fetched hashes from the ones being fetched and returns
+// the failed ones grouped by the next connected peer which announced them.
+// The failed hashes without any alternate announcer are removed as well
+func (a *announcer) nextFetches(fetched, failed []types.Hash) map[*announcePeer][]types.Hash {
+	a.fetchingLock.Lock()
+	defer a.fetchingLock.Unlock()
+
+	for _, hash := range fetched {
+		delete(a.fetching, hash)
+	}
+
+	next := make(map[*announcePeer][]types.Hash)
+
+	for _, hash := range failed {
+		alternates := a.fetching[hash]
+		found := false
+
+		for !found && len(alternates) > 0 {
+			value, ok := a.peers.Load(alternates[0])
+			alternates = alternates[1:]
+
+			if ok {
+				p := value.(*announcePeer) // nolint:forcetypeassert
+				next[p] = append(next[p], hash)
+				found = true
+			}
+		}
+
+		if !found {
+			delete(a.fetching, hash)
+
+			continue
+		}
+
+		a.fetching[hash] = alternates
+	}
+
+	return next
+}
+
+// fetch requests the given transactions from the peer and passes them to the pool.
+// The transactions not returned by the peer are requested from the other peers
+// which announced them (if any)
+func (a *announcer) fetch(p *announcePeer, hashes []types.Hash) {
+	received := a.request(p, hashes)
+
+	fetched := make([]types.Hash, 0, len(received))
+	failed := make([]types.Hash, 0, len(hashes)-len(received))
+
+	for _, hash := range hashes {
+		if _, ok := received[hash]; ok {
+			fetched = append(fetched, hash)
+		} else {
+			failed = append(failed, hash)
+		}
+	}
+
+	for next, retried := range a.nextFetches(fetched, failed) {
+		go a.fetch(next, retried)
+	}
+}
+
+// request requests the given transactions from the peer, passes them
+// to the pool and returns the hashes of the received transactions
+func (a *announcer) request(p *announcePeer, hashes []types.Hash) map[types.Hash]struct{} {
+	received := make(map[types.Hash]struct{}, len(hashes))
+
+	ctx, cancel := context.WithTimeout(context.Background(), announceTimeout)
+	defer cancel()
+
+	resp, err := p.client.GetTxs(ctx, toProtoHashes(hashes))
+	if err != nil {
+		a.logger.Debug("failed to fetch txs", "peer", p.id, "err", err)
+
+		return received
+	}
+
+	requested := make(map[types.Hash]struct{}, len(hashes))
+	for _, hash := range hashes {
+		requested[hash] = struct{}{}
+	}
+
+	for _, raw := range resp.Txs {
+		if raw.Raw == nil {
+			continue
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw.Raw.Value); err != nil {
+			a.logger.Debug("failed to decode fetched tx", "peer", p.id, "err", err)
+			a.pool.reportPeer(p.id, err)
+
+			continue
+		}
+
+		// ignore the transactions which were not requested
+		if _, ok := requested[tx.Hash]; !ok {
+			continue
+		}
+
+		received[tx.Hash] = struct{}{}
+
+		a.pool.addFetchedTx(tx, p.id)
+	}
+
+	return received
+}
+
+func containsPeer(ids []peer.ID, id peer.ID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toProtoHashes(hashes []types.Hash) *proto.TxnHashes {
+	raw := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		raw[i] = hash.Bytes()
+	}
+
+	return &proto.TxnHashes{
+		Hashes: raw,
+	}
+}
+
+func fromProtoHashes(raw [][]byte) []types.Hash {
+	hashes := make([]types.Hash, 0, len(raw))
+
+	for _, buf := range raw {
+		if len(buf) != types.HashLength {
+			continue
+		}
+
+		hashes = append(hashes, types.BytesToHash(buf))
+	}
+
+	return hashes
+}
@@ -0,0 +1,237 @@
+package txpool
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/network"
+	libp2pGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashCache(t *testing.T) {
+	cache := newHashCache(2)
+
+	hashes := []types.Hash{
+		types.StringToHash("1"),
+		types.StringToHash("2"),
+		types.StringToHash("3"),
+	}
+
+	tx := newTx(addr1, 0, 1)
+
+	assert.True(t, cache.add(hashes[0], tx))
+	assert.False(t, cache.add(hashes[0], tx))
+
+	cached, ok := cache.get(hashes[0])
+	assert.True(t, ok)
+	assert.Equal(t, tx, cached)
+
+	// entries without a transaction are only known
+	assert.Equal(t, hashes[1:2], cache.addUnknown(hashes[:2]))
+	assert.True(t, cache.has(hashes[1]))
+
+	_, ok = cache.get(hashes[1])
+	assert.False(t, ok)
+
+	// the oldest entry is evicted once full
+	assert.Equal(t, hashes[2:], cache.addUnknown(hashes[1:]))
+	assert.False(t, cache.has(hashes[0]))
+	assert.True(t, cache.has(hashes[1]))
+	assert.True(t, cache.has(hashes[2]))
+}
+
+func TestProtoHashes(t *testing.T) {
+	hashes := []types.Hash{
+		types.StringToHash("1"),
+		types.StringToHash("2"),
+	}
+
+	raw := toProtoHashes(hashes)
+
+	// malformed hashes are skipped
+	raw.Hashes = append(raw.Hashes, []byte{0x1})
+
+	assert.Equal(t, hashes, fromProtoHashes(raw.Hashes))
+}
+
+func TestAnnouncer_Propagation(t *testing.T) {
+	key, _ := tests.GenerateKeyAndAddr(t)
+	signer := crypto.NewEIP155Signer(100)
+
+	newNetworkPool := func(t *testing.T, sealing bool) (*TxPool, *network.Server) {
+		t.Helper()
+
+		server, err := network.CreateServer(nil)
+		assert.NoError(t, err)
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			forks.At(0),
+			defaultMockStore{},
+			nil,
+			server,
+			nilMetrics,
+			&Config{
+				PriceLimit: defaultPriceLimit,
+				MaxSlots:   defaultMaxSlots,
+				Sealing:    sealing,
+			},
+		)
+		assert.NoError(t, err)
+
+		pool.SetSigner(signer)
+		pool.Start()
+
+		t.Cleanup(func() {
+			pool.Close()
+			assert.NoError(t, server.Close())
+		})
+
+		return pool, server
+	}
+
+	// the tx is relayed by a non sealing node: source <-> relay <-> sealer
+	source, sourceServer := newNetworkPool(t, false)
+	relay, relayServer := newNetworkPool(t, false)
+	sealer, sealerServer := newNetworkPool(t, true)
+
+	// the legacy topic is disabled by default
+	assert.Nil(t, source.topic)
+
+	assert.NoError(t, network.JoinAndWait(sourceServer, relayServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+	assert.NoError(t, network.JoinAndWait(relayServer, sealerServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+
+	signedTx, err := signer.SignTx(newTx(types.ZeroAddress, 0, 1), key)
+	assert.NoError(t, err)
+	assert.NoError(t, source.AddTx(signedTx))
+
+	assert.Eventually(t, func() bool {
+		return sealer.accounts.promoted() == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// the non sealing relay keeps the tx out of its pool
+	assert.Equal(t, uint64(0), relay.Length())
+
+	_, ok := relay.announcer.relay.get(signedTx.Hash)
+	assert.True(t, ok)
+
+	// the hash is known by the peers it was exchanged with
+	peer, ok := source.announcer.peers.Load(relayServer.AddrInfo().ID)
+	if assert.True(t, ok) {
+		assert.True(t, peer.(*announcePeer).known.has(signedTx.Hash))
+	}
+
+	peer, ok = sealer.announcer.peers.Load(relayServer.AddrInfo().ID)
+	if assert.True(t, ok) {
+		assert.True(t, peer.(*announcePeer).known.has(signedTx.Hash))
+	}
+}
+
+func TestAnnouncer_Fetching(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+
+	a := newAnnouncer(hclog.NewNullLogger(), pool, nil)
+
+	peerA, peerB := peer.ID("A"), peer.ID("B")
+
+	hashes := []types.Hash{
+		types.StringToHash("1"),
+		types.StringToHash("2"),
+	}
+
+	assert.Equal(t, hashes, a.markFetching(peerA, hashes))
+
+	// the hashes being fetched are remembered for the other announcers
+	assert.Empty(t, a.markFetching(peerB, hashes[:1]))
+	assert.Empty(t, a.markFetching(peerB, hashes[:1]))
+	assert.Equal(t, []peer.ID{peerB}, a.fetching[hashes[0]])
+
+	alternate := &announcePeer{id: peerB}
+	a.peers.Store(peerB, alternate)
+
+	// the failed hashes are fetched from the alternate announcer
+	next := a.nextFetches(nil, hashes)
+	assert.Equal(t, map[*announcePeer][]types.Hash{alternate: hashes[:1]}, next)
+	assert.Len(t, a.fetching, 1)
+
+	// the hashes are dropped once all the announcers failed
+	assert.Empty(t, a.nextFetches(nil, hashes[:1]))
+	assert.Empty(t, a.fetching)
+
+	// the fetched hashes are dropped
+	assert.Equal(t, hashes, a.markFetching(peerA, hashes))
+	assert.Empty(t, a.nextFetches(hashes, nil))
+	assert.Empty(t, a.fetching)
+}
+
+func TestAnnouncer_Limits(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+
+	a := newAnnouncer(hclog.NewNullLogger(), pool, nil)
+
+	id := peer.ID("A")
+	a.peers.Store(id, &announcePeer{id: id, known: newHashCache(maxKnownHashes)})
+
+	ctx := &libp2pGrpc.Context{Context: context.Background(), PeerID: id}
+
+	newHashes := func(offset, count int) []types.Hash {
+		hashes := make([]types.Hash, count)
+		for i := range hashes {
+			hashes[i] = types.StringToHash(strconv.Itoa(offset + i))
+		}
+
+		return hashes
+	}
+
+	// oversized announcements are rejected
+	_, err = a.AnnounceTxs(ctx, toProtoHashes(newHashes(0, maxAnnounceBatch+1)))
+	assert.ErrorIs(t, err, errOversizedAnnouncement)
+	assert.Empty(t, a.fetching)
+
+	// the number of hashes being fetched is bounded
+	assert.Len(t, a.markFetching(id, newHashes(0, maxFetchingHashes+1)), maxFetchingHashes)
+	assert.Empty(t, a.markFetching(id, newHashes(maxFetchingHashes+1, 1)))
+}
+
+func TestAnnouncer_Queue(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+
+	a := newAnnouncer(hclog.NewNullLogger(), pool, nil)
+
+	// the peer worker is not running, so the batches stay queued
+	id := peer.ID("A")
+	p := &announcePeer{
+		id:    id,
+		known: newHashCache(maxKnownHashes),
+		queue: make(chan []types.Hash, maxQueuedAnnouncements),
+	}
+	a.peers.Store(id, p)
+
+	hashes := make([]types.Hash, (maxQueuedAnnouncements+1)*maxAnnounceBatch)
+	for i := range hashes {
+		hashes[i] = types.StringToHash(strconv.Itoa(i))
+	}
+
+	a.announce(hashes...)
+	a.flush()
+
+	// the batches are queued in order, the ones over the limit are dropped
+	assert.Len(t, p.queue, maxQueuedAnnouncements)
+
+	for i := 0; i < maxQueuedAnnouncements; i++ {
+		assert.Equal(t, hashes[i*maxAnnounceBatch:(i+1)*maxAnnounceBatch], <-p.queue)
+	}
+
+	assert.Empty(t, p.queue)
+}
@@ -0,0 +1,214 @@
+package txpool
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// DefaultBundleLifetime is the default (and maximum) number
+	// of blocks a bundle is kept in the store
+	DefaultBundleLifetime = 25
+
+	// maxBundleTxs is the maximum number of transactions in a bundle
+	maxBundleTxs = 32
+
+	// maxBundles is the maximum number of bundles in the store
+	maxBundles = 1024
+)
+
+var (
+	ErrEmptyBundle        = errors.New("empty bundle")
+	ErrBundleTooLarge     = errors.New("too many transactions in the bundle")
+	ErrInvalidBundleRange = errors.New("invalid bundle block range")
+	ErrBundleExpired      = errors.New("bundle max block number already reached")
+	ErrBundleStoreFull    = errors.New("bundle store is full")
+	ErrBundleNotSealing   = errors.New("bundles are only accepted by sealing nodes, " +
+		"send the bundle to a validator instead")
+)
+
+// Bundle is a list of transactions which are included
+// atomically and in order in a block of the given range
+type Bundle struct {
+	Hash           types.Hash
+	Txs            []*types.Transaction
+	MinBlockNumber uint64
+	MaxBlockNumber uint64
+}
+
+// bundleHash returns the hash of the given transactions
+func bundleHash(txs []*types.Transaction) types.Hash {
+	hashes := make([]byte, 0, len(txs)*types.HashLength)
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash.Bytes()...)
+	}
+
+	return types.BytesToHash(crypto.Keccak256(hashes))
+}
+
+// bundleStore keeps the bundles in the order they were received
+type bundleStore struct {
+	sync.RWMutex
+	all []*Bundle
+}
+
+// add adds the bundle to the store. [thread-safe]
+func (s *bundleStore) add(bundle *Bundle) error {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, b := range s.all {
+		if b.Hash == bundle.Hash {
+			return ErrAlreadyKnown
+		}
+	}
+
+	if len(s.all) >= maxBundles {
+		return ErrBundleStoreFull
+	}
+
+	s.all = append(s.all, bundle)
+
+	return nil
+}
+
+// remove removes the given bundle from the store. [thread-safe]
+func (s *bundleStore) remove(hash types.Hash) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	for i, b := range s.all {
+		if b.Hash == hash {
+			s.all = append(s.all[:i], s.all[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// ready returns the bundles which can be included in the given block. [thread-safe]
+func (s *bundleStore) ready(number uint64) []*Bundle {
+	s.RLock()
+	defer s.RUnlock()
+
+	var ready []*Bundle
+
+	for _, b := range s.all {
+		if b.MinBlockNumber <= number && number <= b.MaxBlockNumber {
+			ready = append(ready, b)
+		}
+	}
+
+	return ready
+}
+
+// prune removes the bundles which can't be included
+// after the given block, returning their number. [thread-safe]
+func (s *bundleStore) prune(number uint64) int {
+	s.Lock()
+	defer s.Unlock()
+
+	kept := s.all[:0]
+
+	for _, b := range s.all {
+		if b.MaxBlockNumber > number {
+			kept = append(kept, b)
+		}
+	}
+
+	pruned := len(s.all) - len(kept)
+
+	// release the references to the pruned bundles
+	for i := len(kept); i < len(s.all); i++ {
+		s.all[i] = nil
+	}
+
+	s.all = kept
+
+	return pruned
+}
+
+// length returns the number of bundles in the store. [thread-safe]
+func (s *bundleStore) length() int {
+	s.RLock()
+	defer s.RUnlock()
+
+	return len(s.all)
+}
+
+// AddBundle adds a bundle of transactions (sent from json-RPC endpoints),
+// which are included atomically and in order by the block builder of the node.
+// The bundles are not gossiped, so non sealing nodes reject them.
+// The bundle targets the blocks from minBlockNumber (next block if zero) to
+// maxBlockNumber, capped by the bundle lifetime (which also applies if zero).
+func (p *TxPool) AddBundle(txs []*types.Transaction, minBlockNumber, maxBlockNumber uint64) (types.Hash, error) {
+	if !p.sealing {
+		return types.ZeroHash, ErrBundleNotSealing
+	}
+
+	if len(txs) == 0 {
+		return types.ZeroHash, ErrEmptyBundle
+	}
+
+	if len(txs) > maxBundleTxs {
+		return types.ZeroHash, ErrBundleTooLarge
+	}
+
+	head := p.store.Header().Number
+	limit := head + DefaultBundleLifetime
+
+	if minBlockNumber == 0 {
+		minBlockNumber = head + 1
+	}
+
+	if maxBlockNumber == 0 || maxBlockNumber > limit {
+		maxBlockNumber = limit
+	}
+
+	if maxBlockNumber <= head {
+		return types.ZeroHash, ErrBundleExpired
+	}
+
+	if minBlockNumber > maxBlockNumber {
+		return types.ZeroHash, ErrInvalidBundleRange
+	}
+
+	for _, tx := range txs {
+		if err := p.validateTx(tx); err != nil {
+			return types.ZeroHash, err
+		}
+
+		tx.ComputeHash()
+	}
+
+	bundle := &Bundle{
+		Hash:           bundleHash(txs),
+		Txs:            txs,
+		MinBlockNumber: minBlockNumber,
+		MaxBlockNumber: maxBlockNumber,
+	}
+
+	if err := p.bundles.add(bundle); err != nil {
+		return types.ZeroHash, err
+	}
+
+	p.logger.Debug("bundle added", "hash", bundle.Hash.String(), "txs", len(txs))
+
+	return bundle.Hash, nil
+}
+
+// Bundles returns the bundles which can be included in the given block
+func (p *TxPool) Bundles(number uint64) []*Bundle {
+	return p.bundles.ready(number)
+}
+
+// RemoveBundle removes the given bundle, after it was
+// included or failed to be included in a block
+func (p *TxPool) RemoveBundle(hash types.Hash) {
+	p.bundles.remove(hash)
+}
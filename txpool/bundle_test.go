@@ -0,0 +1,103 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundles(t *testing.T) {
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+		pool.sealing = true
+
+		return pool
+	}
+
+	t.Run("non sealing node", func(t *testing.T) {
+		pool := setupPool(t)
+		pool.sealing = false
+
+		_, err := pool.AddBundle([]*types.Transaction{newTx(addr1, 0, 1)}, 0, 0)
+		assert.ErrorIs(t, err, ErrBundleNotSealing)
+
+		assert.Zero(t, pool.bundles.length())
+	})
+
+	t.Run("invalid bundles", func(t *testing.T) {
+		pool := setupPool(t)
+
+		_, err := pool.AddBundle(nil, 0, 0)
+		assert.ErrorIs(t, err, ErrEmptyBundle)
+
+		tooLarge := make([]*types.Transaction, maxBundleTxs+1)
+		for i := range tooLarge {
+			tooLarge[i] = newTx(addr1, uint64(i), 1)
+		}
+
+		_, err = pool.AddBundle(tooLarge, 0, 0)
+		assert.ErrorIs(t, err, ErrBundleTooLarge)
+
+		_, err = pool.AddBundle([]*types.Transaction{newTx(addr1, 0, 1)}, 5, 4)
+		assert.ErrorIs(t, err, ErrInvalidBundleRange)
+
+		// the transactions are validated
+		tx := newTx(addr1, 0, 1)
+		tx.Gas = 1
+
+		_, err = pool.AddBundle([]*types.Transaction{tx}, 0, 0)
+		assert.ErrorIs(t, err, ErrIntrinsicGas)
+
+		assert.Zero(t, pool.bundles.length())
+	})
+
+	t.Run("target block range", func(t *testing.T) {
+		pool := setupPool(t)
+
+		txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1)}
+
+		hash, err := pool.AddBundle(txs, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, bundleHash(txs), hash)
+
+		// the bundle is known by its hash
+		_, err = pool.AddBundle(txs, 0, 0)
+		assert.ErrorIs(t, err, ErrAlreadyKnown)
+
+		// the next blocks are targeted, up to the lifetime
+		assert.Empty(t, pool.Bundles(0))
+		assert.Len(t, pool.Bundles(1), 1)
+		assert.Len(t, pool.Bundles(DefaultBundleLifetime), 1)
+		assert.Empty(t, pool.Bundles(DefaultBundleLifetime+1))
+
+		// the bundle transactions are not added to the pool
+		assert.Zero(t, pool.gauge.read())
+
+		_, ok := pool.index.get(txs[0].Hash)
+		assert.False(t, ok)
+
+		pool.RemoveBundle(hash)
+		assert.Empty(t, pool.Bundles(1))
+	})
+
+	t.Run("expired after max block number", func(t *testing.T) {
+		pool := setupPool(t)
+
+		_, err := pool.AddBundle([]*types.Transaction{newTx(addr1, 0, 1)}, 2, 3)
+		assert.NoError(t, err)
+
+		_, err = pool.AddBundle([]*types.Transaction{newTx(addr2, 0, 1)}, 0, 5)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 0, pool.bundles.prune(2))
+		assert.Equal(t, 1, pool.bundles.prune(3))
+		assert.Equal(t, 1, pool.bundles.length())
+		assert.Len(t, pool.Bundles(5), 1)
+	})
+}
@@ -0,0 +1,163 @@
+package txpool
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var errNoActiveJournal = errors.New("no active journal")
+
+// A journal of the locally originated transactions, persisted on disk
+// so they survive node restarts. Each line of the journal file holds one
+// hex encoded RLP transaction.
+type journal struct {
+	sync.Mutex
+
+	// path of the journal file
+	path string
+
+	// writer appending to the journal file,
+	// only set once the journal has been rotated
+	writer *os.File
+}
+
+func newJournal(path string) *journal {
+	return &journal{
+		path: path,
+	}
+}
+
+// load reads the journal file and passes each transaction to the given
+// add function. Malformed entries (e.g. the last line of a journal
+// interrupted by a crash) are skipped. Returns the added transactions.
+func (j *journal) load(add func(*types.Transaction) error) ([]*types.Transaction, error) {
+	file, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// nothing to load
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var (
+		added   []*types.Transaction
+		scanner = bufio.NewScanner(file)
+	)
+
+	// lines are bounded by the max size of a transaction
+	scanner.Buffer(make([]byte, 0, 64*1024), 2*txMaxSize+4)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		raw, err := hex.DecodeHex(line)
+		if err != nil {
+			continue
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			continue
+		}
+
+		if err := add(tx); err != nil {
+			continue
+		}
+
+		added = append(added, tx)
+	}
+
+	return added, scanner.Err()
+}
+
+// insert appends the given transaction to the journal.
+func (j *journal) insert(tx *types.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+
+	if _, err := fmt.Fprintln(j.writer, hex.EncodeToHex(tx.MarshalRLP())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// rotate replaces the content of the journal with the given transactions,
+// dropping all the entries which are no longer in the pool.
+func (j *journal) rotate(txs []*types.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+
+		j.writer = nil
+	}
+
+	// write the new journal next to the current one
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		if _, err := fmt.Fprintln(replacement, hex.EncodeToHex(tx.MarshalRLP())); err != nil {
+			replacement.Close()
+
+			return err
+		}
+	}
+
+	if err := replacement.Close(); err != nil {
+		return err
+	}
+
+	// replace the current journal
+	if err := os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+
+	writer, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	j.writer = writer
+
+	return nil
+}
+
+// close flushes and closes the journal file.
+func (j *journal) close() error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+
+	err := j.writer.Close()
+	j.writer = nil
+
+	return err
+}
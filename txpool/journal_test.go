@@ -0,0 +1,147 @@
+package txpool
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal_InsertInactive(t *testing.T) {
+	j := newJournal(filepath.Join(t.TempDir(), "transactions.journal"))
+
+	assert.ErrorIs(t, j.insert(newTx(addr1, 0, 1)), errNoActiveJournal)
+}
+
+func TestJournal_RotateAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transactions.journal")
+	j := newJournal(path)
+
+	txs := []*types.Transaction{
+		newTx(addr1, 0, 1),
+		newTx(addr1, 1, 1),
+		newTx(addr1, 2, 1),
+	}
+
+	// the journal is only written after the first rotation
+	assert.ErrorIs(t, j.insert(txs[0]), errNoActiveJournal)
+	assert.NoError(t, j.rotate(txs[1:2]))
+	assert.NoError(t, j.insert(txs[2]))
+	assert.NoError(t, j.close())
+
+	// append an entry interrupted by a crash
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	assert.NoError(t, err)
+
+	_, err = file.WriteString("0xf86")
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	var replayed []types.Hash
+
+	loaded, err := newJournal(path).load(func(tx *types.Transaction) error {
+		tx.ComputeHash()
+		replayed = append(replayed, tx.Hash)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, loaded, 2)
+
+	expected := []types.Hash{
+		txs[1].ComputeHash().Hash,
+		txs[2].ComputeHash().Hash,
+	}
+	assert.Equal(t, expected, replayed)
+}
+
+func TestJournal_LoadSkipsRejected(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transactions.journal")
+
+	assert.NoError(t, newJournal(path).rotate([]*types.Transaction{
+		newTx(addr1, 0, 1),
+		newTx(addr1, 1, 1),
+	}))
+
+	loaded, err := newJournal(path).load(func(tx *types.Transaction) error {
+		if tx.Nonce == 0 {
+			return ErrNonceTooLow
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, loaded, 1) {
+		assert.Equal(t, uint64(1), loaded[0].Nonce)
+	}
+}
+
+func TestJournal_LoadMissingFile(t *testing.T) {
+	loaded, err := newJournal(filepath.Join(t.TempDir(), "missing")).load(func(*types.Transaction) error {
+		return errors.New("unexpected tx")
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, loaded)
+}
+
+func TestTxPool_JournalReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transactions.journal")
+	key, sender := tests.GenerateKeyAndAddr(t)
+	signer := crypto.NewEIP155Signer(100)
+
+	newJournaledPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			forks.At(0),
+			defaultMockStore{},
+			nil,
+			nil,
+			nilMetrics,
+			&Config{
+				PriceLimit: defaultPriceLimit,
+				MaxSlots:   defaultMaxSlots,
+				Journal:    path,
+			},
+		)
+		assert.NoError(t, err)
+
+		pool.SetSigner(signer)
+
+		return pool
+	}
+
+	// submit a local tx and shut down
+	pool := newJournaledPool(t)
+	pool.Start()
+
+	signedTx, err := signer.SignTx(newTx(types.ZeroAddress, 0, 1), key)
+	assert.NoError(t, err)
+	assert.NoError(t, pool.AddTx(signedTx))
+
+	pool.Close()
+
+	// the tx is replayed on startup
+	pool = newJournaledPool(t)
+	pool.Start()
+
+	defer pool.Close()
+
+	assert.Eventually(t, func() bool {
+		return pool.Length() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	account := pool.accounts.get(sender)
+	assert.True(t, account.isLocal())
+	assert.Equal(t, signedTx.Hash, account.promoted.peek().Hash)
+}
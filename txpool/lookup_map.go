@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"math/big"
 	"sync"
 
 	"github.com/0xPolygon/polygon-edge/types"
@@ -10,6 +11,9 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// gas cost of the pool transactions paid by each fee payer
+	sponsored map[types.Address]*big.Int
 }
 
 // add inserts the given transaction into the map. [thread-safe]
@@ -18,7 +22,12 @@ func (m *lookupMap) add(txs ...*types.Transaction) {
 	defer m.Unlock()
 
 	for _, tx := range txs {
+		if old, ok := m.all[tx.Hash]; ok {
+			m.unsponsor(old)
+		}
+
 		m.all[tx.Hash] = tx
+		m.sponsor(tx)
 	}
 }
 
@@ -28,7 +37,13 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 	defer m.Unlock()
 
 	for _, tx := range txs {
+		old, ok := m.all[tx.Hash]
+		if !ok {
+			continue
+		}
+
 		delete(m.all, tx.Hash)
+		m.unsponsor(old)
 	}
 }
 
@@ -44,3 +59,49 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// sponsoredCost returns the gas cost of the transactions
+// the given fee payer is committed to pay for. [thread-safe]
+func (m *lookupMap) sponsoredCost(feePayer types.Address) *big.Int {
+	m.RLock()
+	defer m.RUnlock()
+
+	cost, ok := m.sponsored[feePayer]
+	if !ok {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(cost)
+}
+
+// sponsor adds the gas cost of a fee delegated transaction to its fee payer
+func (m *lookupMap) sponsor(tx *types.Transaction) {
+	if !tx.IsFeeDelegated() {
+		return
+	}
+
+	cost, ok := m.sponsored[*tx.FeePayer]
+	if !ok {
+		cost = new(big.Int)
+		m.sponsored[*tx.FeePayer] = cost
+	}
+
+	cost.Add(cost, tx.GasCost())
+}
+
+// unsponsor subtracts the gas cost of a fee delegated transaction from its fee payer,
+// dropping the fee payers with no transactions left
+func (m *lookupMap) unsponsor(tx *types.Transaction) {
+	if !tx.IsFeeDelegated() {
+		return
+	}
+
+	cost, ok := m.sponsored[*tx.FeePayer]
+	if !ok {
+		return
+	}
+
+	if cost.Sub(cost, tx.GasCost()).Sign() <= 0 {
+		delete(m.sponsored, *tx.FeePayer)
+	}
+}
@@ -11,6 +11,12 @@ import (
 type Metrics struct {
 	// Pending transactions
 	PendingTxs metrics.Gauge
+
+	// Transactions evicted to make room when the pool is full
+	EvictedTxs metrics.Counter
+
+	// Enqueued transactions dropped after exceeding their lifetime
+	ExpiredTxs metrics.Counter
 }
 
 // GetPrometheusMetrics return the txpool metrics instance
@@ -28,6 +34,18 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 			Name:      "pending_transactions",
 			Help:      "Pending transactions in the pool",
 		}, labels).With(labelsWithValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "txpool",
+			Name:      "evicted_transactions",
+			Help:      "Transactions evicted from the full pool",
+		}, labels).With(labelsWithValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "txpool",
+			Name:      "expired_transactions",
+			Help:      "Enqueued transactions that exceeded their lifetime",
+		}, labels).With(labelsWithValues...),
 	}
 }
 
@@ -35,5 +53,7 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 func NilMetrics() *Metrics {
 	return &Metrics{
 		PendingTxs: discard.NewGauge(),
+		EvictedTxs: discard.NewCounter(),
+		ExpiredTxs: discard.NewCounter(),
 	}
 }
@@ -1,9 +1,12 @@
 package txpool
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 
+	"github.com/0xPolygon/polygon-edge/admission"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -55,3 +58,44 @@ type mockSigner struct {
 func (s *mockSigner) Sender(tx *types.Transaction) (types.Address, error) {
 	return tx.From, nil
 }
+
+type mockAdmissionPolicy struct {
+	denied types.Address
+}
+
+func (m *mockAdmissionPolicy) Admit(_ types.Hash, _ *types.Header, tx *types.Transaction) error {
+	if tx.From == m.denied {
+		return admission.ErrSenderDenied
+	}
+
+	return nil
+}
+
+func (m *mockAdmissionPolicy) Reload() (*admission.Policy, error) {
+	return admission.NewPolicy(nil, []types.Address{m.denied}, nil), nil
+}
+
+type mockSponsorWhitelist struct {
+	sponsor types.Address
+}
+
+func (m *mockSponsorWhitelist) IsWhitelisted(_ types.Hash, _ *types.Header, sponsor types.Address) (bool, error) {
+	return sponsor == m.sponsor, nil
+}
+
+type mockValidatorIdentity struct {
+	key        *ecdsa.PrivateKey
+	validators []types.Address
+}
+
+func (m *mockValidatorIdentity) Address() types.Address {
+	return crypto.PubKeyToAddress(&m.key.PublicKey)
+}
+
+func (m *mockValidatorIdentity) Validators() []types.Address {
+	return m.validators
+}
+
+func (m *mockValidatorIdentity) Sign(hash []byte) ([]byte, error) {
+	return crypto.Sign(m.key, hash)
+}
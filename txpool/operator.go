@@ -2,10 +2,13 @@ package txpool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	any "google.golang.org/protobuf/types/known/anypb"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -20,25 +23,28 @@ func (p *TxPool) Status(ctx context.Context, req *empty.Empty) (*proto.TxnPoolSt
 
 // AddTxn adds a local transaction to the pool
 func (p *TxPool) AddTxn(ctx context.Context, raw *proto.AddTxnReq) (*proto.AddTxnResp, error) {
-	if raw.Raw == nil {
-		return nil, fmt.Errorf("transaction's field raw is empty")
+	txn, err := decodeTxn(raw.Raw, raw.From)
+	if err != nil {
+		return nil, err
 	}
 
-	txn := new(types.Transaction)
-	if err := txn.UnmarshalRLP(raw.Raw.Value); err != nil {
+	if err := p.AddTx(txn); err != nil {
 		return nil, err
 	}
 
-	if raw.From != "" {
-		from := types.Address{}
-		if err := from.UnmarshalText([]byte(raw.From)); err != nil {
-			return nil, err
-		}
+	return &proto.AddTxnResp{
+		TxHash: txn.Hash.String(),
+	}, nil
+}
 
-		txn.From = from
+// AddPrivateTxn adds a local transaction to the pool, forwarded only to the validators
+func (p *TxPool) AddPrivateTxn(ctx context.Context, raw *proto.AddPrivateTxnReq) (*proto.AddTxnResp, error) {
+	txn, err := decodeTxn(raw.Raw, raw.From)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := p.AddTx(txn); err != nil {
+	if err := p.AddPrivateTx(txn, raw.MaxBlockNumber); err != nil {
 		return nil, err
 	}
 
@@ -78,3 +84,204 @@ func (p *TxPool) Subscribe(
 		}
 	}
 }
+
+// ReloadAdmissionPolicy implements the operator endpoint. It reloads the admission policy from the local file
+func (p *TxPool) ReloadAdmissionPolicy(ctx context.Context, req *empty.Empty) (*proto.AdmissionPolicy, error) {
+	if p.policy == nil {
+		return nil, errors.New("no admission policy configured")
+	}
+
+	policy, err := p.policy.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	toStrings := func(addrs []types.Address) []string {
+		res := make([]string, len(addrs))
+		for i, addr := range addrs {
+			res[i] = addr.String()
+		}
+
+		return res
+	}
+
+	return &proto.AdmissionPolicy{
+		Allowlist:       toStrings(policy.Allowlist),
+		Denylist:        toStrings(policy.Denylist),
+		DeployAllowlist: toStrings(policy.DeployAllowlist),
+	}, nil
+}
+
+// ListTxns implements the operator endpoint. It returns the promoted
+// and enqueued transactions of the requested account, or of all accounts
+func (p *TxPool) ListTxns(ctx context.Context, req *proto.ListTxnsReq) (*proto.ListTxnsResp, error) {
+	resp := &proto.ListTxnsResp{}
+
+	if req.Address != "" {
+		addr := types.Address{}
+		if err := addr.UnmarshalText([]byte(req.Address)); err != nil {
+			return nil, err
+		}
+
+		account := p.accounts.get(addr)
+		if account == nil {
+			return nil, ErrAccountNotFound
+		}
+
+		resp.Accounts = append(resp.Accounts, toProtoAccountTxns(addr, account))
+
+		return resp, nil
+	}
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+
+		txns := toProtoAccountTxns(addr, p.accounts.get(addr))
+
+		// skip the accounts without transactions
+		if len(txns.Promoted)+len(txns.Enqueued) == 0 {
+			return true
+		}
+
+		resp.Accounts = append(resp.Accounts, txns)
+
+		return true
+	})
+
+	return resp, nil
+}
+
+// GetTxn implements the operator endpoint. It returns the transaction with the given hash
+func (p *TxPool) GetTxn(ctx context.Context, req *proto.GetTxnReq) (*proto.PoolTxn, error) {
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	promoted, ok := p.accounts.get(tx.From).contains(tx)
+	if !ok {
+		// removed concurrently
+		return nil, ErrTxNotFound
+	}
+
+	return toProtoPoolTxn(tx, promoted), nil
+}
+
+// DropTxn implements the operator endpoint. It drops the transaction with the given hash
+func (p *TxPool) DropTxn(ctx context.Context, req *proto.DropTxnReq) (*proto.DropTxnResp, error) {
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := p.DropTx(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DropTxnResp{
+		TxHashes: []string{tx.Hash.String()},
+	}, nil
+}
+
+// DropAccount implements the operator endpoint. It drops all transactions of the given account
+func (p *TxPool) DropAccount(ctx context.Context, req *proto.DropAccountReq) (*proto.DropTxnResp, error) {
+	addr := types.Address{}
+	if err := addr.UnmarshalText([]byte(req.Address)); err != nil {
+		return nil, err
+	}
+
+	dropped, err := p.DropAccountTxs(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.DropTxnResp{
+		TxHashes: make([]string, len(dropped)),
+	}
+
+	for i, tx := range dropped {
+		resp.TxHashes[i] = tx.Hash.String()
+	}
+
+	return resp, nil
+}
+
+func decodeTxn(raw *any.Any, fromStr string) (*types.Transaction, error) {
+	if raw == nil {
+		return nil, fmt.Errorf("transaction's field raw is empty")
+	}
+
+	txn := new(types.Transaction)
+	if err := txn.UnmarshalRLP(raw.Value); err != nil {
+		return nil, err
+	}
+
+	if fromStr != "" {
+		from := types.Address{}
+		if err := from.UnmarshalText([]byte(fromStr)); err != nil {
+			return nil, err
+		}
+
+		txn.From = from
+	}
+
+	return txn, nil
+}
+
+func parseHash(str string) (types.Hash, error) {
+	buf, err := hex.DecodeHex(str)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	if len(buf) != types.HashLength {
+		return types.Hash{}, fmt.Errorf("invalid hash length %d", len(buf))
+	}
+
+	return types.BytesToHash(buf), nil
+}
+
+func toProtoAccountTxns(addr types.Address, account *account) *proto.AccountTxns {
+	promoted, enqueued := account.txs()
+
+	resp := &proto.AccountTxns{
+		Address:   addr.String(),
+		NextNonce: account.getNonce(),
+		Promoted:  make([]*proto.PoolTxn, len(promoted)),
+		Enqueued:  make([]*proto.PoolTxn, len(enqueued)),
+	}
+
+	for i, tx := range promoted {
+		resp.Promoted[i] = toProtoPoolTxn(tx, true)
+	}
+
+	for i, tx := range enqueued {
+		resp.Enqueued[i] = toProtoPoolTxn(tx, false)
+	}
+
+	return resp
+}
+
+func toProtoPoolTxn(tx *types.Transaction, promoted bool) *proto.PoolTxn {
+	resp := &proto.PoolTxn{
+		Hash:     tx.Hash.String(),
+		From:     tx.From.String(),
+		Nonce:    tx.Nonce,
+		GasPrice: tx.GasPrice.String(),
+		Gas:      tx.Gas,
+		Value:    tx.Value.String(),
+		Promoted: promoted,
+	}
+
+	if tx.To != nil {
+		resp.To = tx.To.String()
+	}
+
+	return resp
+}
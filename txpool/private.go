@@ -0,0 +1,415 @@
+package txpool
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	libp2pGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/grpc"
+	any "google.golang.org/protobuf/types/known/anypb"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	privateProto = "/txpool/private/0.1"
+
+	// DefaultPrivateTxLifetime is the default number of blocks
+	// a private transaction is kept in the pool
+	DefaultPrivateTxLifetime = 25
+
+	// privateTimeout is the timeout of the requests made to the validators
+	privateTimeout = 5 * time.Second
+
+	// challengeSize is the size of the challenge signed by the validators
+	challengeSize = 32
+)
+
+var (
+	errNotValidator       = errors.New("not a validator")
+	errInvalidChallenge   = errors.New("invalid challenge")
+	errUnknownPrivatePeer = errors.New("unknown peer")
+)
+
+// validatorIdentity resolves the current validators and signs with the
+// validator key of the node, so that private transactions are only
+// forwarded to the peers proving to be validators
+type validatorIdentity interface {
+	// Address returns the validator address of the node
+	Address() types.Address
+
+	// Validators returns the validators of the next block
+	Validators() []types.Address
+
+	// Sign signs the given hash with the validator key
+	Sign(hash []byte) ([]byte, error)
+}
+
+// privateLookup keeps track of the private transactions
+// and the last block they can be included in
+type privateLookup struct {
+	sync.RWMutex
+	all map[types.Hash]uint64
+}
+
+// add marks the given transaction as private. [thread-safe]
+func (m *privateLookup) add(hash types.Hash, maxBlockNumber uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.all[hash] = maxBlockNumber
+}
+
+// remove unmarks the given transaction. [thread-safe]
+func (m *privateLookup) remove(hash types.Hash) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.all, hash)
+}
+
+// has returns true if the given transaction is private. [thread-safe]
+func (m *privateLookup) has(hash types.Hash) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	_, ok := m.all[hash]
+
+	return ok
+}
+
+// expired returns the private transactions which can't
+// be included after the given block. [thread-safe]
+func (m *privateLookup) expired(number uint64) []types.Hash {
+	m.RLock()
+	defer m.RUnlock()
+
+	var expired []types.Hash
+
+	for hash, maxBlockNumber := range m.all {
+		if maxBlockNumber <= number {
+			expired = append(expired, hash)
+		}
+	}
+
+	return expired
+}
+
+// filter removes the private transactions from the given accounts,
+// without modifying the underlying queues. [thread-safe]
+func (m *privateLookup) filter(accounts map[types.Address][]*types.Transaction) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if len(m.all) == 0 {
+		return
+	}
+
+	for addr, txs := range accounts {
+		public := make([]*types.Transaction, 0, len(txs))
+
+		for _, tx := range txs {
+			if _, ok := m.all[tx.Hash]; !ok {
+				public = append(public, tx)
+			}
+		}
+
+		switch {
+		case len(public) == len(txs):
+			continue
+		case len(public) == 0:
+			delete(accounts, addr)
+		default:
+			accounts[addr] = public
+		}
+	}
+}
+
+// privatePeer is a peer which proved its validator address
+type privatePeer struct {
+	id        peer.ID
+	conn      *grpc.ClientConn
+	client    proto.TxPrivateClient
+	validator types.Address
+}
+
+// privateForwarder forwards the private transactions of the pool directly
+// to the validators among the peers, instead of announcing them
+type privateForwarder struct {
+	proto.UnimplementedTxPrivateServer
+
+	logger  hclog.Logger
+	pool    *TxPool
+	network *network.Server
+
+	// peers which proved their validator address
+	peers sync.Map
+
+	closeCh chan struct{}
+}
+
+func newPrivateForwarder(logger hclog.Logger, pool *TxPool, network *network.Server) *privateForwarder {
+	return &privateForwarder{
+		logger:  logger.Named("private"),
+		pool:    pool,
+		network: network,
+		closeCh: make(chan struct{}),
+	}
+}
+
+// start registers the private transactions protocol
+func (f *privateForwarder) start() {
+	grpcStream := libp2pGrpc.NewGrpcStream()
+	proto.RegisterTxPrivateServer(grpcStream.GrpcServer(), f)
+	grpcStream.Serve()
+	f.network.Register(privateProto, grpcStream)
+
+	go f.handlePeerEvents()
+}
+
+// close closes the streams to the peers
+func (f *privateForwarder) close() {
+	close(f.closeCh)
+
+	f.peers.Range(func(key, _ interface{}) bool {
+		f.delPeer(key.(peer.ID)) // nolint:forcetypeassert
+
+		return true
+	})
+}
+
+// handlePeerEvents forgets the identities of the disconnected peers
+func (f *privateForwarder) handlePeerEvents() {
+	sub, err := f.network.Subscribe()
+	if err != nil {
+		f.logger.Error("failed to subscribe", "err", err)
+
+		return
+	}
+
+	defer sub.Close()
+
+	for {
+		select {
+		case evnt := <-sub.GetCh():
+			if evnt.Type == network.PeerDisconnected {
+				f.delPeer(evnt.PeerID)
+			}
+		case <-f.closeCh:
+			return
+		}
+	}
+}
+
+// getPeer returns the given peer, opening a stream to it and
+// requesting its identity if it was not identified yet
+func (f *privateForwarder) getPeer(id peer.ID) *privatePeer {
+	if p, ok := f.peers.Load(id); ok {
+		return p.(*privatePeer) // nolint:forcetypeassert
+	}
+
+	stream, err := f.network.NewStream(privateProto, id)
+	if err != nil {
+		f.logger.Debug("peer doesn't support private txs", "peer", id, "err", err)
+
+		return nil
+	}
+
+	conn := libp2pGrpc.WrapClient(stream)
+
+	p := &privatePeer{
+		id:     id,
+		conn:   conn,
+		client: proto.NewTxPrivateClient(conn),
+	}
+
+	if p.validator, err = f.identify(p); err != nil {
+		// peers without a validator key are asked again on the next forward
+		f.logger.Debug("failed to identify peer", "peer", id, "err", err)
+
+		_ = conn.Close()
+
+		return nil
+	}
+
+	actual, loaded := f.peers.LoadOrStore(id, p)
+	if loaded {
+		// added concurrently
+		_ = conn.Close()
+	}
+
+	return actual.(*privatePeer) // nolint:forcetypeassert
+}
+
+// delPeer closes the stream to the given peer
+func (f *privateForwarder) delPeer(id peer.ID) {
+	p, ok := f.peers.LoadAndDelete(id)
+	if !ok {
+		return
+	}
+
+	if err := p.(*privatePeer).conn.Close(); err != nil { // nolint:forcetypeassert
+		f.logger.Debug("failed to close stream", "peer", id, "err", err)
+	}
+}
+
+// identify requests the peer to sign a random challenge with
+// its validator key, and returns the validator address recovered
+func (f *privateForwarder) identify(p *privatePeer) (types.Address, error) {
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return types.ZeroAddress, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), privateTimeout)
+	defer cancel()
+
+	resp, err := p.client.Identify(ctx, &proto.IdentifyReq{
+		Challenge: challenge,
+	})
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	pub, err := crypto.RecoverPubkey(resp.Signature, identityHash(challenge, p.id))
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return crypto.PubKeyToAddress(pub), nil
+}
+
+// forward sends the private transaction to the validators among the peers,
+// except the given one. Returns the number of validators it was sent to
+func (f *privateForwarder) forward(tx *types.Transaction, maxBlockNumber uint64, exclude peer.ID) int {
+	if f.pool.identity == nil {
+		return 0
+	}
+
+	validators := make(map[types.Address]struct{})
+	for _, validator := range f.pool.identity.Validators() {
+		validators[validator] = struct{}{}
+	}
+
+	req := &proto.PrivateTxns{
+		Txs: []*proto.PrivateTxn{
+			{
+				Raw: &any.Any{
+					Value: tx.MarshalRLP(),
+				},
+				MaxBlockNumber: maxBlockNumber,
+			},
+		},
+	}
+
+	var (
+		wg   sync.WaitGroup
+		sent int32
+	)
+
+	for _, p := range f.network.Peers() {
+		id := p.Info.ID
+		if id == exclude {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			p := f.getPeer(id)
+			if p == nil {
+				return
+			}
+
+			if _, ok := validators[p.validator]; !ok {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), privateTimeout)
+			defer cancel()
+
+			if _, err := p.client.SendPrivateTxs(ctx, req); err != nil {
+				f.logger.Debug("failed to forward private tx", "peer", id, "err", err)
+
+				return
+			}
+
+			atomic.AddInt32(&sent, 1)
+		}()
+	}
+
+	wg.Wait()
+
+	f.logger.Debug("forwarded private tx", "hash", tx.Hash.String(), "validators", sent)
+
+	return int(sent)
+}
+
+// Identify implements the identification endpoint. It signs
+// the challenge and the peer ID of the node with its validator key
+func (f *privateForwarder) Identify(ctx context.Context, req *proto.IdentifyReq) (*proto.ValidatorIdentity, error) {
+	if f.pool.identity == nil {
+		return nil, errNotValidator
+	}
+
+	if len(req.Challenge) != challengeSize {
+		return nil, errInvalidChallenge
+	}
+
+	signature, err := f.pool.identity.Sign(identityHash(req.Challenge, f.network.AddrInfo().ID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ValidatorIdentity{
+		Signature: signature,
+	}, nil
+}
+
+// SendPrivateTxs implements the forwarding endpoint. Validators add the
+// private transactions to their pool and forward them to the other validators
+func (f *privateForwarder) SendPrivateTxs(ctx context.Context, req *proto.PrivateTxns) (*empty.Empty, error) {
+	grpcCtx, ok := ctx.(*libp2pGrpc.Context)
+	if !ok {
+		return nil, errUnknownPrivatePeer
+	}
+
+	if !f.pool.isValidator() {
+		return nil, errNotValidator
+	}
+
+	for _, raw := range req.Txs {
+		if raw.Raw == nil {
+			continue
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw.Raw.Value); err != nil {
+			f.logger.Debug("failed to decode private tx", "peer", grpcCtx.PeerID, "err", err)
+			f.pool.reportPeer(grpcCtx.PeerID, err)
+
+			continue
+		}
+
+		go f.pool.addForwardedPrivateTx(tx, raw.MaxBlockNumber, grpcCtx.PeerID)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// identityHash returns the hash signed by a validator to prove its identity.
+// It includes the peer ID, so that the proof can't be relayed by other peers
+func identityHash(challenge []byte, id peer.ID) []byte {
+	return crypto.Keccak256(challenge, []byte(id))
+}
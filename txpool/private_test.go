@@ -0,0 +1,114 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivateForwarder(t *testing.T) {
+	key, _ := tests.GenerateKeyAndAddr(t)
+	signer := crypto.NewEIP155Signer(100)
+
+	validatorKey, validator := tests.GenerateKeyAndAddr(t)
+	nodeKey, _ := tests.GenerateKeyAndAddr(t)
+
+	newNetworkPool := func(t *testing.T, identity validatorIdentity) (*TxPool, *network.Server) {
+		t.Helper()
+
+		server, err := network.CreateServer(nil)
+		assert.NoError(t, err)
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			forks.At(0),
+			defaultMockStore{},
+			nil,
+			server,
+			nilMetrics,
+			&Config{
+				PriceLimit: defaultPriceLimit,
+				MaxSlots:   defaultMaxSlots,
+				Sealing:    true,
+			},
+		)
+		assert.NoError(t, err)
+
+		pool.SetSigner(signer)
+
+		if identity != nil {
+			pool.SetValidatorIdentity(identity)
+		}
+
+		pool.Start()
+
+		t.Cleanup(func() {
+			pool.Close()
+			assert.NoError(t, server.Close())
+		})
+
+		return pool, server
+	}
+
+	validators := []types.Address{validator}
+
+	// the tx is sent to a non validator node: observer <-> source <-> validator
+	source, sourceServer := newNetworkPool(t, &mockValidatorIdentity{key: nodeKey, validators: validators})
+	sealer, sealerServer := newNetworkPool(t, &mockValidatorIdentity{key: validatorKey, validators: validators})
+	observer, observerServer := newNetworkPool(t, nil)
+
+	signedTx, err := signer.SignTx(newTx(types.ZeroAddress, 0, 1), key)
+	assert.NoError(t, err)
+
+	// the tx is rejected without validators among the peers
+	assert.ErrorIs(t, source.AddPrivateTx(signedTx.Copy(), 0), ErrNoValidatorPeers)
+
+	assert.NoError(t, network.JoinAndWait(sourceServer, sealerServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+	assert.NoError(t, network.JoinAndWait(sourceServer, observerServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+
+	assert.NoError(t, source.AddPrivateTx(signedTx, 0))
+
+	assert.Eventually(t, func() bool {
+		_, ok := sealer.index.get(signedTx.Hash)
+
+		return ok
+	}, 10*time.Second, 50*time.Millisecond)
+
+	assert.True(t, sealer.privateTxs.has(signedTx.Hash))
+
+	// the validator proved its identity, while the observer could not
+	peer, ok := source.forwarder.peers.Load(sealerServer.AddrInfo().ID)
+	if assert.True(t, ok) {
+		assert.Equal(t, validator, peer.(*privatePeer).validator)
+	}
+
+	_, ok = source.forwarder.peers.Load(observerServer.AddrInfo().ID)
+	assert.False(t, ok)
+
+	// the tx is neither forwarded nor announced to the observer
+	time.Sleep(2 * announceInterval)
+
+	_, ok = observer.index.get(signedTx.Hash)
+	assert.False(t, ok)
+
+	peer, ok = source.announcer.peers.Load(observerServer.AddrInfo().ID)
+	if assert.True(t, ok) {
+		assert.False(t, peer.(*announcePeer).known.has(signedTx.Hash))
+	}
+}
+
+func TestIdentityHash(t *testing.T) {
+	challenge := make([]byte, challengeSize)
+
+	// the proof is bound to the peer ID of the validator
+	assert.NotEqual(t,
+		identityHash(challenge, "peer1"),
+		identityHash(challenge, "peer2"),
+	)
+}
@@ -23,8 +23,21 @@ type TxnPoolOperatorClient interface {
 	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TxnPoolStatusResp, error)
 	// AddTxn adds a local transaction to the pool
 	AddTxn(ctx context.Context, in *AddTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
+	// AddPrivateTxn adds a local transaction to the pool, forwarding it
+	// only to the validators instead of gossiping it
+	AddPrivateTxn(ctx context.Context, in *AddPrivateTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error)
+	// ReloadAdmissionPolicy reloads the admission policy from the local file
+	ReloadAdmissionPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdmissionPolicy, error)
+	// ListTxns returns the promoted and enqueued transactions of the accounts in the pool
+	ListTxns(ctx context.Context, in *ListTxnsReq, opts ...grpc.CallOption) (*ListTxnsResp, error)
+	// GetTxn returns the transaction in the pool with the given hash
+	GetTxn(ctx context.Context, in *GetTxnReq, opts ...grpc.CallOption) (*PoolTxn, error)
+	// DropTxn drops the transaction with the given hash from the pool
+	DropTxn(ctx context.Context, in *DropTxnReq, opts ...grpc.CallOption) (*DropTxnResp, error)
+	// DropAccount drops all the transactions of the given account from the pool
+	DropAccount(ctx context.Context, in *DropAccountReq, opts ...grpc.CallOption) (*DropTxnResp, error)
 }
 
 type txnPoolOperatorClient struct {
@@ -53,6 +66,15 @@ func (c *txnPoolOperatorClient) AddTxn(ctx context.Context, in *AddTxnReq, opts
 	return out, nil
 }
 
+func (c *txnPoolOperatorClient) AddPrivateTxn(ctx context.Context, in *AddPrivateTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error) {
+	out := new(AddTxnResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/AddPrivateTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *txnPoolOperatorClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &TxnPoolOperator_ServiceDesc.Streams[0], "/v1.TxnPoolOperator/Subscribe", opts...)
 	if err != nil {
@@ -85,6 +107,51 @@ func (x *txnPoolOperatorSubscribeClient) Recv() (*TxPoolEvent, error) {
 	return m, nil
 }
 
+func (c *txnPoolOperatorClient) ReloadAdmissionPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdmissionPolicy, error) {
+	out := new(AdmissionPolicy)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/ReloadAdmissionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) ListTxns(ctx context.Context, in *ListTxnsReq, opts ...grpc.CallOption) (*ListTxnsResp, error) {
+	out := new(ListTxnsResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/ListTxns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) GetTxn(ctx context.Context, in *GetTxnReq, opts ...grpc.CallOption) (*PoolTxn, error) {
+	out := new(PoolTxn)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/GetTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) DropTxn(ctx context.Context, in *DropTxnReq, opts ...grpc.CallOption) (*DropTxnResp, error) {
+	out := new(DropTxnResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DropTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) DropAccount(ctx context.Context, in *DropAccountReq, opts ...grpc.CallOption) (*DropTxnResp, error) {
+	out := new(DropTxnResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DropAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxnPoolOperatorServer is the server API for TxnPoolOperator service.
 // All implementations must embed UnimplementedTxnPoolOperatorServer
 // for forward compatibility
@@ -93,8 +160,21 @@ type TxnPoolOperatorServer interface {
 	Status(context.Context, *emptypb.Empty) (*TxnPoolStatusResp, error)
 	// AddTxn adds a local transaction to the pool
 	AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error)
+	// AddPrivateTxn adds a local transaction to the pool, forwarding it
+	// only to the validators instead of gossiping it
+	AddPrivateTxn(context.Context, *AddPrivateTxnReq) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error
+	// ReloadAdmissionPolicy reloads the admission policy from the local file
+	ReloadAdmissionPolicy(context.Context, *emptypb.Empty) (*AdmissionPolicy, error)
+	// ListTxns returns the promoted and enqueued transactions of the accounts in the pool
+	ListTxns(context.Context, *ListTxnsReq) (*ListTxnsResp, error)
+	// GetTxn returns the transaction in the pool with the given hash
+	GetTxn(context.Context, *GetTxnReq) (*PoolTxn, error)
+	// DropTxn drops the transaction with the given hash from the pool
+	DropTxn(context.Context, *DropTxnReq) (*DropTxnResp, error)
+	// DropAccount drops all the transactions of the given account from the pool
+	DropAccount(context.Context, *DropAccountReq) (*DropTxnResp, error)
 	mustEmbedUnimplementedTxnPoolOperatorServer()
 }
 
@@ -108,9 +188,27 @@ func (UnimplementedTxnPoolOperatorServer) Status(context.Context, *emptypb.Empty
 func (UnimplementedTxnPoolOperatorServer) AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTxn not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) AddPrivateTxn(context.Context, *AddPrivateTxnReq) (*AddTxnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPrivateTxn not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) ReloadAdmissionPolicy(context.Context, *emptypb.Empty) (*AdmissionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadAdmissionPolicy not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) ListTxns(context.Context, *ListTxnsReq) (*ListTxnsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTxns not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) GetTxn(context.Context, *GetTxnReq) (*PoolTxn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxn not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) DropTxn(context.Context, *DropTxnReq) (*DropTxnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropTxn not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) DropAccount(context.Context, *DropAccountReq) (*DropTxnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAccount not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) mustEmbedUnimplementedTxnPoolOperatorServer() {}
 
 // UnsafeTxnPoolOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +258,24 @@ func _TxnPoolOperator_AddTxn_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TxnPoolOperator_AddPrivateTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPrivateTxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).AddPrivateTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/AddPrivateTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).AddPrivateTxn(ctx, req.(*AddPrivateTxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TxnPoolOperator_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -181,6 +297,96 @@ func (x *txnPoolOperatorSubscribeServer) Send(m *TxPoolEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TxnPoolOperator_ReloadAdmissionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).ReloadAdmissionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/ReloadAdmissionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).ReloadAdmissionPolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_ListTxns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTxnsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).ListTxns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/ListTxns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).ListTxns(ctx, req.(*ListTxnsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_GetTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).GetTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/GetTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).GetTxn(ctx, req.(*GetTxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_DropTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropTxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DropTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DropTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DropTxn(ctx, req.(*DropTxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_DropAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DropAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DropAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DropAccount(ctx, req.(*DropAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TxnPoolOperator_ServiceDesc is the grpc.ServiceDesc for TxnPoolOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -196,6 +402,30 @@ var TxnPoolOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTxn",
 			Handler:    _TxnPoolOperator_AddTxn_Handler,
 		},
+		{
+			MethodName: "AddPrivateTxn",
+			Handler:    _TxnPoolOperator_AddPrivateTxn_Handler,
+		},
+		{
+			MethodName: "ReloadAdmissionPolicy",
+			Handler:    _TxnPoolOperator_ReloadAdmissionPolicy_Handler,
+		},
+		{
+			MethodName: "ListTxns",
+			Handler:    _TxnPoolOperator_ListTxns_Handler,
+		},
+		{
+			MethodName: "GetTxn",
+			Handler:    _TxnPoolOperator_GetTxn_Handler,
+		},
+		{
+			MethodName: "DropTxn",
+			Handler:    _TxnPoolOperator_DropTxn_Handler,
+		},
+		{
+			MethodName: "DropAccount",
+			Handler:    _TxnPoolOperator_DropAccount_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,268 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TxAnnounceClient is the client API for TxAnnounce service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxAnnounceClient interface {
+	// AnnounceTxs notifies the peer of new transactions by their hashes
+	AnnounceTxs(ctx context.Context, in *TxnHashes, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetTxs returns the requested transactions known by the peer
+	GetTxs(ctx context.Context, in *TxnHashes, opts ...grpc.CallOption) (*Txns, error)
+}
+
+type txAnnounceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxAnnounceClient(cc grpc.ClientConnInterface) TxAnnounceClient {
+	return &txAnnounceClient{cc}
+}
+
+func (c *txAnnounceClient) AnnounceTxs(ctx context.Context, in *TxnHashes, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.TxAnnounce/AnnounceTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txAnnounceClient) GetTxs(ctx context.Context, in *TxnHashes, opts ...grpc.CallOption) (*Txns, error) {
+	out := new(Txns)
+	err := c.cc.Invoke(ctx, "/v1.TxAnnounce/GetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxAnnounceServer is the server API for TxAnnounce service.
+// All implementations must embed UnimplementedTxAnnounceServer
+// for forward compatibility
+type TxAnnounceServer interface {
+	// AnnounceTxs notifies the peer of new transactions by their hashes
+	AnnounceTxs(context.Context, *TxnHashes) (*emptypb.Empty, error)
+	// GetTxs returns the requested transactions known by the peer
+	GetTxs(context.Context, *TxnHashes) (*Txns, error)
+	mustEmbedUnimplementedTxAnnounceServer()
+}
+
+// UnimplementedTxAnnounceServer must be embedded to have forward compatible implementations.
+type UnimplementedTxAnnounceServer struct {
+}
+
+func (UnimplementedTxAnnounceServer) AnnounceTxs(context.Context, *TxnHashes) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceTxs not implemented")
+}
+func (UnimplementedTxAnnounceServer) GetTxs(context.Context, *TxnHashes) (*Txns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxs not implemented")
+}
+func (UnimplementedTxAnnounceServer) mustEmbedUnimplementedTxAnnounceServer() {}
+
+// UnsafeTxAnnounceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxAnnounceServer will
+// result in compilation errors.
+type UnsafeTxAnnounceServer interface {
+	mustEmbedUnimplementedTxAnnounceServer()
+}
+
+func RegisterTxAnnounceServer(s grpc.ServiceRegistrar, srv TxAnnounceServer) {
+	s.RegisterService(&TxAnnounce_ServiceDesc, srv)
+}
+
+func _TxAnnounce_AnnounceTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxAnnounceServer).AnnounceTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxAnnounce/AnnounceTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxAnnounceServer).AnnounceTxs(ctx, req.(*TxnHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxAnnounce_GetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxAnnounceServer).GetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxAnnounce/GetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxAnnounceServer).GetTxs(ctx, req.(*TxnHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TxAnnounce_ServiceDesc is the grpc.ServiceDesc for TxAnnounce service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TxAnnounce_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.TxAnnounce",
+	HandlerType: (*TxAnnounceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnnounceTxs",
+			Handler:    _TxAnnounce_AnnounceTxs_Handler,
+		},
+		{
+			MethodName: "GetTxs",
+			Handler:    _TxAnnounce_GetTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "txpool/proto/v1.proto",
+}
+
+// TxPrivateClient is the client API for TxPrivate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxPrivateClient interface {
+	// Identify proves the peer is a validator by signing the given challenge
+	Identify(ctx context.Context, in *IdentifyReq, opts ...grpc.CallOption) (*ValidatorIdentity, error)
+	// SendPrivateTxs forwards private transactions to the validator
+	SendPrivateTxs(ctx context.Context, in *PrivateTxns, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type txPrivateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxPrivateClient(cc grpc.ClientConnInterface) TxPrivateClient {
+	return &txPrivateClient{cc}
+}
+
+func (c *txPrivateClient) Identify(ctx context.Context, in *IdentifyReq, opts ...grpc.CallOption) (*ValidatorIdentity, error) {
+	out := new(ValidatorIdentity)
+	err := c.cc.Invoke(ctx, "/v1.TxPrivate/Identify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txPrivateClient) SendPrivateTxs(ctx context.Context, in *PrivateTxns, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.TxPrivate/SendPrivateTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxPrivateServer is the server API for TxPrivate service.
+// All implementations must embed UnimplementedTxPrivateServer
+// for forward compatibility
+type TxPrivateServer interface {
+	// Identify proves the peer is a validator by signing the given challenge
+	Identify(context.Context, *IdentifyReq) (*ValidatorIdentity, error)
+	// SendPrivateTxs forwards private transactions to the validator
+	SendPrivateTxs(context.Context, *PrivateTxns) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTxPrivateServer()
+}
+
+// UnimplementedTxPrivateServer must be embedded to have forward compatible implementations.
+type UnimplementedTxPrivateServer struct {
+}
+
+func (UnimplementedTxPrivateServer) Identify(context.Context, *IdentifyReq) (*ValidatorIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+}
+func (UnimplementedTxPrivateServer) SendPrivateTxs(context.Context, *PrivateTxns) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPrivateTxs not implemented")
+}
+func (UnimplementedTxPrivateServer) mustEmbedUnimplementedTxPrivateServer() {}
+
+// UnsafeTxPrivateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxPrivateServer will
+// result in compilation errors.
+type UnsafeTxPrivateServer interface {
+	mustEmbedUnimplementedTxPrivateServer()
+}
+
+func RegisterTxPrivateServer(s grpc.ServiceRegistrar, srv TxPrivateServer) {
+	s.RegisterService(&TxPrivate_ServiceDesc, srv)
+}
+
+func _TxPrivate_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxPrivateServer).Identify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxPrivate/Identify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxPrivateServer).Identify(ctx, req.(*IdentifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxPrivate_SendPrivateTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivateTxns)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxPrivateServer).SendPrivateTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxPrivate/SendPrivateTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxPrivateServer).SendPrivateTxs(ctx, req.(*PrivateTxns))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TxPrivate_ServiceDesc is the grpc.ServiceDesc for TxPrivate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TxPrivate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.TxPrivate",
+	HandlerType: (*TxPrivateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Identify",
+			Handler:    _TxPrivate_Identify_Handler,
+		},
+		{
+			MethodName: "SendPrivateTxs",
+			Handler:    _TxPrivate_SendPrivateTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "txpool/proto/v1.proto",
+}
@@ -28,8 +28,14 @@ func (p *TxPool) GetCapacity() (uint64, uint64) {
 	return p.gauge.read(), p.gauge.max
 }
 
-// GetPendingTx returns the transaction by hash in the TxPool (pending txn) [Thread-safe]
+// GetPendingTx returns the transaction by hash in the TxPool (pending txn),
+// except the private ones [Thread-safe]
 func (p *TxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	// private txs are only forwarded to the validators
+	if p.privateTxs.has(txHash) {
+		return nil, false
+	}
+
 	tx, ok := p.index.get(txHash)
 	if !ok {
 		return nil, false
@@ -38,11 +44,14 @@ func (p *TxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
 	return tx, true
 }
 
-// GetTxs gets pending and queued transactions
+// GetTxs gets pending and queued transactions, except the private ones
 func (p *TxPool) GetTxs(inclQueued bool) (
 	allPromoted, allEnqueued map[types.Address][]*types.Transaction,
 ) {
 	allPromoted, allEnqueued = p.accounts.allTxs(inclQueued)
 
+	p.privateTxs.filter(allPromoted)
+	p.privateTxs.filter(allEnqueued)
+
 	return
 }
@@ -67,6 +67,80 @@ func (q *accountQueue) push(tx *types.Transaction) {
 	heap.Push(&q.queue, tx)
 }
 
+// getByNonce returns the transaction with the given nonce, or nil if there is none.
+func (q *accountQueue) getByNonce(nonce uint64) *types.Transaction {
+	for _, tx := range q.queue {
+		if tx.Nonce == nonce {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+// replace swaps the transaction with the same nonce as the given one
+// and returns the replaced transaction, or nil if there is none.
+// The nonce ordering is not affected by the swap.
+func (q *accountQueue) replace(tx *types.Transaction) *types.Transaction {
+	for i, old := range q.queue {
+		if old.Nonce == tx.Nonce {
+			q.queue[i] = tx
+
+			return old
+		}
+	}
+
+	return nil
+}
+
+// tail returns the transaction with the highest nonce, or nil if the queue is empty.
+func (q *accountQueue) tail() *types.Transaction {
+	var tail *types.Transaction
+
+	for _, tx := range q.queue {
+		if tail == nil || tx.Nonce > tail.Nonce {
+			tail = tx
+		}
+	}
+
+	return tail
+}
+
+// remove removes the given transaction from the queue.
+// Returns false if the transaction is not present.
+func (q *accountQueue) remove(tx *types.Transaction) bool {
+	for i, queued := range q.queue {
+		if queued == tx {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// clear removes all transactions from the queue and returns them.
+func (q *accountQueue) clear() (removed []*types.Transaction) {
+	for q.length() != 0 {
+		removed = append(removed, q.pop())
+	}
+
+	return
+}
+
+// popIfHead removes the first transaction from the queue if it is the given one.
+// Returns false if the queue is empty or starts with a different transaction.
+func (q *accountQueue) popIfHead(tx *types.Transaction) bool {
+	if head := q.peek(); head == nil || head.Hash != tx.Hash {
+		return false
+	}
+
+	q.pop()
+
+	return true
+}
+
 // peek returns the first transaction from the queue without removing it.
 func (q *accountQueue) peek() *types.Transaction {
 	if q.length() == 0 {
@@ -128,7 +202,9 @@ func (q *minNonceQueue) Pop() interface{} {
 	return x
 }
 
+// A thread-safe queue of transactions sorted by gas price.
 type pricedQueue struct {
+	sync.Mutex
 	queue maxPriceQueue
 }
 
@@ -155,21 +231,47 @@ func (q *pricedQueue) clear() {
 
 // Pushes the given transactions onto the queue.
 func (q *pricedQueue) push(tx *types.Transaction) {
+	q.Lock()
+	defer q.Unlock()
+
 	heap.Push(&q.queue, tx)
 }
 
 // Pop removes the first transaction from the queue
 // or nil if the queue is empty.
 func (q *pricedQueue) pop() *types.Transaction {
-	if q.length() == 0 {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.queue.Len() == 0 {
 		return nil
 	}
 
 	return heap.Pop(&q.queue).(*types.Transaction)
 }
 
+// remove removes the given transaction from the queue.
+// Returns false if the transaction is not present.
+func (q *pricedQueue) remove(tx *types.Transaction) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	for i, queued := range q.queue {
+		if queued.Hash == tx.Hash {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // length returns the number of transactions in the queue.
 func (q *pricedQueue) length() uint64 {
+	q.Lock()
+	defer q.Unlock()
+
 	return uint64(q.queue.Len())
 }
 
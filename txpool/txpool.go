@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
 
+	"github.com/0xPolygon/polygon-edge/admission"
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
@@ -21,30 +24,66 @@ const (
 	txSlotSize  = 32 * 1024  // 32kB
 	txMaxSize   = 128 * 1024 //128Kb
 	topicNameV1 = "txpool/0.1"
+
+	// DefaultPriceBump is the default minimum gas price increase (in percent)
+	// required to replace a transaction with the same nonce
+	DefaultPriceBump = 10
+
+	// DefaultMaxAccountEnqueued is the default maximum number
+	// of enqueued transactions per account
+	DefaultMaxAccountEnqueued = 128
+
+	// DefaultMaxAccountPromoted is the default maximum number
+	// of promoted transactions per account
+	DefaultMaxAccountPromoted = 1024
+
+	// DefaultEnqueuedLifetime is the default time an enqueued transaction
+	// with a nonce gap is kept in its account without any activity
+	DefaultEnqueuedLifetime = 3 * time.Hour
+
+	// expiryInterval is the interval of pruning expired enqueued transactions
+	expiryInterval = time.Minute
+
+	// DefaultJournalRotate is the default interval of
+	// rotating the journal of local transactions
+	DefaultJournalRotate = time.Hour
 )
 
 // errors
 var (
-	ErrIntrinsicGas        = errors.New("intrinsic gas too low")
-	ErrNegativeValue       = errors.New("negative value")
-	ErrNonEncryptedTx      = errors.New("non-encrypted transaction")
-	ErrInvalidSender       = errors.New("invalid sender")
-	ErrTxPoolOverflow      = errors.New("txpool is full")
-	ErrUnderpriced         = errors.New("transaction underpriced")
-	ErrNonceTooLow         = errors.New("nonce too low")
-	ErrInsufficientFunds   = errors.New("insufficient funds for gas * price + value")
-	ErrInvalidAccountState = errors.New("invalid account state")
-	ErrAlreadyKnown        = errors.New("already known")
-	ErrOversizedData       = errors.New("oversized data")
+	ErrIntrinsicGas           = errors.New("intrinsic gas too low")
+	ErrNegativeValue          = errors.New("negative value")
+	ErrNonEncryptedTx         = errors.New("non-encrypted transaction")
+	ErrInvalidSender          = errors.New("invalid sender")
+	ErrTxPoolOverflow         = errors.New("txpool is full")
+	ErrUnderpriced            = errors.New("transaction underpriced")
+	ErrNonceTooLow            = errors.New("nonce too low")
+	ErrInsufficientFunds      = errors.New("insufficient funds for gas * price + value")
+	ErrInvalidAccountState    = errors.New("invalid account state")
+	ErrAlreadyKnown           = errors.New("already known")
+	ErrOversizedData          = errors.New("oversized data")
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+	ErrMaxEnqueuedLimit       = errors.New("maximum number of enqueued transactions reached")
+	ErrMaxPromotedLimit       = errors.New("maximum number of promoted transactions reached")
+	ErrFeeDelegationDisabled  = errors.New("fee delegation is not enabled")
+	ErrInvalidFeePayer        = errors.New("invalid fee payer")
+	ErrSponsorNotWhitelisted  = errors.New("fee payer is not a whitelisted sponsor")
+	ErrInsufficientFeePayer   = errors.New("insufficient fee payer funds for gas * price")
+	ErrTxNotFound             = errors.New("transaction not found in the pool")
+	ErrAccountNotFound        = errors.New("account not found in the pool")
+	ErrPrivateTxsDisabled     = errors.New("private transactions are not enabled")
+	ErrPrivateTxExpired       = errors.New("private transaction max block number already reached")
+	ErrNoValidatorPeers       = errors.New("no validator reached to forward the private transaction")
 )
 
 // indicates origin of a transaction
 type txOrigin int
 
 const (
-	local  txOrigin = iota // json-RPC/gRPC endpoints
-	gossip                 // gossip protocol
-	reorg                  // legacy code
+	local   txOrigin = iota // json-RPC/gRPC endpoints
+	gossip                  // gossip protocol
+	reorg                   // legacy code
+	private                 // private json-RPC/gRPC endpoints and validators
 )
 
 func (o txOrigin) String() (s string) {
@@ -55,6 +94,8 @@ func (o txOrigin) String() (s string) {
 		s = "gossip"
 	case reorg:
 		s = "reorg"
+	case private:
+		s = "private"
 	}
 
 	return
@@ -72,10 +113,47 @@ type signer interface {
 	Sender(tx *types.Transaction) (types.Address, error)
 }
 
+// feePayerSigner recovers the fee payer of fee delegated transactions
+type feePayerSigner interface {
+	FeePayer(tx *types.Transaction) (types.Address, error)
+}
+
+// sponsorWhitelist decides which accounts can pay for the gas of fee delegated transactions
+type sponsorWhitelist interface {
+	IsWhitelisted(parentRoot types.Hash, header *types.Header, sponsor types.Address) (bool, error)
+}
+
+// peerReporter lowers the score of the peers sending malformed transactions
+type peerReporter interface {
+	ReportPeer(id peer.ID, penalty network.Penalty, reason string)
+}
+
+// admissionPolicy decides which transactions are admitted to the chain
+type admissionPolicy interface {
+	Admit(parentRoot types.Hash, header *types.Header, tx *types.Transaction) error
+	Reload() (*admission.Policy, error)
+}
+
 type Config struct {
-	PriceLimit uint64
-	MaxSlots   uint64
-	Sealing    bool
+	PriceLimit         uint64
+	PriceBump          uint64
+	MaxSlots           uint64
+	MaxAccountEnqueued uint64
+	MaxAccountPromoted uint64
+	EnqueuedLifetime   time.Duration
+	Sealing            bool
+
+	// Journal is the path of the local transactions journal (disabled if empty)
+	Journal       string
+	JournalRotate time.Duration
+
+	// LegacyGossip enables broadcasting full transactions on the pubsub topic,
+	// for compatibility with the nodes not supporting hash announcements
+	LegacyGossip bool
+
+	// PrivateTxLifetime is the number of blocks
+	// private transactions are kept in the pool
+	PrivateTxLifetime uint64
 }
 
 /* All requests are passed to the main loop
@@ -129,6 +207,14 @@ type TxPool struct {
 	forks  chain.ForksInTime
 	store  store
 
+	// admission policy of permissioned chains (optional)
+	policy admissionPolicy
+
+	// sponsors allowed to pay for fee delegated transactions (optional),
+	// from the block of the fee delegation fork
+	sponsors          sponsorWhitelist
+	feeDelegationFork chain.Fork
+
 	// map of all accounts registered by the pool
 	accounts accountsMap
 
@@ -140,7 +226,20 @@ type TxPool struct {
 	index lookupMap
 
 	// networking stack
-	topic *network.Topic
+	reporter  peerReporter
+	topic     *network.Topic
+	announcer *announcer
+	forwarder *privateForwarder
+
+	// private transactions, which are only forwarded to the validators
+	privateTxs        privateLookup
+	privateTxLifetime uint64
+
+	// identity of the node among the validators (optional)
+	identity validatorIdentity
+
+	// bundles included atomically by the block builder of the node
+	bundles bundleStore
 
 	// gauge for measuring pool capacity
 	gauge slotGauge
@@ -148,6 +247,23 @@ type TxPool struct {
 	// priceLimit is a lower threshold for gas price
 	priceLimit uint64
 
+	// priceBump is the minimum gas price increase (in percent)
+	// required to replace a transaction with the same nonce
+	priceBump uint64
+
+	// maximum number of enqueued and promoted
+	// transactions per account (0 means no limit)
+	maxAccountEnqueued uint64
+	maxAccountPromoted uint64
+
+	// enqueuedLifetime is the time an enqueued transaction with
+	// a nonce gap is kept without any activity (0 means forever)
+	enqueuedLifetime time.Duration
+
+	// journal of local transactions (nil if disabled)
+	journal       *journal
+	journalRotate time.Duration
+
 	// channels on which the pool's event loop
 	// does dispatching/handling requests.
 	enqueueReqCh chan enqueueRequest
@@ -190,23 +306,57 @@ func NewTxPool(
 		metrics:     metrics,
 		accounts:    accountsMap{},
 		executables: newPricedQueue(),
-		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
-		gauge:       slotGauge{height: 0, max: config.MaxSlots},
-		priceLimit:  config.PriceLimit,
-		sealing:     config.Sealing,
+		index: lookupMap{
+			all:       make(map[types.Hash]*types.Transaction),
+			sponsored: make(map[types.Address]*big.Int),
+		},
+		gauge:      slotGauge{height: 0, max: config.MaxSlots},
+		priceLimit: config.PriceLimit,
+		priceBump:  config.PriceBump,
+		sealing:    config.Sealing,
+
+		maxAccountEnqueued: config.MaxAccountEnqueued,
+		maxAccountPromoted: config.MaxAccountPromoted,
+		enqueuedLifetime:   config.EnqueuedLifetime,
+
+		privateTxs:        privateLookup{all: make(map[types.Hash]uint64)},
+		privateTxLifetime: config.PrivateTxLifetime,
+	}
+
+	if pool.privateTxLifetime == 0 {
+		pool.privateTxLifetime = DefaultPrivateTxLifetime
+	}
+
+	if config.Journal != "" {
+		pool.journal = newJournal(config.Journal)
+		pool.journalRotate = config.JournalRotate
+
+		if pool.journalRotate == 0 {
+			pool.journalRotate = DefaultJournalRotate
+		}
 	}
 
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
 	if network != nil {
+		pool.reporter = network
+
+		// txs are propagated by announcing their hashes
+		pool.announcer = newAnnouncer(pool.logger, pool, network)
+
+		// private txs are forwarded to the validators
+		pool.forwarder = newPrivateForwarder(pool.logger, pool, network)
+	}
+
+	if network != nil && config.LegacyGossip {
 		// subscribe to the gossip protocol
 		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
 		if err != nil {
 			return nil, err
 		}
 
-		if subscribeErr := topic.Subscribe(pool.addGossipTx); subscribeErr != nil {
+		if subscribeErr := topic.SubscribeWithSender(pool.addGossipTx); subscribeErr != nil {
 			return nil, fmt.Errorf("unable to subscribe to gossip topic, %w", subscribeErr)
 		}
 
@@ -233,6 +383,26 @@ func (p *TxPool) Start() {
 	p.metrics.PendingTxs.Set(0)
 
 	go func() {
+		// expired enqueued txs are pruned periodically (if enabled)
+		var expiryCh <-chan time.Time
+
+		if p.enqueuedLifetime > 0 {
+			ticker := time.NewTicker(expiryInterval)
+			defer ticker.Stop()
+
+			expiryCh = ticker.C
+		}
+
+		// the journal is rotated periodically (if enabled)
+		var rotateCh <-chan time.Time
+
+		if p.journal != nil {
+			ticker := time.NewTicker(p.journalRotate)
+			defer ticker.Stop()
+
+			rotateCh = ticker.C
+		}
+
 		for {
 			select {
 			case <-p.shutdownCh:
@@ -241,15 +411,94 @@ func (p *TxPool) Start() {
 				go p.handleEnqueueRequest(req)
 			case req := <-p.promoteReqCh:
 				go p.handlePromoteRequest(req)
+			case <-expiryCh:
+				go p.pruneExpired()
+			case <-rotateCh:
+				go func() {
+					p.rotateJournal(p.localTxs())
+				}()
 			}
 		}
 	}()
+
+	if p.announcer != nil {
+		p.announcer.start()
+	}
+
+	if p.forwarder != nil {
+		p.forwarder.start()
+	}
+
+	if p.journal != nil {
+		// replay the local txs of the previous run
+		loaded := p.loadJournal()
+		p.rotateJournal(loaded)
+
+		for _, tx := range loaded {
+			p.broadcast(tx)
+		}
+	}
 }
 
 // Close shuts down the pool's main loop.
 func (p *TxPool) Close() {
 	p.eventManager.Close()
 	p.shutdownCh <- struct{}{}
+
+	if p.announcer != nil {
+		p.announcer.close()
+	}
+
+	if p.forwarder != nil {
+		p.forwarder.close()
+	}
+
+	if p.journal != nil {
+		if err := p.journal.close(); err != nil {
+			p.logger.Error("failed to close journal", "err", err)
+		}
+	}
+}
+
+// loadJournal adds the local transactions
+// stored in the journal back to the pool.
+func (p *TxPool) loadJournal() []*types.Transaction {
+	loaded, err := p.journal.load(func(tx *types.Transaction) error {
+		return p.addTx(local, tx)
+	})
+	if err != nil {
+		p.logger.Error("failed to load journal", "err", err)
+	}
+
+	p.logger.Info("loaded local transactions from journal", "count", len(loaded))
+
+	return loaded
+}
+
+// rotateJournal regenerates the journal with the
+// given local transactions currently in the pool.
+func (p *TxPool) rotateJournal(txs []*types.Transaction) {
+	if err := p.journal.rotate(txs); err != nil {
+		p.logger.Error("failed to rotate journal", "err", err)
+
+		return
+	}
+
+	p.logger.Debug("rotated journal", "count", len(txs))
+}
+
+// localTxs returns the transactions of the local accounts,
+// except the private ones which must not be broadcasted on replay.
+func (p *TxPool) localTxs() []*types.Transaction {
+	var public []*types.Transaction
+
+	for _, tx := range p.accounts.localTxs() {
+		if !p.privateTxs.has(tx.Hash) {
+			public = append(public, tx)
+		}
+	}
+
+	return public
 }
 
 // SetSigner sets the signer the pool will use
@@ -258,6 +507,25 @@ func (p *TxPool) SetSigner(s signer) {
 	p.signer = s
 }
 
+// SetAdmissionPolicy sets the policy the pool will use
+// to reject transactions not allowed on the chain.
+func (p *TxPool) SetAdmissionPolicy(policy admissionPolicy) {
+	p.policy = policy
+}
+
+// SetSponsorWhitelist sets the whitelist the pool will use to accept
+// fee delegated transactions, once the given fork is active.
+func (p *TxPool) SetSponsorWhitelist(sponsors sponsorWhitelist, fork chain.Fork) {
+	p.sponsors = sponsors
+	p.feeDelegationFork = fork
+}
+
+// SetValidatorIdentity sets the identity the pool will use to
+// forward private transactions to the validators only.
+func (p *TxPool) SetValidatorIdentity(identity validatorIdentity) {
+	p.identity = identity
+}
+
 // EnableDev enables the pool to accept
 // non-encrypted transactions. (used for testing)
 func (p *TxPool) EnableDev() {
@@ -273,9 +541,140 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		return err
 	}
 
-	// broadcast the transaction only if network is enabled
-	// and we are not in dev mode
-	if p.topic != nil && !p.dev {
+	p.broadcast(tx)
+
+	return nil
+}
+
+// AddPrivateTx adds a new transaction to the pool (sent from json-RPC/gRPC endpoints)
+// and forwards it only to the validators, keeping it out of the public gossip.
+// The transaction is dropped if it is not included until the given block,
+// capped by the private transaction lifetime (which also applies if zero).
+func (p *TxPool) AddPrivateTx(tx *types.Transaction, maxBlockNumber uint64) error {
+	if p.forwarder == nil && !p.dev {
+		return ErrPrivateTxsDisabled
+	}
+
+	maxBlockNumber, err := p.privateMaxBlockNumber(maxBlockNumber)
+	if err != nil {
+		return err
+	}
+
+	// validate a copy, as the tx is validated again when added
+	if err := p.validateTx(tx.Copy()); err != nil {
+		return err
+	}
+
+	tx.ComputeHash()
+
+	if _, ok := p.index.get(tx.Hash); ok {
+		return ErrAlreadyKnown
+	}
+
+	// the tx is only added if some validator (possibly the node itself) got it
+	if !p.dev {
+		if sent := p.forwarder.forward(tx, maxBlockNumber, ""); sent == 0 && !p.isValidator() {
+			return ErrNoValidatorPeers
+		}
+	}
+
+	if err := p.addPrivateTx(tx, maxBlockNumber); err != nil {
+		p.logger.Error("failed to add private tx", "err", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// addForwardedPrivateTx handles the private transactions forwarded
+// by the peers, passing them on to the other validators.
+func (p *TxPool) addForwardedPrivateTx(tx *types.Transaction, maxBlockNumber uint64, from peer.ID) {
+	// the lifetime of the node applies as well
+	maxBlockNumber, err := p.privateMaxBlockNumber(maxBlockNumber)
+	if err != nil {
+		return
+	}
+
+	tx.ComputeHash()
+
+	if _, ok := p.index.get(tx.Hash); ok {
+		// silently drop known tx
+		return
+	}
+
+	if err := p.addPrivateTx(tx, maxBlockNumber); err != nil {
+		p.logger.Debug("failed to add forwarded private tx", "err", err)
+
+		return
+	}
+
+	p.forwarder.forward(tx, maxBlockNumber, from)
+}
+
+// addPrivateTx adds the transaction to the pool as private.
+func (p *TxPool) addPrivateTx(tx *types.Transaction, maxBlockNumber uint64) error {
+	// the tx is marked before being indexed, so that it is never announced
+	p.privateTxs.add(tx.Hash, maxBlockNumber)
+
+	if err := p.addTx(private, tx); err != nil {
+		// a known tx may be private already
+		if !errors.Is(err, ErrAlreadyKnown) {
+			p.privateTxs.remove(tx.Hash)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// privateMaxBlockNumber returns the last block a private transaction
+// can be included in, capped by the private transaction lifetime.
+func (p *TxPool) privateMaxBlockNumber(maxBlockNumber uint64) (uint64, error) {
+	head := p.store.Header().Number
+	limit := head + p.privateTxLifetime
+
+	if maxBlockNumber == 0 || maxBlockNumber > limit {
+		maxBlockNumber = limit
+	}
+
+	if maxBlockNumber <= head {
+		return 0, ErrPrivateTxExpired
+	}
+
+	return maxBlockNumber, nil
+}
+
+// isValidator returns true if the node is a validator of the next block.
+func (p *TxPool) isValidator() bool {
+	if p.identity == nil {
+		return false
+	}
+
+	addr := p.identity.Address()
+
+	for _, validator := range p.identity.Validators() {
+		if validator == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// broadcast propagates the transaction to the network.
+// Only if network is enabled and we are not in dev mode
+func (p *TxPool) broadcast(tx *types.Transaction) {
+	if p.dev {
+		return
+	}
+
+	if p.announcer != nil {
+		p.announcer.announce(tx.Hash)
+	}
+
+	if p.topic != nil {
 		tx := &proto.Txn{
 			Raw: &any.Any{
 				Value: tx.MarshalRLP(),
@@ -286,8 +685,6 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 			p.logger.Error("failed to topic tx", "err", err)
 		}
 	}
-
-	return nil
 }
 
 // Prepare generates all the transactions
@@ -309,14 +706,41 @@ func (p *TxPool) Prepare() {
 
 // Peek returns the best-price selected
 // transaction ready for execution.
+// Transactions no longer admitted by the admission policy,
+// which can be reloaded at runtime, are dropped.
 func (p *TxPool) Peek() *types.Transaction {
-	// Popping the executables queue
-	// does not remove the actual tx
-	// from the pool.
-	// The executables queue just provides
-	// insight into which account has the
-	// highest priced tx (head of promoted queue)
-	return p.executables.pop()
+	for {
+		// Popping the executables queue
+		// does not remove the actual tx
+		// from the pool.
+		// The executables queue just provides
+		// insight into which account has the
+		// highest priced tx (head of promoted queue)
+		tx := p.executables.pop()
+		if tx == nil || p.isAdmitted(tx) {
+			return tx
+		}
+
+		p.Drop(tx)
+	}
+}
+
+// isAdmitted returns a flag indicating if the transaction
+// is admitted by the admission policy at the latest block
+func (p *TxPool) isAdmitted(tx *types.Transaction) bool {
+	if p.policy == nil {
+		return true
+	}
+
+	header := p.store.Header()
+
+	if err := p.policy.Admit(header.StateRoot, header, tx); err != nil {
+		p.logger.Debug("transaction not admitted", "hash", tx.Hash.String(), "err", err)
+
+		return false
+	}
+
+	return true
 }
 
 // Pop removes the given transaction from the
@@ -330,8 +754,13 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 	account.promoted.lock(true)
 	defer account.promoted.unlock()
 
-	// pop the top most promoted tx
-	account.promoted.pop()
+	// pop the top most promoted tx, unless it was
+	// replaced or dropped since it was peeked
+	if !account.promoted.popIfHead(tx) {
+		p.logger.Debug("popped transaction is no longer promoted", "hash", tx.Hash.String())
+
+		return
+	}
 
 	// update state
 	p.gauge.decrease(slotsRequired(tx))
@@ -356,8 +785,13 @@ func (p *TxPool) Drop(tx *types.Transaction) {
 	account.promoted.lock(true)
 	defer account.promoted.unlock()
 
-	// pop the top most promoted tx
-	account.promoted.pop()
+	// pop the top most promoted tx, unless it was
+	// replaced or dropped since it was peeked
+	if !account.promoted.popIfHead(tx) {
+		p.logger.Debug("dropped transaction is no longer promoted", "hash", tx.Hash.String())
+
+		return
+	}
 
 	// update state
 	p.index.remove(tx)
@@ -390,8 +824,13 @@ func (p *TxPool) Demote(tx *types.Transaction) {
 	account.promoted.lock(true)
 	defer account.promoted.unlock()
 
-	// drop the tx from account promoted
-	account.promoted.pop()
+	// drop the tx from account promoted, unless it was
+	// replaced or dropped since it was peeked
+	if !account.promoted.popIfHead(tx) {
+		p.logger.Debug("demoted transaction is no longer promoted", "hash", tx.Hash.String())
+
+		return
+	}
 
 	// signal enqueue request [BLOCKING]
 	p.enqueueReqCh <- enqueueRequest{tx: tx, demoted: true}
@@ -405,6 +844,103 @@ func (p *TxPool) Demote(tx *types.Transaction) {
 	p.eventManager.signalEvent(proto.EventType_DEMOTED, tx.Hash)
 }
 
+// DropTx removes the transaction with the given hash from the pool
+// on demand of the operator. Dropping a promoted transaction demotes
+// the following promoted transactions of its account.
+// If the block builder already peeked the transaction, its later
+// Pop, Drop or Demote is a no-op, as the transaction is no longer promoted.
+func (p *TxPool) DropTx(hash types.Hash) (*types.Transaction, error) {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	if !p.dropTx(tx, proto.EventType_DROPPED) {
+		// removed concurrently
+		return nil, ErrTxNotFound
+	}
+
+	return tx, nil
+}
+
+// dropTx removes the given transaction from the pool, signaling the given event.
+// Returns false if the transaction is not in the pool.
+func (p *TxPool) dropTx(tx *types.Transaction, event proto.EventType) bool {
+	account := p.accounts.get(tx.From)
+
+	demoted, promoted, ok := account.drop(tx)
+	if !ok {
+		return false
+	}
+
+	// update state
+	p.index.remove(tx)
+	p.gauge.decrease(slotsRequired(tx))
+
+	// the dropped tx could be the primary of its account
+	p.executables.remove(tx)
+
+	// update metrics
+	if promoted {
+		p.metrics.PendingTxs.Add(float64(-1 - len(demoted)))
+	}
+
+	p.logger.Debug("dropped transaction", "hash", tx.Hash.String(), "demoted", len(demoted))
+
+	p.eventManager.signalEvent(event, tx.Hash)
+	p.eventManager.signalEvent(proto.EventType_DEMOTED, txHashes(demoted)...)
+
+	if account.isLocal() {
+		p.syncJournal()
+	}
+
+	return true
+}
+
+// DropAccountTxs removes all transactions of the given account from
+// the pool on demand of the operator. Returns the dropped transactions.
+func (p *TxPool) DropAccountTxs(addr types.Address) ([]*types.Transaction, error) {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil, ErrAccountNotFound
+	}
+
+	promoted, enqueued := account.dropAll()
+	dropped := append(promoted, enqueued...)
+
+	// update state
+	p.index.remove(dropped...)
+	p.gauge.decrease(slotsRequired(dropped...))
+
+	// the primary of the account could be in the executables
+	if len(promoted) > 0 {
+		p.executables.remove(promoted[0])
+	}
+
+	// update metrics
+	p.metrics.PendingTxs.Add(float64(-1 * len(promoted)))
+
+	p.logger.Debug("dropped account transactions", "addr", addr.String(), "count", len(dropped))
+
+	p.eventManager.signalEvent(proto.EventType_DROPPED, txHashes(dropped)...)
+
+	if account.isLocal() {
+		p.syncJournal()
+	}
+
+	return dropped, nil
+}
+
+// syncJournal rotates the journal (if enabled) so that
+// transactions dropped by the operator are not replayed.
+func (p *TxPool) syncJournal() {
+	if p.journal == nil {
+		return
+	}
+
+	p.rotateJournal(p.localTxs())
+}
+
 // ResetWithHeaders processes the transactions from the new
 // headers to sync the pool with the new state.
 func (p *TxPool) ResetWithHeaders(headers ...*types.Header) {
@@ -415,6 +951,34 @@ func (p *TxPool) ResetWithHeaders(headers ...*types.Header) {
 	// process the txs in the event
 	// to make sure the pool is up-to-date
 	p.processEvent(e)
+
+	if len(headers) > 0 {
+		number := headers[len(headers)-1].Number
+
+		p.pruneExpiredPrivate(number)
+
+		if pruned := p.bundles.prune(number); pruned > 0 {
+			p.logger.Debug("pruned expired bundles", "num", pruned)
+		}
+	}
+}
+
+// pruneExpiredPrivate removes the private transactions
+// which were not included until their max block number.
+func (p *TxPool) pruneExpiredPrivate(number uint64) {
+	for _, hash := range p.privateTxs.expired(number) {
+		p.privateTxs.remove(hash)
+
+		// the tx may have been included or removed already
+		tx, ok := p.index.get(hash)
+		if !ok {
+			continue
+		}
+
+		if p.dropTx(tx, proto.EventType_EXPIRED) {
+			p.metrics.ExpiredTxs.Add(1)
+		}
+	}
 }
 
 // processEvent collects the latest nonces for each account containted
@@ -521,8 +1085,16 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrUnderpriced
 	}
 
-	// Grab the state root for the latest block
-	stateRoot := p.store.Header().StateRoot
+	// Grab the latest block
+	header := p.store.Header()
+	stateRoot := header.StateRoot
+
+	// Reject transactions not allowed on the chain
+	if p.policy != nil {
+		if err := p.policy.Admit(stateRoot, header, tx); err != nil {
+			return err
+		}
+	}
 
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
@@ -534,8 +1106,19 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidAccountState
 	}
 
-	// Check if the sender has enough funds to execute the transaction
-	if accountBalance.Cmp(tx.Cost()) < 0 {
+	// Check if the sender has enough funds to execute the transaction,
+	// the gas of fee delegated transactions is paid by the fee payer
+	cost := tx.Cost()
+
+	if tx.IsFeeDelegated() {
+		if err := p.validateFeePayer(header, tx); err != nil {
+			return err
+		}
+
+		cost = tx.Value
+	}
+
+	if accountBalance.Cmp(cost) < 0 {
 		return ErrInsufficientFunds
 	}
 
@@ -552,6 +1135,54 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	return nil
 }
 
+// validateFeePayer ensures the fee payer of a fee delegated transaction
+// signed it, is a whitelisted sponsor and can pay for its gas
+// on top of the other transactions it sponsors in the pool.
+func (p *TxPool) validateFeePayer(header *types.Header, tx *types.Transaction) error {
+	// the tx is included in the block after the head at the earliest
+	signer, ok := p.signer.(feePayerSigner)
+	if !ok || p.sponsors == nil || !p.feeDelegationFork.Active(header.Number+1) {
+		return ErrFeeDelegationDisabled
+	}
+
+	feePayer, err := signer.FeePayer(tx)
+	if err != nil || feePayer != *tx.FeePayer {
+		return ErrInvalidFeePayer
+	}
+
+	whitelisted, err := p.sponsors.IsWhitelisted(header.StateRoot, header, feePayer)
+	if err != nil {
+		return err
+	}
+
+	if !whitelisted {
+		return ErrSponsorNotWhitelisted
+	}
+
+	feePayerBalance, err := p.store.GetBalance(header.StateRoot, feePayer)
+	if err != nil {
+		return ErrInvalidAccountState
+	}
+
+	// the fee payer must cover the transactions it already sponsors in the pool,
+	// apart from the one being replaced
+	cost := p.index.sponsoredCost(feePayer)
+	cost.Add(cost, tx.GasCost())
+
+	if account := p.accounts.get(tx.From); account != nil {
+		if replaced := account.getByNonce(tx.Nonce); replaced != nil &&
+			replaced.IsFeeDelegated() && *replaced.FeePayer == feePayer {
+			cost.Sub(cost, replaced.GasCost())
+		}
+	}
+
+	if feePayerBalance.Cmp(cost) < 0 {
+		return ErrInsufficientFeePayer
+	}
+
+	return nil
+}
+
 // addTx is the main entry point to the pool
 // for all new transactions. If the call is
 // successful, an account is created for this address
@@ -567,11 +1198,6 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		return err
 	}
 
-	// check for overflow
-	if p.gauge.read()+slotsRequired(tx) > p.gauge.max {
-		return ErrTxPoolOverflow
-	}
-
 	tx.ComputeHash()
 
 	// check if already known
@@ -595,13 +1221,141 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		p.createAccountOnce(tx.From)
 	}
 
+	account := p.accounts.get(tx.From)
+
+	if origin == local {
+		// local accounts are exempt from eviction
+		account.markLocal()
+	}
+
+	if old := account.getByNonce(tx.Nonce); old != nil {
+		// a transaction with the same nonce can only be replaced
+		// by a transaction with a sufficiently higher gas price
+		if !isReplacementPriced(old, tx, p.priceBump) {
+			return ErrReplacementUnderpriced
+		}
+	} else if err := p.checkAccountLimits(account, tx); err != nil {
+		return err
+	}
+
+	// check for overflow
+	if err := p.makeRoom(tx); err != nil {
+		return err
+	}
+
 	// send request [BLOCKING]
 	p.enqueueReqCh <- enqueueRequest{tx: tx}
 	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
 
+	if origin == local && p.journal != nil {
+		if err := p.journal.insert(tx); err != nil && !errors.Is(err, errNoActiveJournal) {
+			p.logger.Error("failed to journal local tx", "err", err)
+		}
+	}
+
 	return nil
 }
 
+// checkAccountLimits ensures the account can accept another transaction.
+// Transactions with a nonce gap count towards the enqueued limit, while the
+// ones matching the expected nonce count towards the promoted limit.
+func (p *TxPool) checkAccountLimits(account *account, tx *types.Transaction) error {
+	if tx.Nonce > account.getNonce() {
+		account.enqueued.lock(false)
+		defer account.enqueued.unlock()
+
+		if p.maxAccountEnqueued != 0 &&
+			account.enqueued.length() >= p.maxAccountEnqueued {
+			return ErrMaxEnqueuedLimit
+		}
+
+		return nil
+	}
+
+	account.promoted.lock(false)
+	defer account.promoted.unlock()
+
+	if p.maxAccountPromoted != 0 &&
+		account.promoted.length() >= p.maxAccountPromoted {
+		return ErrMaxPromotedLimit
+	}
+
+	return nil
+}
+
+// makeRoom ensures there are enough free slots in the pool for the given
+// transaction, by evicting the lowest priced non-local transactions
+// of other accounts. Only transactions with a lower gas price than
+// the given one are evicted.
+func (p *TxPool) makeRoom(tx *types.Transaction) error {
+	for p.gauge.read()+slotsRequired(tx) > p.gauge.max {
+		victim := p.accounts.lowestPricedEvictable(tx.From)
+		if victim == nil ||
+			victim.GasPrice.Cmp(tx.GasPrice) >= 0 {
+			return ErrTxPoolOverflow
+		}
+
+		promoted, evicted := p.accounts.get(victim.From).evict(victim)
+		if !evicted {
+			// the account changed in the meantime, try again
+			continue
+		}
+
+		p.logger.Debug("evicted transaction",
+			"hash", victim.Hash.String(),
+			"replacement", tx.Hash.String(),
+		)
+
+		// update state
+		p.index.remove(victim)
+		p.gauge.decrease(slotsRequired(victim))
+
+		// update metrics
+		if promoted {
+			p.metrics.PendingTxs.Add(-1)
+		}
+
+		p.metrics.EvictedTxs.Add(1)
+		p.eventManager.signalEvent(proto.EventType_EVICTED, victim.Hash)
+	}
+
+	return nil
+}
+
+// pruneExpired removes the enqueued transactions with a nonce gap
+// of all non-local accounts inactive for longer than the enqueued lifetime.
+func (p *TxPool) pruneExpired() {
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+
+		account := p.accounts.get(addr)
+		if account.isLocal() {
+			return true
+		}
+
+		expired := account.pruneExpired(p.enqueuedLifetime)
+		if len(expired) == 0 {
+			return true
+		}
+
+		p.logger.Debug("expired enqueued transactions",
+			"addr", addr.String(),
+			"count", len(expired),
+		)
+
+		// update state
+		p.index.remove(expired...)
+		p.gauge.decrease(slotsRequired(expired...))
+
+		// update metrics
+		p.metrics.ExpiredTxs.Add(float64(len(expired)))
+
+		p.eventManager.signalEvent(proto.EventType_EXPIRED, txHashes(expired)...)
+
+		return true
+	})
+}
+
 // handleEnqueueRequest attempts to enqueue the transaction
 // contained in the given request to the associated account.
 // If, afterwards, the account is eligible for promotion,
@@ -613,6 +1367,23 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 	// fetch account
 	account := p.accounts.get(addr)
 
+	// demoted transactions are returned to the queue they were taken from,
+	// while new transactions can replace the ones with the same nonce
+	if !req.demoted {
+		replaced, promoted, err := account.replace(tx, p.priceBump)
+		if err != nil {
+			p.logger.Error("enqueue request", "err", err)
+
+			return
+		}
+
+		if replaced != nil {
+			p.handleReplaced(replaced, tx, promoted)
+
+			return
+		}
+	}
+
 	// enqueue tx
 	if err := account.enqueue(tx, req.demoted); err != nil {
 		p.logger.Error("enqueue request", "err", err)
@@ -639,6 +1410,37 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 	}
 }
 
+// handleReplaced updates the pool state after the replaced
+// transaction was swapped with the replacement in its account queue.
+func (p *TxPool) handleReplaced(replaced, tx *types.Transaction, promoted bool) {
+	p.logger.Debug("replaced transaction",
+		"hash", replaced.Hash.String(),
+		"replacement", tx.Hash.String(),
+	)
+
+	// update lookup
+	p.index.remove(replaced)
+	p.index.add(tx)
+
+	// the replaced tx could be the primary of its account
+	if p.executables.remove(replaced) {
+		p.executables.push(tx)
+	}
+
+	// update state
+	p.gauge.decrease(slotsRequired(replaced))
+	p.gauge.increase(slotsRequired(tx))
+
+	p.eventManager.signalEvent(proto.EventType_REPLACED, replaced.Hash)
+
+	// the replacement takes the place of the replaced tx
+	if promoted {
+		p.eventManager.signalEvent(proto.EventType_PROMOTED, tx.Hash)
+	} else {
+		p.eventManager.signalEvent(proto.EventType_ENQUEUED, tx.Hash)
+	}
+}
+
 // handlePromoteRequest handles moving promotable transactions
 // of some account from enqueued to promoted. Can only be
 // invoked by handleEnqueueRequest or resetAccount.
@@ -657,7 +1459,7 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 
 // addGossipTx handles receiving transactions
 // gossiped by the network.
-func (p *TxPool) addGossipTx(obj interface{}) {
+func (p *TxPool) addGossipTx(obj interface{}, from peer.ID) {
 	if !p.sealing {
 		return
 	}
@@ -668,6 +1470,7 @@ func (p *TxPool) addGossipTx(obj interface{}) {
 	// decode tx
 	if err := tx.UnmarshalRLP(raw.Raw.Value); err != nil {
 		p.logger.Error("failed to decode broadcasted tx", "err", err)
+		p.reportPeer(from, err)
 
 		return
 	}
@@ -675,6 +1478,78 @@ func (p *TxPool) addGossipTx(obj interface{}) {
 	// add tx
 	if err := p.addTx(gossip, tx); err != nil {
 		p.logger.Error("failed to add broadcasted txn", "err", err)
+
+		if isMalformedTx(err) {
+			p.reportPeer(from, err)
+		}
+
+		return
+	}
+
+	// announce it to the peers not subscribed to the topic
+	if p.announcer != nil {
+		p.announcer.announce(tx.Hash)
+	}
+}
+
+// addFetchedTx handles the transactions fetched from the peers
+// after being announced. Non sealing nodes don't add them to the pool,
+// but keep them for relaying.
+func (p *TxPool) addFetchedTx(tx *types.Transaction, from peer.ID) {
+	if !p.sealing {
+		if err := p.validateTx(tx); err != nil {
+			p.logger.Debug("failed to validate fetched txn", "err", err)
+
+			if isMalformedTx(err) {
+				p.reportPeer(from, err)
+			}
+
+			return
+		}
+
+		if p.announcer.relay.add(tx.Hash, tx) {
+			p.announcer.announce(tx.Hash)
+		}
+
+		return
+	}
+
+	if err := p.addTx(gossip, tx); err != nil {
+		if !errors.Is(err, ErrAlreadyKnown) {
+			p.logger.Error("failed to add fetched txn", "err", err)
+		}
+
+		if isMalformedTx(err) {
+			p.reportPeer(from, err)
+		}
+
+		return
+	}
+
+	p.announcer.announce(tx.Hash)
+}
+
+// reportPeer reports the peer which sent a malformed transaction
+func (p *TxPool) reportPeer(from peer.ID, err error) {
+	if p.reporter == nil || from == "" {
+		return
+	}
+
+	p.reporter.ReportPeer(from, network.PenaltyInvalidTx, err.Error())
+}
+
+// isMalformedTx returns true if the transaction can never be valid,
+// as opposed to the transactions outdated or not matching the pool limits
+func isMalformedTx(err error) bool {
+	switch {
+	case errors.Is(err, ErrOversizedData),
+		errors.Is(err, ErrNegativeValue),
+		errors.Is(err, ErrNonEncryptedTx),
+		errors.Is(err, ErrInvalidSender),
+		errors.Is(err, ErrIntrinsicGas):
+		return true
+	default:
+		return false
 	}
 }
 
@@ -753,3 +1628,13 @@ func (p *TxPool) createAccountOnce(newAddr types.Address) *account {
 func (p *TxPool) Length() uint64 {
 	return p.accounts.promoted()
 }
+
+// txHashes returns the hashes of the given transactions.
+func txHashes(txs []*types.Transaction) []types.Hash {
+	hashes := make([]types.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash
+	}
+
+	return hashes
+}
@@ -1,11 +1,15 @@
 package txpool
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"crypto/rand"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/admission"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
@@ -14,6 +18,7 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
@@ -284,7 +289,7 @@ func TestAddGossipTx(t *testing.T) {
 					Value: signedTx.MarshalRLP(),
 				},
 			}
-			pool.addGossipTx(protoTx)
+			pool.addGossipTx(protoTx, "")
 		}()
 		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
 
@@ -312,7 +317,7 @@ func TestAddGossipTx(t *testing.T) {
 				Value: signedTx.MarshalRLP(),
 			},
 		}
-		pool.addGossipTx(protoTx)
+		pool.addGossipTx(protoTx, "")
 
 		assert.Equal(t, uint64(0), pool.accounts.get(sender).enqueued.length())
 	})
@@ -2043,3 +2048,941 @@ func TestGetTxs(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaceTx(t *testing.T) {
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		pool.priceBump = DefaultPriceBump
+
+		return pool
+	}
+
+	newPricedTx := func(nonce, gasPrice uint64) *types.Transaction {
+		tx := newTx(addr1, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	// addTx sends the tx and handles its enqueue request
+	addTx := func(t *testing.T, pool *TxPool, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, tx))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	}
+
+	t.Run("replace enqueued tx", func(t *testing.T) {
+		pool := setupPool(t)
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_REPLACED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		original := newPricedTx(5, 100)
+		addTx(t, pool, original)
+
+		// the price bump is not high enough
+		assert.ErrorIs(t,
+			pool.addTx(local, newPricedTx(5, 109)),
+			ErrReplacementUnderpriced,
+		)
+
+		replacement := newPricedTx(5, 110)
+		addTx(t, pool, replacement)
+
+		enqueued := pool.accounts.get(addr1).enqueued
+		assert.Equal(t, uint64(1), enqueued.length())
+		assert.Equal(t, replacement.Hash, enqueued.peek().Hash)
+
+		_, ok := pool.index.get(original.Hash)
+		assert.False(t, ok)
+
+		_, ok = pool.index.get(replacement.Hash)
+		assert.True(t, ok)
+
+		assert.Equal(t, uint64(1), pool.gauge.read())
+
+		select {
+		case event := <-subscription.subscriptionChannel:
+			assert.Equal(t, proto.EventType_REPLACED, event.Type)
+			assert.Equal(t, original.Hash.String(), event.TxHash)
+		case <-time.After(5 * time.Second):
+			t.Fatal("replaced event not received")
+		}
+	})
+
+	t.Run("replace promoted primary", func(t *testing.T) {
+		pool := setupPool(t)
+
+		original := newPricedTx(0, 100)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, original))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		// the original tx is the primary of the account
+		pool.Prepare()
+
+		replacement := newPricedTx(0, 200)
+		addTx(t, pool, replacement)
+
+		promoted := pool.accounts.get(addr1).promoted
+		assert.Equal(t, uint64(1), promoted.length())
+		assert.Equal(t, replacement.Hash, promoted.peek().Hash)
+		assert.Equal(t, uint64(1), pool.gauge.read())
+
+		// the replacement takes the place of the original tx in the executables
+		assert.Equal(t, uint64(1), pool.executables.length())
+
+		tx := pool.Peek()
+		assert.Equal(t, replacement.Hash, tx.Hash)
+
+		pool.Pop(tx)
+
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, uint64(0), promoted.length())
+	})
+
+	t.Run("replace peeked primary", func(t *testing.T) {
+		pool := setupPool(t)
+
+		original := newPricedTx(0, 100)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, original))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		// the original tx is taken by the block builder
+		pool.Prepare()
+
+		tx := pool.Peek()
+		assert.Equal(t, original.Hash, tx.Hash)
+
+		replacement := newPricedTx(0, 200)
+		addTx(t, pool, replacement)
+
+		// popping the original tx leaves the replacement untouched
+		pool.Pop(tx)
+
+		promoted := pool.accounts.get(addr1).promoted
+		assert.Equal(t, uint64(1), promoted.length())
+		assert.Equal(t, replacement.Hash, promoted.peek().Hash)
+		assert.Equal(t, uint64(1), pool.gauge.read())
+
+		_, ok := pool.index.get(replacement.Hash)
+		assert.True(t, ok)
+
+		// the replacement is not pushed back to the executables
+		assert.Nil(t, pool.Peek())
+	})
+}
+
+func TestIsReplacementPriced(t *testing.T) {
+	testTable := []struct {
+		name        string
+		oldPrice    uint64
+		newPrice    uint64
+		priceBump   uint64
+		replaceable bool
+	}{
+		{"same price", 100, 100, 0, false},
+		{"lower price", 100, 99, 0, false},
+		{"higher price without bump", 100, 101, 0, true},
+		{"bump not reached", 100, 109, 10, false},
+		{"bump reached", 100, 110, 10, true},
+		{"bump rounded down", 5, 6, 10, true},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			old := &types.Transaction{GasPrice: new(big.Int).SetUint64(testCase.oldPrice)}
+			replacement := &types.Transaction{GasPrice: new(big.Int).SetUint64(testCase.newPrice)}
+
+			assert.Equal(t,
+				testCase.replaceable,
+				isReplacementPriced(old, replacement, testCase.priceBump),
+			)
+		})
+	}
+}
+
+func TestAccountLimits(t *testing.T) {
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		pool.maxAccountEnqueued = 1
+		pool.maxAccountPromoted = 1
+
+		return pool
+	}
+
+	t.Run("ErrMaxEnqueuedLimit", func(t *testing.T) {
+		pool := setupPool(t)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, newTx(addr1, 5, 1)))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, newTx(addr1, 6, 1)),
+			ErrMaxEnqueuedLimit,
+		)
+	})
+
+	t.Run("ErrMaxPromotedLimit", func(t *testing.T) {
+		pool := setupPool(t)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, newTx(addr1, 0, 1)))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, newTx(addr1, 1, 1)),
+			ErrMaxPromotedLimit,
+		)
+	})
+}
+
+func TestEviction(t *testing.T) {
+	newPricedTx := func(addr types.Address, nonce, gasPrice uint64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		// room for 2 single-slot txs
+		pool.gauge.max = 2
+
+		return pool
+	}
+
+	// addAndPromote sends the tx and handles its requests
+	addAndPromote := func(t *testing.T, pool *TxPool, origin txOrigin, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.addTx(origin, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	t.Run("evict lowest priced tx", func(t *testing.T) {
+		pool := setupPool(t)
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_EVICTED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 1))
+
+		evicted := newPricedTx(addr2, 1, 1)
+		addAndPromote(t, pool, gossip, evicted)
+
+		assert.Equal(t, uint64(2), pool.gauge.read())
+
+		addAndPromote(t, pool, local, newPricedTx(addr1, 0, 2))
+
+		assert.Equal(t, uint64(2), pool.gauge.read())
+
+		_, ok := pool.index.get(evicted.Hash)
+		assert.False(t, ok)
+
+		// the nonce is rolled back to the evicted tx
+		account := pool.accounts.get(addr2)
+		assert.Equal(t, uint64(1), account.promoted.length())
+		assert.Equal(t, uint64(1), account.getNonce())
+
+		select {
+		case event := <-subscription.subscriptionChannel:
+			assert.Equal(t, proto.EventType_EVICTED, event.Type)
+			assert.Equal(t, evicted.Hash.String(), event.TxHash)
+		case <-time.After(5 * time.Second):
+			t.Fatal("evicted event not received")
+		}
+
+		// the remaining primary is never evicted
+		assert.ErrorIs(t,
+			pool.addTx(local, newPricedTx(addr3, 0, 2)),
+			ErrTxPoolOverflow,
+		)
+	})
+
+	t.Run("evict enqueued tx first", func(t *testing.T) {
+		pool := setupPool(t)
+		pool.gauge.max = 3
+
+		addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 1))
+		addAndPromote(t, pool, gossip, newPricedTx(addr2, 1, 1))
+
+		enqueued := newPricedTx(addr2, 5, 1)
+
+		go func() {
+			assert.NoError(t, pool.addTx(gossip, enqueued))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		tx := newPricedTx(addr1, 0, 2)
+		assert.Equal(t, enqueued, pool.accounts.lowestPricedEvictable(tx.From))
+
+		addAndPromote(t, pool, local, tx)
+
+		account := pool.accounts.get(addr2)
+		assert.Equal(t, uint64(0), account.enqueued.length())
+		assert.Equal(t, uint64(2), account.promoted.length())
+		assert.Equal(t, uint64(3), pool.gauge.read())
+	})
+
+	t.Run("no eviction of higher priced txs", func(t *testing.T) {
+		pool := setupPool(t)
+
+		addAndPromote(t, pool, gossip, newPricedTx(addr2, 0, 2))
+		addAndPromote(t, pool, gossip, newPricedTx(addr2, 1, 2))
+
+		assert.ErrorIs(t,
+			pool.addTx(local, newPricedTx(addr1, 0, 2)),
+			ErrTxPoolOverflow,
+		)
+	})
+
+	t.Run("no eviction of local txs", func(t *testing.T) {
+		pool := setupPool(t)
+
+		addAndPromote(t, pool, local, newPricedTx(addr2, 0, 1))
+		addAndPromote(t, pool, local, newPricedTx(addr2, 1, 1))
+
+		assert.ErrorIs(t,
+			pool.addTx(local, newPricedTx(addr1, 0, 2)),
+			ErrTxPoolOverflow,
+		)
+	})
+}
+
+func TestPruneExpired(t *testing.T) {
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		pool.enqueuedLifetime = time.Minute
+
+		return pool
+	}
+
+	// enqueue sends the tx with a nonce gap and makes its account idle
+	enqueue := func(t *testing.T, pool *TxPool, origin txOrigin, tx *types.Transaction, idle time.Duration) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.addTx(origin, tx))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		atomic.StoreInt64(
+			&pool.accounts.get(tx.From).lastActivity,
+			time.Now().Add(-idle).UnixNano(),
+		)
+	}
+
+	t.Run("prune idle accounts", func(t *testing.T) {
+		pool := setupPool(t)
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_EXPIRED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		expired := newTx(addr1, 5, 1)
+		enqueue(t, pool, gossip, expired, 2*time.Minute)
+
+		active := newTx(addr2, 5, 1)
+		enqueue(t, pool, gossip, active, 0)
+
+		pool.pruneExpired()
+
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+		assert.Equal(t, uint64(1), pool.accounts.get(addr2).enqueued.length())
+		assert.Equal(t, uint64(1), pool.gauge.read())
+
+		_, ok := pool.index.get(expired.Hash)
+		assert.False(t, ok)
+
+		select {
+		case event := <-subscription.subscriptionChannel:
+			assert.Equal(t, proto.EventType_EXPIRED, event.Type)
+			assert.Equal(t, expired.Hash.String(), event.TxHash)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expired event not received")
+		}
+	})
+
+	t.Run("local accounts never expire", func(t *testing.T) {
+		pool := setupPool(t)
+
+		enqueue(t, pool, local, newTx(addr1, 5, 1), 2*time.Minute)
+
+		pool.pruneExpired()
+
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+		assert.Equal(t, uint64(1), pool.gauge.read())
+	})
+}
+
+func TestAdmissionPolicy(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+	pool.EnableDev()
+
+	pool.SetAdmissionPolicy(&mockAdmissionPolicy{denied: addr2})
+
+	assert.ErrorIs(t,
+		pool.addTx(local, newTx(addr2, 0, 1)),
+		admission.ErrSenderDenied,
+	)
+
+	go func() {
+		assert.NoError(t, pool.addTx(local, newTx(addr1, 1, 1)))
+	}()
+	pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+	assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+
+	// the policy is reloaded by the operator
+	policy, err := pool.ReloadAdmissionPolicy(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{addr2.String()}, policy.Denylist)
+}
+
+func TestAdmissionPolicy_BlockBuilding(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+	pool.EnableDev()
+
+	policy := &mockAdmissionPolicy{denied: addr2}
+	pool.SetAdmissionPolicy(policy)
+
+	go func() {
+		assert.NoError(t, pool.addTx(local, newTx(addr1, 0, 1)))
+	}()
+	go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+
+	// the sender is denied after the tx was added
+	policy.denied = addr1
+
+	pool.Prepare()
+	assert.Nil(t, pool.Peek())
+
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+	assert.Equal(t, uint64(0), pool.gauge.read())
+}
+
+func TestFeeDelegation(t *testing.T) {
+	senderKey, _ := tests.GenerateKeyAndAddr(t)
+	sponsorKey, sponsor := tests.GenerateKeyAndAddr(t)
+	otherKey, other := tests.GenerateKeyAndAddr(t)
+
+	signer := crypto.NewFeeDelegationSigner(100)
+
+	newDelegatedTx := func(t *testing.T, feePayer types.Address, feePayerKey *ecdsa.PrivateKey) *types.Transaction {
+		t.Helper()
+
+		tx := newTx(types.ZeroAddress, 0, 1)
+		tx.FeePayer = &feePayer
+
+		tx, err := signer.SignTx(tx, senderKey)
+		assert.NoError(t, err)
+
+		tx, err = signer.SignFeePayer(tx, feePayerKey)
+		assert.NoError(t, err)
+
+		return tx
+	}
+
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(signer)
+		pool.SetSponsorWhitelist(&mockSponsorWhitelist{sponsor: sponsor}, 0)
+
+		return pool
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(signer)
+
+		assert.ErrorIs(t,
+			pool.validateTx(newDelegatedTx(t, sponsor, sponsorKey)),
+			ErrFeeDelegationDisabled,
+		)
+	})
+
+	t.Run("before the fork", func(t *testing.T) {
+		pool := setupPool(t)
+		pool.SetSponsorWhitelist(&mockSponsorWhitelist{sponsor: sponsor}, 100)
+
+		assert.ErrorIs(t,
+			pool.validateTx(newDelegatedTx(t, sponsor, sponsorKey)),
+			ErrFeeDelegationDisabled,
+		)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		pool := setupPool(t)
+
+		assert.NoError(t, pool.validateTx(newDelegatedTx(t, sponsor, sponsorKey)))
+	})
+
+	t.Run("ErrInvalidFeePayer", func(t *testing.T) {
+		pool := setupPool(t)
+
+		assert.ErrorIs(t,
+			pool.validateTx(newDelegatedTx(t, sponsor, otherKey)),
+			ErrInvalidFeePayer,
+		)
+	})
+
+	t.Run("ErrSponsorNotWhitelisted", func(t *testing.T) {
+		pool := setupPool(t)
+
+		assert.ErrorIs(t,
+			pool.validateTx(newDelegatedTx(t, other, otherKey)),
+			ErrSponsorNotWhitelisted,
+		)
+	})
+
+	t.Run("balances", func(t *testing.T) {
+		pool := setupPool(t)
+
+		// the gas cost exceeds the balance of every account
+		tx := newTx(types.ZeroAddress, 0, 1)
+		tx.GasPrice.SetUint64(1000000000000)
+
+		signedTx, err := signer.SignTx(tx, senderKey)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, pool.validateTx(signedTx), ErrInsufficientFunds)
+
+		// the sender only needs to cover the value
+		tx.FeePayer = &sponsor
+
+		signedTx, err = signer.SignTx(tx, senderKey)
+		assert.NoError(t, err)
+
+		signedTx, err = signer.SignFeePayer(signedTx, sponsorKey)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, pool.validateTx(signedTx), ErrInsufficientFeePayer)
+	})
+
+	t.Run("sponsored in the pool", func(t *testing.T) {
+		pool := setupPool(t)
+
+		// each tx costs the sponsor 60% of its balance
+		price := new(big.Int).Div(big.NewInt(60000000000000), new(big.Int).SetUint64(validGasLimit))
+
+		newSponsoredTx := func(t *testing.T, nonce uint64, price *big.Int) *types.Transaction {
+			t.Helper()
+
+			tx := newDelegatedTx(t, sponsor, sponsorKey)
+			tx.Nonce = nonce
+			tx.GasPrice = price
+
+			tx, err := signer.SignTx(tx, senderKey)
+			assert.NoError(t, err)
+
+			tx, err = signer.SignFeePayer(tx, sponsorKey)
+			assert.NoError(t, err)
+
+			return tx
+		}
+
+		first := newSponsoredTx(t, 0, price)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, first))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		assert.Equal(t, first.GasCost(), pool.index.sponsoredCost(sponsor))
+
+		// the sponsor can't pay for both txs
+		assert.ErrorIs(t,
+			pool.addTx(local, newSponsoredTx(t, 1, price)),
+			ErrInsufficientFeePayer,
+		)
+
+		// but it can pay for the replacement of the first one
+		replacement := newSponsoredTx(t, 0, new(big.Int).Div(new(big.Int).Mul(price, big.NewInt(11)), big.NewInt(10)))
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, replacement))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		assert.Equal(t, replacement.GasCost(), pool.index.sponsoredCost(sponsor))
+
+		// the committed gas cost is released along with the tx
+		pool.index.remove(replacement)
+
+		assert.Zero(t, pool.index.sponsoredCost(sponsor).Sign())
+		assert.NotContains(t, pool.index.sponsored, sponsor)
+	})
+}
+
+func TestOperatorDrop(t *testing.T) {
+	// setupPool promotes the txs with nonces 0-2 and enqueues the tx with nonce 5
+	setupPool := func(t *testing.T) (*TxPool, []*types.Transaction) {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		txs := []*types.Transaction{
+			newTx(addr1, 0, 1),
+			newTx(addr1, 1, 1),
+			newTx(addr1, 2, 1),
+			newTx(addr1, 5, 1),
+		}
+
+		for _, tx := range txs[1:] {
+			go func(tx *types.Transaction) {
+				assert.NoError(t, pool.addTx(local, tx))
+			}(tx)
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		}
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, txs[0]))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		assert.Equal(t, uint64(3), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+
+		return pool, txs
+	}
+
+	expectEvents := func(t *testing.T, subscription *subscribeResult, expected ...*proto.TxPoolEvent) {
+		t.Helper()
+
+		for _, event := range expected {
+			select {
+			case received := <-subscription.subscriptionChannel:
+				assert.Equal(t, event.Type, received.Type)
+				assert.Equal(t, event.TxHash, received.TxHash)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s event not received", event.Type)
+			}
+		}
+	}
+
+	supportedEvents := []proto.EventType{
+		proto.EventType_DROPPED,
+		proto.EventType_DEMOTED,
+	}
+
+	t.Run("list and get txs", func(t *testing.T) {
+		pool, txs := setupPool(t)
+
+		resp, err := pool.ListTxns(context.Background(), &proto.ListTxnsReq{})
+		assert.NoError(t, err)
+
+		if assert.Len(t, resp.Accounts, 1) {
+			account := resp.Accounts[0]
+
+			assert.Equal(t, addr1.String(), account.Address)
+			assert.Equal(t, uint64(3), account.NextNonce)
+
+			if assert.Len(t, account.Promoted, 3) {
+				for i, tx := range account.Promoted {
+					assert.Equal(t, txs[i].Hash.String(), tx.Hash)
+					assert.True(t, tx.Promoted)
+				}
+			}
+
+			if assert.Len(t, account.Enqueued, 1) {
+				assert.Equal(t, txs[3].Hash.String(), account.Enqueued[0].Hash)
+				assert.False(t, account.Enqueued[0].Promoted)
+			}
+		}
+
+		_, err = pool.ListTxns(context.Background(), &proto.ListTxnsReq{Address: addr2.String()})
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+
+		tx, err := pool.GetTxn(context.Background(), &proto.GetTxnReq{Hash: txs[3].Hash.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(5), tx.Nonce)
+		assert.False(t, tx.Promoted)
+
+		_, err = pool.GetTxn(context.Background(), &proto.GetTxnReq{Hash: types.ZeroHash.String()})
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("drop enqueued tx", func(t *testing.T) {
+		pool, txs := setupPool(t)
+
+		subscription := pool.eventManager.subscribe(supportedEvents)
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		dropped, err := pool.DropTx(txs[3].Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, txs[3], dropped)
+
+		assert.Equal(t, uint64(3), pool.gauge.read())
+		assert.Equal(t, uint64(3), pool.accounts.get(addr1).getNonce())
+		assert.Equal(t, uint64(3), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+
+		expectEvents(t, subscription,
+			&proto.TxPoolEvent{Type: proto.EventType_DROPPED, TxHash: txs[3].Hash.String()},
+		)
+
+		_, err = pool.DropTx(txs[3].Hash)
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("drop promoted tx demotes the following txs", func(t *testing.T) {
+		pool, txs := setupPool(t)
+
+		subscription := pool.eventManager.subscribe(supportedEvents)
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		_, err := pool.DropTx(txs[1].Hash)
+		assert.NoError(t, err)
+
+		assert.Equal(t, uint64(3), pool.gauge.read())
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).getNonce())
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(2), pool.accounts.get(addr1).enqueued.length())
+
+		_, ok := pool.index.get(txs[1].Hash)
+		assert.False(t, ok)
+
+		expectEvents(t, subscription,
+			&proto.TxPoolEvent{Type: proto.EventType_DROPPED, TxHash: txs[1].Hash.String()},
+			&proto.TxPoolEvent{Type: proto.EventType_DEMOTED, TxHash: txs[2].Hash.String()},
+		)
+	})
+
+	t.Run("drop account txs", func(t *testing.T) {
+		pool, txs := setupPool(t)
+
+		subscription := pool.eventManager.subscribe(supportedEvents)
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		// the primary of the account is executable
+		pool.Prepare()
+
+		resp, err := pool.DropAccount(context.Background(), &proto.DropAccountReq{Address: addr1.String()})
+		assert.NoError(t, err)
+		assert.Len(t, resp.TxHashes, 4)
+
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+		assert.Nil(t, pool.Peek())
+
+		expected := make([]*proto.TxPoolEvent, len(txs))
+		for i, tx := range txs {
+			expected[i] = &proto.TxPoolEvent{Type: proto.EventType_DROPPED, TxHash: tx.Hash.String()}
+		}
+
+		expectEvents(t, subscription, expected...)
+
+		_, err = pool.DropAccountTxs(addr2)
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+
+	t.Run("drop peeked tx", func(t *testing.T) {
+		pool, txs := setupPool(t)
+
+		// the primary of the account is taken by the block builder
+		pool.Prepare()
+
+		tx := pool.Peek()
+		assert.Equal(t, txs[0].Hash, tx.Hash)
+
+		_, err := pool.DropTx(tx.Hash)
+		assert.NoError(t, err)
+
+		// popping the dropped tx leaves the demoted txs untouched
+		pool.Pop(tx)
+
+		assert.Equal(t, uint64(3), pool.gauge.read())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(3), pool.accounts.get(addr1).enqueued.length())
+	})
+
+	t.Run("drop account of peeked tx", func(t *testing.T) {
+		pool, _ := setupPool(t)
+
+		pool.Prepare()
+
+		tx := pool.Peek()
+
+		_, err := pool.DropAccountTxs(addr1)
+		assert.NoError(t, err)
+
+		// demoting the dropped tx does not enqueue it again
+		pool.Demote(tx)
+
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+	})
+}
+
+func TestPrivateTx(t *testing.T) {
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.EnableDev()
+
+		return pool
+	}
+
+	addPrivateTx := func(t *testing.T, pool *TxPool, tx *types.Transaction, maxBlockNumber uint64) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.AddPrivateTx(tx, maxBlockNumber))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	t.Run("disabled without network", func(t *testing.T) {
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		assert.ErrorIs(t, pool.AddPrivateTx(newTx(addr1, 0, 1), 0), ErrPrivateTxsDisabled)
+	})
+
+	t.Run("max block number capped by the lifetime", func(t *testing.T) {
+		pool := setupPool(t)
+
+		maxBlockNumber, err := pool.privateMaxBlockNumber(0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(DefaultPrivateTxLifetime), maxBlockNumber)
+
+		maxBlockNumber, err = pool.privateMaxBlockNumber(DefaultPrivateTxLifetime + 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(DefaultPrivateTxLifetime), maxBlockNumber)
+
+		maxBlockNumber, err = pool.privateMaxBlockNumber(10)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10), maxBlockNumber)
+	})
+
+	t.Run("hidden from the public views", func(t *testing.T) {
+		pool := setupPool(t)
+
+		privateTx := newTx(addr1, 0, 1)
+		addPrivateTx(t, pool, privateTx, 0)
+
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+		assert.True(t, pool.privateTxs.has(privateTx.Hash))
+
+		// the tx cannot be queried, even by its hash
+		_, ok := pool.GetPendingTx(privateTx.Hash)
+		assert.False(t, ok)
+
+		promoted, enqueued := pool.GetTxs(true)
+		assert.NotContains(t, promoted, addr1)
+		assert.Empty(t, enqueued[addr1])
+
+		// the queue of the account is untouched
+		publicTx := newTx(addr1, 1, 1)
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, publicTx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		promoted, _ = pool.GetTxs(false)
+		assert.Equal(t, []*types.Transaction{publicTx}, promoted[addr1])
+		assert.Equal(t, uint64(2), pool.accounts.get(addr1).promoted.length())
+
+		// private txs are not journaled
+		pool.accounts.get(addr1).markLocal()
+		assert.Equal(t, []*types.Transaction{publicTx}, pool.localTxs())
+	})
+
+	t.Run("expired after max block number", func(t *testing.T) {
+		pool := setupPool(t)
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_EXPIRED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		tx := newTx(addr1, 0, 1)
+		addPrivateTx(t, pool, tx, 10)
+
+		pool.pruneExpiredPrivate(9)
+		assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+
+		pool.pruneExpiredPrivate(10)
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.False(t, pool.privateTxs.has(tx.Hash))
+
+		_, ok := pool.index.get(tx.Hash)
+		assert.False(t, ok)
+
+		select {
+		case event := <-subscription.subscriptionChannel:
+			assert.Equal(t, tx.Hash.String(), event.TxHash)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expired event not received")
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"math/big"
+)
+
+// StateOverride is the collection of overridden accounts,
+// used for simulating calls against a hypothetical state
+type StateOverride map[Address]OverrideAccount
+
+// OverrideAccount specifies the account fields that should be
+// overridden before the simulated call is executed
+type OverrideAccount struct {
+	Nonce   *uint64
+	Code    []byte
+	Balance *big.Int
+
+	// State replaces the entire account storage
+	State map[Hash]Hash
+
+	// StateDiff modifies only the specified storage slots
+	StateDiff map[Hash]Hash
+}
+
+// BlockOverride specifies the block environment fields that should be
+// overridden before the simulated call is executed
+type BlockOverride struct {
+	Number    *uint64
+	Timestamp *uint64
+	GasLimit  *uint64
+	Coinbase  *Address
+}
@@ -65,6 +65,41 @@ func TestRLPMarshall_And_Unmarshall_Transaction(t *testing.T) {
 	}
 }
 
+func TestRLPMarshall_And_Unmarshall_FeeDelegatedTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	feePayer := StringToAddress("22")
+	txn := &Transaction{
+		Nonce:     0,
+		GasPrice:  big.NewInt(11),
+		Gas:       11,
+		To:        &addrTo,
+		Value:     big.NewInt(1),
+		Input:     []byte{1, 2},
+		V:         big.NewInt(25),
+		S:         big.NewInt(26),
+		R:         big.NewInt(27),
+		FeePayer:  &feePayer,
+		FeePayerV: big.NewInt(28),
+		FeePayerR: big.NewInt(29),
+		FeePayerS: big.NewInt(30),
+	}
+	unmarshalledTxn := new(Transaction)
+	marshaledRlp := txn.MarshalRLP()
+
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+
+	unmarshalledTxn.ComputeHash()
+
+	txn.Hash = unmarshalledTxn.Hash
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// the fee payer values are reset when decoding a regular transaction
+	txn.FeePayer, txn.FeePayerV, txn.FeePayerR, txn.FeePayerS = nil, nil, nil, nil
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(txn.MarshalRLP()))
+	assert.False(t, unmarshalledTxn.IsFeeDelegated())
+	assert.Nil(t, unmarshalledTxn.FeePayerV)
+}
+
 func TestRLPUnmarshal_Header_ComputeHash(t *testing.T) {
 	// header computes hash after unmarshaling
 	h := &Header{}
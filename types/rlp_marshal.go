@@ -185,5 +185,13 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewBigInt(t.R))
 	vv.Set(arena.NewBigInt(t.S))
 
+	// fee delegation values
+	if t.FeePayer != nil {
+		vv.Set(arena.NewBytes((*t.FeePayer).Bytes()))
+		vv.Set(arena.NewBigInt(t.FeePayerV))
+		vv.Set(arena.NewBigInt(t.FeePayerR))
+		vv.Set(arena.NewBigInt(t.FeePayerS))
+	}
+
 	return vv
 }
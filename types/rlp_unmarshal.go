@@ -299,8 +299,9 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return err
 	}
 
-	if num := len(elems); num != 9 {
-		return fmt.Errorf("not enough elements to decode transaction, expected 9 but found %d", num)
+	// fee delegated transactions carry the fee payer and its signature
+	if num := len(elems); num != 9 && num != 13 {
+		return fmt.Errorf("not enough elements to decode transaction, expected 9 or 13 but found %d", num)
 	}
 
 	p.Hash(t.Hash[:0], v)
@@ -355,5 +356,36 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return err
 	}
 
+	// reset the fee delegation values
+	t.FeePayer, t.FeePayerV, t.FeePayerR, t.FeePayerS = nil, nil, nil, nil
+
+	if len(elems) == 9 {
+		return nil
+	}
+
+	// fee payer
+	feePayer := Address{}
+	if err = elems[9].GetAddr(feePayer[:]); err != nil {
+		return err
+	}
+
+	t.FeePayer = &feePayer
+
+	// fee payer V
+	t.FeePayerV = new(big.Int)
+	if err = elems[10].GetBigInt(t.FeePayerV); err != nil {
+		return err
+	}
+	// fee payer R
+	t.FeePayerR = new(big.Int)
+	if err = elems[11].GetBigInt(t.FeePayerR); err != nil {
+		return err
+	}
+	// fee payer S
+	t.FeePayerS = new(big.Int)
+	if err = elems[12].GetBigInt(t.FeePayerS); err != nil {
+		return err
+	}
+
 	return nil
 }
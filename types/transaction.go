@@ -20,6 +20,12 @@ type Transaction struct {
 	Hash     Hash
 	From     Address
 
+	// Fee delegation, the sponsor paying for the gas of the transaction
+	FeePayer  *Address
+	FeePayerV *big.Int
+	FeePayerR *big.Int
+	FeePayerS *big.Int
+
 	// Cache
 	size atomic.Value
 }
@@ -28,6 +34,21 @@ func (t *Transaction) IsContractCreation() bool {
 	return t.To == nil
 }
 
+// IsFeeDelegated returns true if the gas of the transaction is paid by a sponsor
+func (t *Transaction) IsFeeDelegated() bool {
+	return t.FeePayer != nil
+}
+
+// GasPayer returns the account paying for the gas of the transaction,
+// which is the fee payer of delegated transactions and the sender otherwise
+func (t *Transaction) GasPayer() Address {
+	if t.FeePayer != nil {
+		return *t.FeePayer
+	}
+
+	return t.From
+}
+
 // ComputeHash computes the hash of the transaction
 func (t *Transaction) ComputeHash() *Transaction {
 	ar := marshalArenaPool.Get()
@@ -65,17 +86,39 @@ func (t *Transaction) Copy() *Transaction {
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
+	if t.FeePayer != nil {
+		feePayer := *t.FeePayer
+		tt.FeePayer = &feePayer
+	}
+
+	if t.FeePayerV != nil {
+		tt.FeePayerV = new(big.Int).Set(t.FeePayerV)
+	}
+
+	if t.FeePayerR != nil {
+		tt.FeePayerR = new(big.Int).Set(t.FeePayerR)
+	}
+
+	if t.FeePayerS != nil {
+		tt.FeePayerS = new(big.Int).Set(t.FeePayerS)
+	}
+
 	return tt
 }
 
 // Cost returns gas * gasPrice + value
 func (t *Transaction) Cost() *big.Int {
-	total := new(big.Int).Mul(t.GasPrice, new(big.Int).SetUint64(t.Gas))
+	total := t.GasCost()
 	total.Add(total, t.Value)
 
 	return total
 }
 
+// GasCost returns gas * gasPrice
+func (t *Transaction) GasCost() *big.Int {
+	return new(big.Int).Mul(t.GasPrice, new(big.Int).SetUint64(t.Gas))
+}
+
 func (t *Transaction) Size() uint64 {
 	if size := t.size.Load(); size != nil {
 		return size.(uint64)